@@ -51,6 +51,12 @@ type AuthEngineMountSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 func (d *AuthEngineMount) IsDeletable() bool {
@@ -226,6 +232,14 @@ type AuthEngineMountStatus struct {
 
 	// +kubebuilder:validation:Optional
 	Accessor string `json:"accessor,omitempty"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 func (m *AuthEngineMount) GetConditions() []metav1.Condition {
@@ -236,6 +250,26 @@ func (m *AuthEngineMount) SetConditions(conditions []metav1.Condition) {
 	m.Status.Conditions = conditions
 }
 
+func (m *AuthEngineMount) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *AuthEngineMount) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *AuthEngineMount) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *AuthEngineMount) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *AuthEngineMount) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
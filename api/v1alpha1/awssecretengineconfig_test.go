@@ -0,0 +1,32 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAWSSecretEngineConfigAccessKeyPrecedence(t *testing.T) {
+	config := &AWSSecretEngineConfig{Spec: AWSSecretEngineConfigSpec{AWSSEConfig: AWSSEConfig{AccessKeyID: "AKIASPEC"}}}
+	config.setAccessKey("AKIASECRET", "s3cr3t")
+	payload := config.GetPayload()
+	if payload["access_key"] != "AKIASPEC" || payload["secret_key"] != "s3cr3t" {
+		t.Errorf("expected spec.accessKeyID to take precedence over the retrieved one, got %v", payload)
+	}
+}
+
+func TestAWSSecretEngineConfigLease(t *testing.T) {
+	config := &AWSSecretEngineConfig{}
+	if config.GetLeasePayload() != nil {
+		t.Errorf("expected no lease configuration without leaseTTL and leaseMaxTTL")
+	}
+	config.Spec.LeaseTTL = metav1.Duration{Duration: time.Hour}
+	config.Spec.LeaseMaxTTL = metav1.Duration{Duration: 24 * time.Hour}
+	if !config.IsLeaseEquivalentToDesiredState(map[string]interface{}{"lease": "1h0m0s", "lease_max": "24h0m0s"}) {
+		t.Errorf("expected the lease read from Vault to be equivalent to the desired state")
+	}
+	if config.IsLeaseEquivalentToDesiredState(map[string]interface{}{"lease": "768h0m0s", "lease_max": "768h0m0s"}) {
+		t.Errorf("expected the default lease not to be equivalent to the desired state")
+	}
+}
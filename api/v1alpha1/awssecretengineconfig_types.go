@@ -0,0 +1,337 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// AWSSecretEngineConfigSpec defines the desired state of AWSSecretEngineConfig
+type AWSSecretEngineConfigSpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the AWS secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/config/root, and {[spec.authentication.namespace]}/{spec.path}/config/lease for the lease configuration.
+	// The authentication role must have the following capabilities = [ "create", "read", "update"] on those paths.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	// RootCredentials specifies how to retrieve the access key ID and the secret access key of the IAM user Vault uses to manage credentials. The access key ID is read from the usernameKey key and the secret access key from the passwordKey key. Only VaultSecretReference or LocalObjectRefence can be used, random secret is not allowed.
+	// When not specified, Vault uses the credentials of its environment, such as the instance profile of the instance it runs on.
+	// +kubebuilder:validation:Optional
+	RootCredentials vaultutils.RootCredentialConfig `json:"rootCredentials,omitempty"`
+
+	AWSSEConfig `json:",inline"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type AWSSEConfig struct {
+	// AccessKeyID the access key ID of the root credentials. If specified, it takes precedence over the access key ID retrieved from the rootCredentials.
+	// +kubebuilder:validation:Optional
+	AccessKeyID string `json:"accessKeyID,omitempty"`
+
+	// Region specifies the AWS region. If not set it will use the AWS_REGION env var, AWS_DEFAULT_REGION env var, or us-east-1 in that order.
+	// +kubebuilder:validation:Optional
+	Region string `json:"region,omitempty"`
+
+	// IAMEndpoint specifies a custom HTTP IAM endpoint to use.
+	// +kubebuilder:validation:Optional
+	IAMEndpoint string `json:"iamEndpoint,omitempty"`
+
+	// STSEndpoint specifies a custom HTTP STS endpoint to use.
+	// +kubebuilder:validation:Optional
+	STSEndpoint string `json:"stsEndpoint,omitempty"`
+
+	// STSRegion specifies a custom STS region to use, it should correspond to the region of stsEndpoint.
+	// +kubebuilder:validation:Optional
+	STSRegion string `json:"stsRegion,omitempty"`
+
+	// MaxRetries number of max retries the client should use for recoverable errors. The default (-1) falls back to the AWS SDK's default behavior.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=-1
+	MaxRetries int `json:"maxRetries,omitempty"`
+
+	// UsernameTemplate template describing how dynamic usernames are generated. When not specified, the Vault default template is used.
+	// +kubebuilder:validation:Optional
+	UsernameTemplate string `json:"usernameTemplate,omitempty"`
+
+	// LeaseTTL specifies the default lease of the credentials generated by the secret engine. LeaseTTL and LeaseMaxTTL must be specified together, when none is specified the lease configuration is left to Vault.
+	// +kubebuilder:validation:Optional
+	LeaseTTL metav1.Duration `json:"leaseTTL,omitempty"`
+
+	// LeaseMaxTTL specifies the maximum lease of the credentials generated by the secret engine.
+	// +kubebuilder:validation:Optional
+	LeaseMaxTTL metav1.Duration `json:"leaseMaxTTL,omitempty"`
+
+	retrievedAccessKeyID string `json:"-"`
+
+	retrievedSecretAccessKey string `json:"-"`
+}
+
+var _ vaultutils.VaultObject = &AWSSecretEngineConfig{}
+var _ vaultutils.VaultLeaseConfigObject = &AWSSecretEngineConfig{}
+var _ vaultutils.ConditionsAware = &AWSSecretEngineConfig{}
+
+func (d *AWSSecretEngineConfig) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+// IsDeletable returns false, Vault does not support the deletion of the root configuration
+func (d *AWSSecretEngineConfig) IsDeletable() bool {
+	return false
+}
+
+func (d *AWSSecretEngineConfig) GetPath() string {
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "config/root")
+}
+
+func (d *AWSSecretEngineConfig) GetPayload() map[string]interface{} {
+	return d.Spec.AWSSEConfig.toMap()
+}
+
+// IsEquivalentToDesiredState compares the configuration read from Vault, which does not return the secret access key, with the desired configuration
+func (d *AWSSecretEngineConfig) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	desiredState := d.Spec.AWSSEConfig.toMap()
+	delete(desiredState, "secret_key")
+	for key, value := range desiredState {
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *AWSSecretEngineConfig) IsInitialized() bool {
+	return true
+}
+
+func (d *AWSSecretEngineConfig) PrepareInternalValues(context context.Context, object client.Object) error {
+	if d.Spec.RootCredentials.Secret == nil && d.Spec.RootCredentials.VaultSecret == nil {
+		d.Spec.retrievedAccessKeyID = d.Spec.AccessKeyID
+		return nil
+	}
+	return d.setInternalCredentials(context)
+}
+
+func (d *AWSSecretEngineConfig) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *AWSSecretEngineConfig) IsValid() (bool, error) {
+	err := r.isValid()
+	return err == nil, err
+}
+
+func (r *AWSSecretEngineConfig) isValid() error {
+	if r.Spec.RootCredentials.RandomSecret != nil {
+		return errors.New("spec.rootCredentials.randomSecret is not supported, use spec.rootCredentials.secret or spec.rootCredentials.vaultSecret")
+	}
+	if r.Spec.RootCredentials.Secret != nil || r.Spec.RootCredentials.VaultSecret != nil {
+		if err := r.Spec.RootCredentials.ValidateEitherFromVaultSecretOrFromSecret(); err != nil {
+			return err
+		}
+	}
+	if (r.Spec.LeaseTTL.Duration == 0) != (r.Spec.LeaseMaxTTL.Duration == 0) {
+		return errors.New("spec.leaseTTL and spec.leaseMaxTTL must be specified together")
+	}
+	return nil
+}
+
+func (d *AWSSecretEngineConfig) GetLeasePath() string {
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "config/lease")
+}
+
+func (d *AWSSecretEngineConfig) GetLeasePayload() map[string]interface{} {
+	if d.Spec.LeaseTTL.Duration == 0 && d.Spec.LeaseMaxTTL.Duration == 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"lease":     d.Spec.LeaseTTL.Duration.String(),
+		"lease_max": d.Spec.LeaseMaxTTL.Duration.String(),
+	}
+}
+
+// IsLeaseEquivalentToDesiredState compares the lease configuration read from Vault, which returns durations such as 768h0m0s, with the desired one
+func (d *AWSSecretEngineConfig) IsLeaseEquivalentToDesiredState(payload map[string]interface{}) bool {
+	lease, err := time.ParseDuration(fmt.Sprint(payload["lease"]))
+	if err != nil {
+		return false
+	}
+	leaseMax, err := time.ParseDuration(fmt.Sprint(payload["lease_max"]))
+	if err != nil {
+		return false
+	}
+	return lease == d.Spec.LeaseTTL.Duration && leaseMax == d.Spec.LeaseMaxTTL.Duration
+}
+
+func (r *AWSSecretEngineConfig) setInternalCredentials(context context.Context) error {
+	log := log.FromContext(context)
+	if r.Spec.RootCredentials.Secret != nil {
+		kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+		if err != nil {
+			log.Error(err, "unable to retrieve kubernetes client")
+			return err
+		}
+		secret := &corev1.Secret{}
+		err = kubeClient.Get(context, types.NamespacedName{
+			Namespace: r.Namespace,
+			Name:      r.Spec.RootCredentials.Secret.Name,
+		}, secret)
+		if err != nil {
+			log.Error(err, "unable to retrieve Secret", "instance", r)
+			return err
+		}
+		r.setAccessKey(string(secret.Data[r.Spec.RootCredentials.UsernameKey]), string(secret.Data[r.Spec.RootCredentials.PasswordKey]))
+		return nil
+	}
+	if r.Spec.RootCredentials.VaultSecret != nil {
+		secret, exists, err := vaultutils.ReadSecret(context, string(r.Spec.RootCredentials.VaultSecret.Path))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			err = errors.New("secret not found")
+			log.Error(err, "unable to retrieve vault secret", "instance", r)
+			return err
+		}
+		accessKeyID, _ := secret.Data[r.Spec.RootCredentials.UsernameKey].(string)
+		secretAccessKey, _ := secret.Data[r.Spec.RootCredentials.PasswordKey].(string)
+		r.setAccessKey(accessKeyID, secretAccessKey)
+		return nil
+	}
+	return errors.New("no means of retrieving a secret was specified")
+}
+
+// setAccessKey records the retrieved credentials, spec.accessKeyID takes precedence over the retrieved access key ID
+func (r *AWSSecretEngineConfig) setAccessKey(accessKeyID string, secretAccessKey string) {
+	if r.Spec.AccessKeyID != "" {
+		accessKeyID = r.Spec.AccessKeyID
+	}
+	r.Spec.retrievedAccessKeyID = accessKeyID
+	r.Spec.retrievedSecretAccessKey = secretAccessKey
+}
+
+func (i *AWSSEConfig) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["access_key"] = i.retrievedAccessKeyID
+	payload["secret_key"] = i.retrievedSecretAccessKey
+	payload["region"] = i.Region
+	payload["iam_endpoint"] = i.IAMEndpoint
+	payload["sts_endpoint"] = i.STSEndpoint
+	payload["sts_region"] = i.STSRegion
+	payload["max_retries"] = i.MaxRetries
+	// Vault replaces an empty template with its default one
+	if i.UsernameTemplate != "" {
+		payload["username_template"] = i.UsernameTemplate
+	}
+	return payload
+}
+
+// AWSSecretEngineConfigStatus defines the observed state of AWSSecretEngineConfig
+type AWSSecretEngineConfigStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+}
+
+func (m *AWSSecretEngineConfig) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *AWSSecretEngineConfig) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *AWSSecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *AWSSecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *AWSSecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *AWSSecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *AWSSecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// AWSSecretEngineConfig is the Schema for the awssecretengineconfigs API
+type AWSSecretEngineConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSSecretEngineConfigSpec   `json:"spec,omitempty"`
+	Status AWSSecretEngineConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AWSSecretEngineConfigList contains a list of AWSSecretEngineConfig
+type AWSSecretEngineConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSSecretEngineConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSSecretEngineConfig{}, &AWSSecretEngineConfigList{})
+}
+
+func (d *AWSSecretEngineConfig) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
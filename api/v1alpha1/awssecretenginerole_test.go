@@ -0,0 +1,25 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAWSSecretEngineRolePayload(t *testing.T) {
+	role := &AWSSecretEngineRole{Spec: AWSSecretEngineRoleSpec{AWSSERole: AWSSERole{
+		CredentialType: "iam_user",
+		PolicyARNs:     []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		DefaultSTSTTL:  metav1.Duration{Duration: time.Hour},
+	}}}
+	payload := role.GetPayload()
+	for _, key := range []string{"role_arns", "default_sts_ttl", "max_sts_ttl", "user_path"} {
+		if _, ok := payload[key]; ok {
+			t.Errorf("expected %s not to be sent for the iam_user credential type", key)
+		}
+	}
+	if _, ok := payload["iam_tags"]; !ok {
+		t.Errorf("expected iam_tags to be sent for the iam_user credential type")
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AWSSecretEngineRoleSpec defines the desired state of AWSSecretEngineRole
+type AWSSecretEngineRoleSpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the AWS secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/roles/{metadata.name}.
+	// The authentication role must have the following capabilities = [ "create", "read", "update", "delete"] on that path.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	AWSSERole `json:",inline"`
+
+	// The name of the obejct created in Vault. If this is specified it takes precedence over {metatada.name}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
+	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type AWSSERole struct {
+	// CredentialType specifies the type of credential to be used when retrieving credentials from the role.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum:={"iam_user","assumed_role","federation_token"}
+	CredentialType string `json:"credentialType"`
+
+	// RoleARNs specifies the ARNs of the AWS roles this Vault role is allowed to assume. Required for the assumed_role credential type, not allowed otherwise.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	RoleARNs []string `json:"roleARNs,omitempty"`
+
+	// PolicyARNs specifies the ARNs of the AWS managed policies to be attached to IAM users, or used as session policies of the assumed roles and federation tokens.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	PolicyARNs []string `json:"policyARNs,omitempty"`
+
+	// PolicyDocument the IAM policy document, in JSON, attached as an inline policy to IAM users, or used as the session policy of the assumed roles and federation tokens.
+	// +kubebuilder:validation:Optional
+	PolicyDocument string `json:"policyDocument,omitempty"`
+
+	// IAMGroups specifies the names of the IAM groups IAM users are added to. For the assumed_role and federation_token credential types, the policies of the groups are used as session policies.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	IAMGroups []string `json:"iamGroups,omitempty"`
+
+	// IAMTags specifies the tags attached to the IAM users. Only for the iam_user credential type.
+	// +kubebuilder:validation:Optional
+	IAMTags map[string]string `json:"iamTags,omitempty"`
+
+	// DefaultSTSTTL the default TTL for STS credentials. When a TTL is not specified when STS credentials are requested, and a default TTL is specified on the role, then this default TTL will be used. Only for the assumed_role and federation_token credential types.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0s"
+	DefaultSTSTTL metav1.Duration `json:"defaultSTSTTL,omitempty"`
+
+	// MaxSTSTTL the max allowed TTL for STS credentials. Only for the assumed_role and federation_token credential types.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0s"
+	MaxSTSTTL metav1.Duration `json:"maxSTSTTL,omitempty"`
+
+	// UserPath the path of the IAM users, Vault uses / when not specified. Only for the iam_user credential type.
+	// +kubebuilder:validation:Optional
+	UserPath string `json:"userPath,omitempty"`
+
+	// PermissionsBoundaryARN the ARN of the AWS managed policy used as the permissions boundary of the IAM users. Only for the iam_user credential type.
+	// +kubebuilder:validation:Optional
+	PermissionsBoundaryARN string `json:"permissionsBoundaryARN,omitempty"`
+}
+
+var _ vaultutils.VaultObject = &AWSSecretEngineRole{}
+var _ vaultutils.ConditionsAware = &AWSSecretEngineRole{}
+
+func (d *AWSSecretEngineRole) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *AWSSecretEngineRole) IsDeletable() bool {
+	return true
+}
+
+func (d *AWSSecretEngineRole) GetPath() string {
+	if d.Spec.Name != "" {
+		return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "roles" + "/" + d.Spec.Name)
+	}
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "roles" + "/" + d.Name)
+}
+
+func (d *AWSSecretEngineRole) GetPayload() map[string]interface{} {
+	return d.Spec.AWSSERole.toMap()
+}
+
+// IsEquivalentToDesiredState compares the role read from Vault, which returns the TTLs in seconds and null for the empty lists, with the desired role
+func (d *AWSSecretEngineRole) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	for key, value := range d.Spec.AWSSERole.toMap() {
+		if isEmptyAWSRoleValue(value) && isEmptyAWSRoleValue(payload[key]) {
+			continue
+		}
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isEmptyAWSRoleValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return false
+}
+
+func (d *AWSSecretEngineRole) IsInitialized() bool {
+	return true
+}
+
+func (d *AWSSecretEngineRole) PrepareInternalValues(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (d *AWSSecretEngineRole) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *AWSSecretEngineRole) IsValid() (bool, error) {
+	err := r.Spec.AWSSERole.isValid()
+	return err == nil, err
+}
+
+func (i *AWSSERole) isValid() error {
+	if i.PolicyDocument != "" && !json.Valid([]byte(i.PolicyDocument)) {
+		return errors.New("policyDocument must be a valid JSON document")
+	}
+	if i.CredentialType == "assumed_role" {
+		if len(i.RoleARNs) == 0 {
+			return errors.New("roleARNs must be specified for the assumed_role credentialType")
+		}
+	} else if len(i.RoleARNs) != 0 {
+		return fmt.Errorf("roleARNs is not allowed for the %s credentialType", i.CredentialType)
+	}
+	if i.CredentialType != "iam_user" {
+		if len(i.IAMTags) != 0 || i.UserPath != "" || i.PermissionsBoundaryARN != "" {
+			return fmt.Errorf("iamTags, userPath and permissionsBoundaryARN are not allowed for the %s credentialType", i.CredentialType)
+		}
+	} else {
+		if i.DefaultSTSTTL.Duration != 0 || i.MaxSTSTTL.Duration != 0 {
+			return errors.New("defaultSTSTTL and maxSTSTTL are not allowed for the iam_user credentialType")
+		}
+	}
+	if i.CredentialType != "assumed_role" && i.PolicyDocument == "" && len(i.PolicyARNs) == 0 && len(i.IAMGroups) == 0 {
+		return fmt.Errorf("one of policyDocument, policyARNs or iamGroups must be specified for the %s credentialType", i.CredentialType)
+	}
+	return nil
+}
+
+// toMap returns the parameters that Vault accepts for the credential type of the role
+func (i *AWSSERole) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["credential_type"] = i.CredentialType
+	payload["policy_arns"] = i.PolicyARNs
+	payload["policy_document"] = compactPolicyDocument(i.PolicyDocument)
+	payload["iam_groups"] = i.IAMGroups
+	switch i.CredentialType {
+	case "iam_user":
+		payload["iam_tags"] = i.IAMTags
+		payload["permissions_boundary_arn"] = i.PermissionsBoundaryARN
+		if i.UserPath != "" {
+			payload["user_path"] = i.UserPath
+		}
+	case "assumed_role":
+		payload["role_arns"] = i.RoleARNs
+		payload["default_sts_ttl"] = int64(i.DefaultSTSTTL.Seconds())
+		payload["max_sts_ttl"] = int64(i.MaxSTSTTL.Seconds())
+	case "federation_token":
+		payload["default_sts_ttl"] = int64(i.DefaultSTSTTL.Seconds())
+		payload["max_sts_ttl"] = int64(i.MaxSTSTTL.Seconds())
+	}
+	return payload
+}
+
+// compactPolicyDocument returns the policy document compacted as Vault stores it
+func compactPolicyDocument(document string) string {
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, []byte(document)); err != nil {
+		return document
+	}
+	return compacted.String()
+}
+
+// AWSSecretEngineRoleStatus defines the observed state of AWSSecretEngineRole
+type AWSSecretEngineRoleStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+}
+
+func (m *AWSSecretEngineRole) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *AWSSecretEngineRole) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *AWSSecretEngineRole) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *AWSSecretEngineRole) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *AWSSecretEngineRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *AWSSecretEngineRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *AWSSecretEngineRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// AWSSecretEngineRole is the Schema for the awssecretengineroles API
+type AWSSecretEngineRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSSecretEngineRoleSpec   `json:"spec,omitempty"`
+	Status AWSSecretEngineRoleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AWSSecretEngineRoleList contains a list of AWSSecretEngineRole
+type AWSSecretEngineRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSSecretEngineRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSSecretEngineRole{}, &AWSSecretEngineRoleList{})
+}
+
+func (d *AWSSecretEngineRole) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
@@ -49,6 +49,12 @@ type AzureAuthEngineConfigSpec struct {
 	// AzureCredentials consists in ClientID and ClientSecret, which can be created as Kubernetes Secret, VaultSecret or RandomSecret
 	// +kubebuilder:validation:Optional
 	AzureCredentials vaultutils.RootCredentialConfig `json:"azureCredentials,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 // AzureAuthEngineConfigStatus defines the observed state of AzureAuthEngineConfig
@@ -58,6 +64,14 @@ type AzureAuthEngineConfigStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -146,6 +160,26 @@ func (r *AzureAuthEngineConfig) SetConditions(conditions []metav1.Condition) {
 	r.Status.Conditions = conditions
 }
 
+func (r *AzureAuthEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return r.Status.Drift
+}
+
+func (r *AzureAuthEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	r.Status.Drift = report
+}
+
+func (r *AzureAuthEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return r.Spec.ManagementPolicy
+}
+
+func (r *AzureAuthEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return r.Status.Ownership
+}
+
+func (r *AzureAuthEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	r.Status.Ownership = ownership
+}
+
 func (r *AzureAuthEngineConfig) SetClientIDAndClientSecret(ClientID string, ClientSecret string) {
 	r.Spec.AzureConfig.retrievedClientID = ClientID
 	r.Spec.AzureConfig.retrievedClientPassword = ClientSecret
@@ -191,7 +225,11 @@ func (r *AzureAuthEngineConfig) PrepareTLSConfig(context context.Context, object
 
 func (r *AzureAuthEngineConfig) setInternalCredentials(context context.Context) error {
 	log := log.FromContext(context)
-	kubeClient := context.Value("kubeClient").(client.Client)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
 	if r.Spec.AzureCredentials.RandomSecret != nil {
 		randomSecret := &RandomSecret{}
 		err := kubeClient.Get(context, types.NamespacedName{
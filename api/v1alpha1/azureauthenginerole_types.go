@@ -42,6 +42,12 @@ type AzureAuthEngineRoleSpec struct {
 	Path vaultutils.Path `json:"path,omitempty"`
 
 	AzureRole `json:",inline"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 // AzureAuthEngineRoleStatus defines the observed state of AzureAuthEngineRole
@@ -51,6 +57,14 @@ type AzureAuthEngineRoleStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -200,6 +214,26 @@ func (r *AzureAuthEngineRole) SetConditions(conditions []metav1.Condition) {
 	r.Status.Conditions = conditions
 }
 
+func (r *AzureAuthEngineRole) GetDriftReport() *vaultutils.DriftReport {
+	return r.Status.Drift
+}
+
+func (r *AzureAuthEngineRole) SetDriftReport(report *vaultutils.DriftReport) {
+	r.Status.Drift = report
+}
+
+func (r *AzureAuthEngineRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return r.Spec.ManagementPolicy
+}
+
+func (r *AzureAuthEngineRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return r.Status.Ownership
+}
+
+func (r *AzureAuthEngineRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	r.Status.Ownership = ownership
+}
+
 func (d *AzureAuthEngineRole) GetVaultConnection() *vaultutils.VaultConnection {
 	return d.Spec.Connection
 }
@@ -51,6 +51,12 @@ type AzureSecretEngineConfigSpec struct {
 
 	// +kubebuilder:validation:Required
 	AzureSEConfig `json:",inline"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 // AzureSecretEngineConfigStatus defines the observed state of AzureSecretEngineConfig
@@ -60,6 +66,14 @@ type AzureSecretEngineConfigStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -136,6 +150,26 @@ func (r *AzureSecretEngineConfig) SetConditions(conditions []metav1.Condition) {
 	r.Status.Conditions = conditions
 }
 
+func (r *AzureSecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return r.Status.Drift
+}
+
+func (r *AzureSecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	r.Status.Drift = report
+}
+
+func (r *AzureSecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return r.Spec.ManagementPolicy
+}
+
+func (r *AzureSecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return r.Status.Ownership
+}
+
+func (r *AzureSecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	r.Status.Ownership = ownership
+}
+
 func (d *AzureSecretEngineConfig) GetVaultConnection() *vaultutils.VaultConnection {
 	return d.Spec.Connection
 }
@@ -189,7 +223,11 @@ func (d *AzureSecretEngineConfig) PrepareTLSConfig(context context.Context, obje
 
 func (r *AzureSecretEngineConfig) setInternalCredentials(context context.Context) error {
 	log := log.FromContext(context)
-	kubeClient := context.Value("kubeClient").(client.Client)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
 	if r.Spec.AzureCredentials.RandomSecret != nil {
 		randomSecret := &RandomSecret{}
 		err := kubeClient.Get(context, types.NamespacedName{
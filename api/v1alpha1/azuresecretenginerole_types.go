@@ -47,6 +47,12 @@ type AzureSecretEngineRoleSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 // AzureSecretEngineRoleStatus defines the observed state of AzureSecretEngineRole
@@ -56,6 +62,14 @@ type AzureSecretEngineRoleStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -193,6 +207,26 @@ func (r *AzureSecretEngineRole) SetConditions(conditions []metav1.Condition) {
 	r.Status.Conditions = conditions
 }
 
+func (r *AzureSecretEngineRole) GetDriftReport() *vaultutils.DriftReport {
+	return r.Status.Drift
+}
+
+func (r *AzureSecretEngineRole) SetDriftReport(report *vaultutils.DriftReport) {
+	r.Status.Drift = report
+}
+
+func (r *AzureSecretEngineRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return r.Spec.ManagementPolicy
+}
+
+func (r *AzureSecretEngineRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return r.Status.Ownership
+}
+
+func (r *AzureSecretEngineRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	r.Status.Ownership = ownership
+}
+
 func (i *AzureSERole) toMap() map[string]interface{} {
 	payload := map[string]interface{}{}
 	payload["azure_roles"] = i.AzureRoles
@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClusterVaultSecretIsValid(t *testing.T) {
+	output := TemplatizedK8sSecret{Name: "pull-secret", Type: "kubernetes.io/dockerconfigjson", StringData: map[string]string{".dockerconfigjson": "{}"}}
+	tests := []struct {
+		name             string
+		output           TemplatizedK8sSecret
+		targetNamespaces vaultutils.TargetNamespaceConfig
+		valid            bool
+	}{
+		{
+			name:             "namespace selector",
+			output:           output,
+			targetNamespaces: vaultutils.TargetNamespaceConfig{TargetNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"registry-access": "true"}}},
+			valid:            true,
+		},
+		{
+			name:             "namespace list",
+			output:           output,
+			targetNamespaces: vaultutils.TargetNamespaceConfig{TargetNamespaces: []string{"team-a", "team-b"}},
+			valid:            true,
+		},
+		{
+			name:   "namespace selector and list",
+			output: output,
+			targetNamespaces: vaultutils.TargetNamespaceConfig{
+				TargetNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"registry-access": "true"}},
+				TargetNamespaces:        []string{"team-a"},
+			},
+			valid: false,
+		},
+		{
+			name:   "no target namespaces",
+			output: output,
+			valid:  false,
+		},
+		{
+			name:             "invalid namespace selector",
+			output:           output,
+			targetNamespaces: vaultutils.TargetNamespaceConfig{TargetNamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}}}},
+			valid:            false,
+		},
+		{
+			name:             "invalid output",
+			output:           TemplatizedK8sSecret{Name: "pull-secret", Kind: "ConfigMap", Template: "data: {}"},
+			targetNamespaces: vaultutils.TargetNamespaceConfig{TargetNamespaces: []string{"team-a"}},
+			valid:            false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterVaultSecret := &ClusterVaultSecret{Spec: ClusterVaultSecretSpec{TemplatizedK8sSecret: tt.output, TargetNamespaces: tt.targetNamespaces}}
+			valid, err := clusterVaultSecret.IsValid()
+			if valid != tt.valid {
+				t.Errorf("expected valid to be %v, got %v (%v)", tt.valid, valid, err)
+			}
+		})
+	}
+}
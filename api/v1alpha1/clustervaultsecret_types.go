@@ -0,0 +1,161 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterVaultSecretSpec defines the desired state of ClusterVaultSecret
+type ClusterVaultSecretSpec struct {
+
+	// RefreshPeriod if specified, the operator will refresh the secret with the given frequency.
+	// This takes precedence over any vault secret lease duration and can be used to force a refresh.
+	// +kubebuilder:validation:Optional
+	RefreshPeriod *metav1.Duration `json:"refreshPeriod,omitempty"`
+	// RefreshThreshold if specified, will instruct the operator to refresh when a percentage of the lease duration is met when there is no RefreshPeriod specified.
+	// The default is 90, meaning the secret would refresh after 90% of the time has passed from the vault secret's lease duration.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:default=90
+	RefreshThreshold int `json:"refreshThreshold,omitempty"`
+	// LeaseRevocationGracePeriod is the time the leases of the secrets are kept after new credentials were written to the output, before they are revoked, so that the workloads still using the previous credentials have time to pick up the new ones.
+	// The default is 5m, 0s revokes the previous leases as soon as the output is written.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5m"
+	LeaseRevocationGracePeriod *metav1.Duration `json:"leaseRevocationGracePeriod,omitempty"`
+	// AuthenticationNamespace is the namespace of the service accounts and secrets used to authenticate to Vault, and of the NamespacedVaultConnections referenced by the vaultSecretDefinitions.
+	// +kubebuilder:validation:Required
+	AuthenticationNamespace string `json:"authenticationNamespace,omitempty"`
+	// VaultSecretDefinitions are the secrets in Vault. They are read once for all the target namespaces.
+	// +kubebuilder:validation:Required
+	VaultSecretDefinitions []VaultSecretDefinition `json:"vaultSecretDefinitions,omitempty"`
+	// TemplatizedK8sSecret is the formatted K8s Secret created by templating from the Vault KV secrets, in each of the target namespaces.
+	// +kubebuilder:validation:Required
+	TemplatizedK8sSecret TemplatizedK8sSecret `json:"output,omitempty"`
+	// TargetNamespaces specifies the namespaces to output to.
+	// +kubebuilder:validation:Required
+	TargetNamespaces vaultutils.TargetNamespaceConfig `json:"targetNamespaces,omitempty"`
+}
+
+// ClusterVaultSecretStatus defines the observed state of ClusterVaultSecret
+type ClusterVaultSecretStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	//LastVaultSecretUpdate the last time when the secrets were read from Vault
+	LastVaultSecretUpdate *metav1.Time `json:"lastVaultSecretUpdate,omitempty"`
+
+	//LastLeaseRenewal the last time when the leases of the secrets were renewed, without updating the outputs
+	LastLeaseRenewal *metav1.Time `json:"lastLeaseRenewal,omitempty"`
+
+	//NextVaultSecretUpdate the next time when the secrets will be synced with Vault. If nil, they will not be refreshed.
+	NextVaultSecretUpdate *metav1.Time `json:"nextVaultSecretUpdate,omitempty"`
+
+	//VaultSecretDefinitionsStatus information used to determine if the secrets should be rereconciled
+	VaultSecretDefinitionsStatus []VaultSecretDefinitionStatus `json:"vaultSecretDefinitionsStatus,omitempty"`
+
+	//TargetNamespaces the namespaces the output was written to, used to remove the output from the namespaces that are no longer targeted
+	// +listType=set
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
+
+	//TemplateErrors the errors rendering the templates of the output at the last sync, by key
+	// +listType=map
+	// +listMapKey=key
+	TemplateErrors []TemplateError `json:"templateErrors,omitempty"`
+
+	//SupersededLeases the leases of the secrets no longer written to the output, revoked once their grace period has elapsed
+	// +listType=atomic
+	SupersededLeases []SupersededLease `json:"supersededLeases,omitempty"`
+}
+
+var _ vaultutils.ConditionsAware = &ClusterVaultSecret{}
+
+func (vs *ClusterVaultSecret) GetConditions() []metav1.Condition {
+	return vs.Status.Conditions
+}
+
+func (vs *ClusterVaultSecret) SetConditions(conditions []metav1.Condition) {
+	vs.Status.Conditions = conditions
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+
+// ClusterVaultSecret is the Schema for the clustervaultsecrets API. It reads secrets from Vault once and outputs them to every target namespace.
+type ClusterVaultSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterVaultSecretSpec   `json:"spec,omitempty"`
+	Status ClusterVaultSecretStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterVaultSecretList contains a list of ClusterVaultSecret
+type ClusterVaultSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterVaultSecret `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterVaultSecret{}, &ClusterVaultSecretList{})
+}
+
+func (vs *ClusterVaultSecret) IsValid() (bool, error) {
+	err := vs.isValid()
+	return err == nil, err
+}
+
+func (vs *ClusterVaultSecret) isValid() error {
+	result := &multierror.Error{}
+	result = multierror.Append(result, vs.Spec.TemplatizedK8sSecret.isValid())
+	for i := range vs.Spec.VaultSecretDefinitions {
+		result = multierror.Append(result, vs.Spec.VaultSecretDefinitions[i].isValid())
+	}
+	result = multierror.Append(result, vs.validateEitherTargetNamespaceSelectorOrTargetNamespace())
+	if vs.Spec.TargetNamespaces.TargetNamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(vs.Spec.TargetNamespaces.TargetNamespaceSelector); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid targetNamespaces.targetNamespaceSelector: %w", err))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+func (vs *ClusterVaultSecret) validateEitherTargetNamespaceSelectorOrTargetNamespace() error {
+	count := 0
+	if vs.Spec.TargetNamespaces.TargetNamespaceSelector != nil {
+		count++
+	}
+	if vs.Spec.TargetNamespaces.TargetNamespaces != nil {
+		count++
+	}
+	if count != 1 {
+		return errors.New("only one of TargetNamespaceSelector or TargetNamespaces can be specified")
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var clustervaultsecretlog = logf.Log.WithName("clustervaultsecret-resource")
+
+func (r *ClusterVaultSecret) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+
+//+kubebuilder:webhook:path=/mutate-redhatcop-redhat-io-v1alpha1-clustervaultsecret,mutating=true,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=clustervaultsecrets,verbs=create;update,versions=v1alpha1,name=mclustervaultsecret.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Defaulter = &ClusterVaultSecret{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ClusterVaultSecret) Default() {
+	// clustervaultsecretlog.Info("default", "name", r.Name)
+}
+
+// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
+//+kubebuilder:webhook:path=/validate-redhatcop-redhat-io-v1alpha1-clustervaultsecret,mutating=false,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=clustervaultsecrets,verbs=create;update,versions=v1alpha1,name=vclustervaultsecret.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ClusterVaultSecret{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterVaultSecret) ValidateCreate() (admission.Warnings, error) {
+	clustervaultsecretlog.Info("validate create", "name", r.Name)
+	return nil, r.isValid()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterVaultSecret) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	clustervaultsecretlog.Info("validate update", "name", r.Name)
+	return nil, r.isValid()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterVaultSecret) ValidateDelete() (admission.Warnings, error) {
+	clustervaultsecretlog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
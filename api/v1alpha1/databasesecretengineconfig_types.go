@@ -21,7 +21,6 @@ import (
 	"errors"
 	"reflect"
 
-	vault "github.com/hashicorp/vault/api"
 	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -61,6 +60,12 @@ type DatabaseSecretEngineConfigSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 var _ vaultutils.VaultObject = &DatabaseSecretEngineConfig{}
@@ -141,7 +146,11 @@ func (r *DatabaseSecretEngineConfig) setInternalCredentials(context context.Cont
 		return nil
 	}
 
-	kubeClient := context.Value("kubeClient").(client.Client)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
 	if r.Spec.RootCredentials.RandomSecret != nil {
 		randomSecret := &RandomSecret{}
 		err := kubeClient.Get(context, types.NamespacedName{
@@ -293,6 +302,14 @@ type DatabaseSecretEngineConfigStatus struct {
 
 	// +kubebuilder:validation:Optional
 	LastRootPasswordRotation metav1.Time `json:"lastRootPasswordRotation,omitempty"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 var _ vaultutils.ConditionsAware = &DatabaseSecretEngineConfig{}
@@ -305,6 +322,26 @@ func (m *DatabaseSecretEngineConfig) SetConditions(conditions []metav1.Condition
 	m.Status.Conditions = conditions
 }
 
+func (m *DatabaseSecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *DatabaseSecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *DatabaseSecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *DatabaseSecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *DatabaseSecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 func (m *DatabaseSecretEngineConfig) SetUsernameAndPassword(username string, password string) {
 	m.Spec.DBSEConfig.retrievedUsername = username
 	m.Spec.DBSEConfig.retrievedPassword = password
@@ -371,8 +408,7 @@ func (d *DatabaseSecretEngineConfig) GetKubeAuthConfiguration() *vaultutils.Kube
 
 func (d *DatabaseSecretEngineConfig) RotateRootPassword(ctx context.Context) error {
 	log := log.FromContext(ctx)
-	vaultClient := ctx.Value("vaultClient").(*vault.Client)
-	_, err := vaultClient.Logical().WriteWithContext(ctx, d.GetRootPasswordRotationPath(), nil)
+	_, err := vaultutils.WriteSecret(ctx, d.GetRootPasswordRotationPath(), nil)
 	if err != nil {
 		log.Error(err, "unable to rotate root password", "instance", d)
 		return err
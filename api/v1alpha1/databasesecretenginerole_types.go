@@ -53,6 +53,12 @@ type DatabaseSecretEngineRoleSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 var _ vaultutils.VaultObject = &DatabaseSecretEngineRole{}
@@ -145,6 +151,14 @@ type DatabaseSecretEngineRoleStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 func (m *DatabaseSecretEngineRole) GetConditions() []metav1.Condition {
@@ -155,6 +169,26 @@ func (m *DatabaseSecretEngineRole) SetConditions(conditions []metav1.Condition)
 	m.Status.Conditions = conditions
 }
 
+func (m *DatabaseSecretEngineRole) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *DatabaseSecretEngineRole) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *DatabaseSecretEngineRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *DatabaseSecretEngineRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *DatabaseSecretEngineRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
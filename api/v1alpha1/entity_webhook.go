@@ -0,0 +1,77 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var entitylog = logf.Log.WithName("entity-resource")
+
+func (r *Entity) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-redhatcop-redhat-io-v1alpha1-entity,mutating=true,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=entities,verbs=create,versions=v1alpha1,name=mentity.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Entity{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Entity) Default() {
+	entitylog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-redhatcop-redhat-io-v1alpha1-entity,mutating=false,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=entities,verbs=create;update,versions=v1alpha1,name=ventity.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Entity{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Entity) ValidateCreate() (admission.Warnings, error) {
+	entitylog.Info("validate create", "name", r.Name)
+
+	_, err := r.IsValid()
+	return nil, err
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Entity) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	entitylog.Info("validate update", "name", r.Name)
+	oldEntity := old.(*Entity)
+
+	// the entity is identified by its name in Vault
+	if r.GetEntityName() != oldEntity.GetEntityName() {
+		return nil, errors.New("the name of the entity cannot be updated")
+	}
+	_, err := r.IsValid()
+	return nil, err
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Entity) ValidateDelete() (admission.Warnings, error) {
+	entitylog.Info("validate delete", "name", r.Name)
+
+	return nil, nil
+}
@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	"errors"
+	"testing"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEntityAliasCreateOnlyRecordsOwnership(t *testing.T) {
+	alias := &EntityAlias{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice", Namespace: "team-a"},
+		Spec: EntityAliasSpec{
+			EntityAliasConfig: EntityAliasConfig{AuthEngineMountPath: "ldap", EntityName: "alice"},
+			ManagementPolicy:  vaultutils.ManagementPolicyCreateOnly,
+		},
+	}
+	ctx := newIdentityTestContext(t, map[string]map[string]interface{}{
+		"GET /v1/sys/auth/ldap":              {"accessor": "auth_ldap_5a8c4d21"},
+		"GET /v1/identity/entity/name/alice": {"id": "8d6a45e5"},
+		"PUT /v1/identity/entity-alias":      {"id": "34982d3d"},
+	}, alias)
+
+	if err := alias.PrepareInternalValues(ctx, alias); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alias.Status.ID != "34982d3d" || alias.Status.Ownership != vaultutils.VaultOwnershipCreated {
+		t.Errorf("expected the created alias to be recorded as created, got id %q and ownership %q", alias.Status.ID, alias.Status.Ownership)
+	}
+	if err := vaultutils.CheckManagementPolicy(alias, alias.GetPath(), true, map[string]interface{}{"id": "34982d3d"}); err != nil {
+		t.Errorf("expected the alias created by the resource to satisfy the CreateOnly policy, got %v", err)
+	}
+}
+
+func TestEntityAliasCreateOnlyExistingAliasIsNotOwned(t *testing.T) {
+	alias := &EntityAlias{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice", Namespace: "team-a"},
+		Spec: EntityAliasSpec{
+			EntityAliasConfig: EntityAliasConfig{AuthEngineMountPath: "ldap", EntityName: "alice"},
+			ManagementPolicy:  vaultutils.ManagementPolicyCreateOnly,
+		},
+	}
+	// the alias was created by a login
+	ctx := newIdentityTestContext(t, map[string]map[string]interface{}{
+		"GET /v1/sys/auth/ldap":              {"accessor": "auth_ldap_5a8c4d21"},
+		"GET /v1/identity/entity/name/alice": {"id": "8d6a45e5"},
+		"PUT /v1/identity/lookup/entity": {"aliases": []interface{}{
+			map[string]interface{}{"id": "34982d3d", "name": "alice", "mount_accessor": "auth_ldap_5a8c4d21"},
+		}},
+	}, alias)
+
+	err := alias.PrepareInternalValues(ctx, alias)
+	if !errors.Is(err, vaultutils.ErrVaultObjectNotOwned) {
+		t.Fatalf("expected the existing alias not to be owned, got %v", err)
+	}
+	if alias.Status.ID != "" || alias.Status.Ownership != "" {
+		t.Errorf("expected the existing alias not to be recorded, got id %q and ownership %q", alias.Status.ID, alias.Status.Ownership)
+	}
+}
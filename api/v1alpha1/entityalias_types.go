@@ -0,0 +1,304 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// EntityAliasSpec defines the desired state of EntityAlias
+type EntityAliasSpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	EntityAliasConfig `json:",inline"`
+
+	retrievedMountAccessor string `json:"-"`
+
+	retrievedCanonicalID string `json:"-"`
+
+	retrievedName string `json:"-"`
+
+	// The name of the obejct created in Vault. If this is specified it takes precedence over {metatada.name}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
+	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type EntityAliasConfig struct {
+	// AuthEngineMountPath the path of the auth engine mount the alias is bound to, for example oidc or ldap. The alias name is the name the auth engine gives to the user, such as its username.
+	// +kubebuilder:validation:Required
+	AuthEngineMountPath string `json:"authEngineMountPath,omitempty"`
+
+	// EntityName the name of the entity in Vault the alias belongs to.
+	// +kubebuilder:validation:Required
+	EntityName string `json:"entityName,omitempty"`
+
+	// CustomMetadata custom metadata to be associated with the alias.
+	// +kubebuilder:validation:Optional
+	// +mapType=granular
+	CustomMetadata map[string]string `json:"customMetadata,omitempty"`
+}
+
+// EntityAliasStatus defines the observed state of EntityAlias
+type EntityAliasStatus struct {
+	// +kubebuilder:validation:Optional
+	ID string `json:"id,omitempty"`
+
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// EntityAlias is the Schema for the entityaliases API
+type EntityAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EntityAliasSpec   `json:"spec,omitempty"`
+	Status EntityAliasStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EntityAliasList contains a list of EntityAlias
+type EntityAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EntityAlias `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EntityAlias{}, &EntityAliasList{})
+}
+
+var _ vaultutils.VaultObject = &EntityAlias{}
+var _ vaultutils.ConditionsAware = &EntityAlias{}
+
+func (m *EntityAlias) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (d *EntityAlias) IsDeletable() bool {
+	return true
+}
+
+func (m *EntityAlias) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *EntityAlias) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *EntityAlias) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *EntityAlias) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *EntityAlias) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *EntityAlias) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+func (d *EntityAlias) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *EntityAlias) GetPath() string {
+	return vaultutils.CleansePath("/identity/entity-alias/id/" + d.Status.ID)
+}
+
+func (d *EntityAlias) GetPayload() map[string]interface{} {
+	return d.Spec.toMap()
+}
+
+func (i *EntityAliasSpec) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["name"] = i.retrievedName
+	payload["mount_accessor"] = i.retrievedMountAccessor
+	payload["canonical_id"] = i.retrievedCanonicalID
+	payload["custom_metadata"] = i.CustomMetadata
+	return payload
+}
+
+func (d *EntityAlias) IsInitialized() bool {
+	return true
+}
+
+func (d *EntityAlias) PrepareInternalValues(context context.Context, object client.Object) error {
+	log := log.FromContext(context)
+	// let find the auth engine mount accessor
+	secret, found, err := vaultutils.ReadSecret(context, vaultutils.CleansePath("sys/auth/"+d.Spec.AuthEngineMountPath))
+	if err != nil {
+		log.Error(err, "unable to retrieve authEngineMount", "path", d.Spec.AuthEngineMountPath)
+		return err
+	}
+	if !found {
+		err = errors.New("auth engine not found")
+		log.Error(err, "authEngineMount not found at path", "path", d.Spec.AuthEngineMountPath)
+		return err
+	}
+	d.Spec.retrievedMountAccessor = secret.Data["accessor"].(string)
+
+	secret, found, err = vaultutils.ReadSecret(context, vaultutils.CleansePath("/identity/entity/name/"+d.Spec.EntityName))
+	if err != nil {
+		log.Error(err, "unable to retrieve entity", "name", d.Spec.EntityName)
+		return err
+	}
+	if !found {
+		err = errors.New("entity not found")
+		log.Error(err, "entity not found", "name", d.Spec.EntityName)
+		return err
+	}
+	d.Spec.retrievedCanonicalID = secret.Data["id"].(string)
+	if d.Spec.Name != "" {
+		d.Spec.retrievedName = d.Spec.Name
+	} else {
+		d.Spec.retrievedName = d.Name
+	}
+
+	if d.Status.ID == "" {
+		id, err := d.lookupAliasID(context)
+		if err != nil {
+			return err
+		}
+		if id == "" {
+			//we have to create the entity alias as unfortunately this api is asymmetric
+			payload := map[string]interface{}{
+				"name":           d.Spec.retrievedName,
+				"mount_accessor": d.Spec.retrievedMountAccessor,
+				"canonical_id":   d.Spec.retrievedCanonicalID,
+			}
+			log.V(1).Info("create entity alias", "payload", payload)
+			if vaultutils.PlanOperation(context, vaultutils.VaultWrite, "/identity/entity-alias") {
+				return nil
+			}
+			result, err := vaultutils.WriteSecret(context, "/identity/entity-alias", payload)
+			if err != nil {
+				log.Error(err, "unable to create entity alias", "entity alias", d.Spec)
+				return err
+			}
+			id = result.Data["id"].(string)
+			// the alias did not exist in Vault, the ownership is recorded with its id so that the management policy sees it as created by this resource
+			vaultutils.RecordVaultOwnership(d, false)
+		} else if d.GetManagementPolicy() == vaultutils.ManagementPolicyCreateOnly {
+			err := fmt.Errorf("%w: %s, the managementPolicy is %s", vaultutils.ErrVaultObjectNotOwned, vaultutils.CleansePath("/identity/entity-alias/id/"+id), vaultutils.ManagementPolicyCreateOnly)
+			log.Error(err, "unable to manage existing entity alias", "name", d.Spec.retrievedName)
+			return err
+		}
+		d.Status.ID = id
+		kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+		if err != nil {
+			log.Error(err, "unable to retrieve kubernetes client")
+			return err
+		}
+		err = kubeClient.Status().Update(context, d, &client.SubResourceUpdateOptions{})
+		if err != nil {
+			log.Error(err, "unable to update entity alias status, your kube and vault systems may now be inconsistent", "instance", d)
+			return err
+		}
+	}
+	return nil
+}
+
+// lookupAliasID returns the id of the alias with the same name on the same auth engine mount, for example created by a login, or an empty string
+func (d *EntityAlias) lookupAliasID(context context.Context) (string, error) {
+	secret, found, err := vaultutils.ReadSecretWithPayload(context, "/identity/lookup/entity", map[string]string{
+		"alias_name":           d.Spec.retrievedName,
+		"alias_mount_accessor": d.Spec.retrievedMountAccessor,
+	})
+	if err != nil || !found {
+		return "", err
+	}
+	aliases, _ := secret.Data["aliases"].([]interface{})
+	for _, alias := range aliases {
+		alias, ok := alias.(map[string]interface{})
+		if ok && alias["name"] == d.Spec.retrievedName && alias["mount_accessor"] == d.Spec.retrievedMountAccessor {
+			id, _ := alias["id"].(string)
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+func (d *EntityAlias) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *EntityAlias) IsValid() (bool, error) {
+	err := r.isValid()
+	return err == nil, err
+}
+
+func (r *EntityAlias) isValid() error {
+	if r.Spec.AuthEngineMountPath == "" || r.Spec.EntityName == "" {
+		return errors.New("spec.authEngineMountPath and spec.entityName must be specified")
+	}
+	return nil
+}
+
+func (d *EntityAlias) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
+
+// IsEquivalentToDesiredState compares the alias read from Vault, which also returns its id, creation time and mount details, with the desired alias
+func (d *EntityAlias) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	for key, value := range d.Spec.toMap() {
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return true
+}
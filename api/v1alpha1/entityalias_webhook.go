@@ -0,0 +1,77 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var entityaliaslog = logf.Log.WithName("entityalias-resource")
+
+func (r *EntityAlias) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-redhatcop-redhat-io-v1alpha1-entityalias,mutating=true,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=entityaliases,verbs=create,versions=v1alpha1,name=mentityalias.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &EntityAlias{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *EntityAlias) Default() {
+	entityaliaslog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-redhatcop-redhat-io-v1alpha1-entityalias,mutating=false,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=entityaliases,verbs=create;update,versions=v1alpha1,name=ventityalias.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &EntityAlias{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *EntityAlias) ValidateCreate() (admission.Warnings, error) {
+	entityaliaslog.Info("validate create", "name", r.Name)
+
+	_, err := r.IsValid()
+	return nil, err
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *EntityAlias) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	entityaliaslog.Info("validate update", "name", r.Name)
+	oldEntityAlias := old.(*EntityAlias)
+
+	// an alias cannot be moved to another auth engine mount
+	if r.Spec.AuthEngineMountPath != oldEntityAlias.Spec.AuthEngineMountPath {
+		return nil, errors.New("spec.authEngineMountPath cannot be updated")
+	}
+	_, err := r.IsValid()
+	return nil, err
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *EntityAlias) ValidateDelete() (admission.Warnings, error) {
+	entityaliaslog.Info("validate delete", "name", r.Name)
+
+	return nil, nil
+}
@@ -50,6 +50,12 @@ type GCPAuthEngineConfigSpec struct {
 	// GCPCredentials in JSON string containing the contents of a GCP service account credentials file.
 	// +kubebuilder:validation:Optional
 	GCPCredentials vaultutils.RootCredentialConfig `json:"GCPCredentials,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 // GCPAuthEngineConfigStatus defines the observed state of GCPAuthEngineConfig
@@ -59,6 +65,14 @@ type GCPAuthEngineConfigStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -159,6 +173,26 @@ func (r *GCPAuthEngineConfig) SetConditions(conditions []metav1.Condition) {
 	r.Status.Conditions = conditions
 }
 
+func (r *GCPAuthEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return r.Status.Drift
+}
+
+func (r *GCPAuthEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	r.Status.Drift = report
+}
+
+func (r *GCPAuthEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return r.Spec.ManagementPolicy
+}
+
+func (r *GCPAuthEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return r.Status.Ownership
+}
+
+func (r *GCPAuthEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	r.Status.Ownership = ownership
+}
+
 func (r *GCPAuthEngineConfig) SetServiceAccountAndCredentials(ServiceAccount string, Credentials string) {
 	r.Spec.GCPConfig.retrievedServiceAccount = ServiceAccount
 	r.Spec.GCPConfig.retrievedCredentials = Credentials
@@ -204,7 +238,11 @@ func (r *GCPAuthEngineConfig) PrepareTLSConfig(context context.Context, object c
 
 func (r *GCPAuthEngineConfig) setInternalCredentials(context context.Context) error {
 	log := log.FromContext(context)
-	kubeClient := context.Value("kubeClient").(client.Client)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
 	if r.Spec.GCPCredentials.RandomSecret != nil {
 		randomSecret := &RandomSecret{}
 		err := kubeClient.Get(context, types.NamespacedName{
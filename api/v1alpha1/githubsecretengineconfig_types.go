@@ -21,7 +21,6 @@ import (
 	"errors"
 	"reflect"
 
-	vault "github.com/hashicorp/vault/api"
 	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -57,6 +56,12 @@ type GitHubSecretEngineConfigSpec struct {
 	// SSHKeyReference allows ofr options to retrieve the ssh key. For security reasons it is never displayed.
 	// +kubebuilder:validation:Required
 	SSHKeyReference SSHKeyConfig `json:"sSHKeyReference,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 type GHConfig struct {
@@ -131,8 +136,11 @@ func (r *GitHubSecretEngineConfig) IsValid() (bool, error) {
 
 func (r *GitHubSecretEngineConfig) setInternalCredentials(context context.Context) error {
 	log := log.FromContext(context)
-	kubeClient := context.Value("kubeClient").(client.Client)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
 	if r.Spec.SSHKeyReference.Secret != nil {
 		secret := &corev1.Secret{}
 		err := kubeClient.Get(context, types.NamespacedName{
@@ -152,11 +160,16 @@ func (r *GitHubSecretEngineConfig) setInternalCredentials(context context.Contex
 		return nil
 	}
 	if r.Spec.SSHKeyReference.VaultSecret != nil {
-		secret, err := vaultClient.Logical().Read(string(r.Spec.SSHKeyReference.VaultSecret.Path))
+		secret, exists, err := vaultutils.ReadSecret(context, string(r.Spec.SSHKeyReference.VaultSecret.Path))
 		if err != nil {
 			log.Error(err, "unable to retrieve vault secret", "instance", r)
 			return err
 		}
+		if !exists {
+			err = errors.New("secret not found")
+			log.Error(err, "unable to retrieve vault secret", "instance", r)
+			return err
+		}
 		r.Spec.retrievedSSHKey = secret.Data["key"].(string)
 		return nil
 	}
@@ -172,6 +185,14 @@ type GitHubSecretEngineConfigStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 var _ vaultutils.ConditionsAware = &GitHubSecretEngineConfig{}
@@ -184,6 +205,26 @@ func (m *GitHubSecretEngineConfig) SetConditions(conditions []metav1.Condition)
 	m.Status.Conditions = conditions
 }
 
+func (m *GitHubSecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *GitHubSecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *GitHubSecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *GitHubSecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *GitHubSecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -18,11 +18,15 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -40,10 +44,18 @@ type GroupSpec struct {
 
 	GroupConfig `json:",inline"`
 
+	retrievedMemberEntityIDs []string `json:"-"`
+
 	// The name of the obejct created in Vault. If this is specified it takes precedence over {metatada.name}
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 type GroupConfig struct {
@@ -71,7 +83,7 @@ type GroupConfig struct {
 	// kubebuilder:validation:UniqueItems=true
 	MemberGroupIDs []string `json:"memberGroupIDs,omitempty"`
 
-	// MemberEntityIDs Entity IDs to be assigned as group members.
+	// MemberEntityIDs Entity IDs to be assigned as group members. The names of Entity resources in the namespace of the group can be used instead of the IDs, they are resolved to the IDs of the corresponding Vault entities.
 	// +kubebuilder:validation:Optional
 	// +listType=set
 	// kubebuilder:validation:UniqueItems=true
@@ -85,6 +97,14 @@ type GroupStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -114,6 +134,7 @@ func init() {
 
 var _ vaultutils.VaultObject = &Group{}
 var _ vaultutils.ConditionsAware = &Group{}
+var _ vaultutils.OwnershipMarkerAware = &Group{}
 
 func (m *Group) GetConditions() []metav1.Condition {
 	return m.Status.Conditions
@@ -127,6 +148,26 @@ func (m *Group) SetConditions(conditions []metav1.Condition) {
 	m.Status.Conditions = conditions
 }
 
+func (m *Group) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *Group) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *Group) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *Group) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *Group) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 func (d *Group) GetVaultConnection() *vaultutils.VaultConnection {
 	return d.Spec.Connection
 }
@@ -138,8 +179,20 @@ func (d *Group) GetPath() string {
 	return vaultutils.CleansePath(string("/identity/group/name/" + d.Name))
 }
 
+// GetPayload records the ownership marker in the group metadata
 func (d *Group) GetPayload() map[string]interface{} {
-	return d.Spec.toMap()
+	payload := d.Spec.toMap()
+	metadata := map[string]string{}
+	for key, value := range d.Spec.Metadata {
+		metadata[key] = value
+	}
+	metadata[vaultutils.OwnershipMarkerKey] = vaultutils.GetOwnershipMarker(d)
+	payload["metadata"] = metadata
+	return payload
+}
+
+func (d *Group) GetOwnershipMarker(payload map[string]interface{}) string {
+	return vaultutils.GetOwnershipMarkerFromMetadata(payload["metadata"])
 }
 
 func (i *GroupSpec) toMap() map[string]interface{} {
@@ -149,7 +202,7 @@ func (i *GroupSpec) toMap() map[string]interface{} {
 	payload["policies"] = i.Policies
 	if i.Type == "internal" {
 		payload["member_group_ids"] = i.MemberGroupIDs
-		payload["member_entity_ids"] = i.MemberEntityIDs
+		payload["member_entity_ids"] = i.retrievedMemberEntityIDs
 	}
 	return payload
 }
@@ -159,9 +212,56 @@ func (d *Group) IsInitialized() bool {
 }
 
 func (d *Group) PrepareInternalValues(context context.Context, object client.Object) error {
+	if d.Spec.Type != "internal" {
+		return nil
+	}
+	memberEntityIDs, err := d.resolveMemberEntityIDs(context)
+	if err != nil {
+		return err
+	}
+	d.Spec.retrievedMemberEntityIDs = memberEntityIDs
 	return nil
 }
 
+// resolveMemberEntityIDs replaces the names of the Entity resources in the namespace of the group with the ids of the corresponding Vault entities, the other members are kept as entity ids
+func (d *Group) resolveMemberEntityIDs(context context.Context) ([]string, error) {
+	log := log.FromContext(context)
+	if len(d.Spec.MemberEntityIDs) == 0 {
+		return d.Spec.MemberEntityIDs, nil
+	}
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return nil, err
+	}
+	memberEntityIDs := make([]string, 0, len(d.Spec.MemberEntityIDs))
+	for _, member := range d.Spec.MemberEntityIDs {
+		entity := &Entity{}
+		err := kubeClient.Get(context, types.NamespacedName{
+			Namespace: d.Namespace,
+			Name:      member,
+		}, entity)
+		if apierrors.IsNotFound(err) {
+			memberEntityIDs = append(memberEntityIDs, member)
+			continue
+		}
+		if err != nil {
+			log.Error(err, "unable to retrieve Entity", "name", member)
+			return nil, err
+		}
+		secret, found, err := vaultutils.ReadSecret(context, vaultutils.CleansePath("/identity/entity/name/"+entity.GetEntityName()))
+		if err != nil {
+			log.Error(err, "unable to retrieve entity", "name", entity.GetEntityName())
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("entity %s of Entity %s not found in Vault", entity.GetEntityName(), member)
+		}
+		memberEntityIDs = append(memberEntityIDs, secret.Data["id"].(string))
+	}
+	return memberEntityIDs, nil
+}
+
 func (d *Group) PrepareTLSConfig(context context.Context, object client.Object) error {
 	return nil
 }
@@ -175,7 +275,7 @@ func (d *Group) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
 }
 
 func (d *Group) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
-	desiredState := d.Spec.toMap()
+	desiredState := d.GetPayload()
 	delete(payload, "name")
 	return reflect.DeepEqual(desiredState, payload)
 }
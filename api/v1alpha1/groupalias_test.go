@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vault "github.com/hashicorp/vault/api"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newIdentityTestContext returns a reconcile context whose vault client talks to a fake vault answering the given paths with the given data, and whose kubernetes client holds objects
+func newIdentityTestContext(t *testing.T, responses map[string]map[string]interface{}, objects ...client.Object) context.Context {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(server.Close)
+	config := vault.DefaultConfig()
+	config.Address = server.URL
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to build scheme: %v", err)
+	}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(objects...).Build()
+	ctx := vaultutils.WithVaultClient(context.TODO(), vaultClient)
+	return vaultutils.WithKubeClient(ctx, kubeClient)
+}
+
+func TestGroupAliasCreateOnlyRecordsOwnership(t *testing.T) {
+	alias := &GroupAlias{
+		ObjectMeta: metav1.ObjectMeta{Name: "admins", Namespace: "team-a"},
+		Spec: GroupAliasSpec{
+			GroupAliasConfig: GroupAliasConfig{AuthEngineMountPath: "oidc", GroupName: "admins"},
+			ManagementPolicy: vaultutils.ManagementPolicyCreateOnly,
+		},
+	}
+	ctx := newIdentityTestContext(t, map[string]map[string]interface{}{
+		"GET /v1/sys/auth/oidc":              {"accessor": "auth_oidc_1b2c3d4e"},
+		"GET /v1/identity/group/name/admins": {"id": "5f6a7b8c"},
+		"PUT /v1/identity/group-alias":       {"id": "9d0e1f2a"},
+	}, alias)
+
+	if err := alias.PrepareInternalValues(ctx, alias); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alias.Status.ID != "9d0e1f2a" || alias.Status.Ownership != vaultutils.VaultOwnershipCreated {
+		t.Errorf("expected the created alias to be recorded as created, got id %q and ownership %q", alias.Status.ID, alias.Status.Ownership)
+	}
+	if err := vaultutils.CheckManagementPolicy(alias, alias.GetPath(), true, map[string]interface{}{"id": "9d0e1f2a"}); err != nil {
+		t.Errorf("expected the alias created by the resource to satisfy the CreateOnly policy, got %v", err)
+	}
+}
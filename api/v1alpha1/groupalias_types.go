@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"reflect"
 
-	vault "github.com/hashicorp/vault/api"
 	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -56,6 +55,12 @@ type GroupAliasSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 type GroupAliasConfig struct {
@@ -73,6 +78,14 @@ type GroupAliasStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -115,6 +128,26 @@ func (m *GroupAlias) SetConditions(conditions []metav1.Condition) {
 	m.Status.Conditions = conditions
 }
 
+func (m *GroupAlias) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *GroupAlias) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *GroupAlias) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *GroupAlias) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *GroupAlias) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 func (d *GroupAlias) GetVaultConnection() *vaultutils.VaultConnection {
 	return d.Spec.Connection
 }
@@ -180,14 +213,22 @@ func (d *GroupAlias) PrepareInternalValues(context context.Context, object clien
 			"canonical_id":   d.Spec.retrievedCanonicalID,
 		}
 		log.V(1).Info("create group alias", "payload", payload)
-		vaultClient := context.Value("vaultClient").(*vault.Client)
-		result, err := vaultClient.Logical().Write("/identity/group-alias", payload)
+		if vaultutils.PlanOperation(context, vaultutils.VaultWrite, "/identity/group-alias") {
+			return nil
+		}
+		result, err := vaultutils.WriteSecret(context, "/identity/group-alias", payload)
 		if err != nil {
 			log.Error(err, "unable to create group alias", "group alias", d.Spec)
 			return err
 		}
 		d.Status.ID = result.Data["id"].(string)
-		kubeClient := context.Value("kubeClient").(client.Client)
+		// the alias did not exist in Vault, the ownership is recorded with its id so that the management policy sees it as created by this resource
+		vaultutils.RecordVaultOwnership(d, false)
+		kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+		if err != nil {
+			log.Error(err, "unable to retrieve kubernetes client")
+			return err
+		}
 		err = kubeClient.Status().Update(context, d, &client.SubResourceUpdateOptions{})
 		if err != nil {
 			log.Error(err, "unable to update group alias status, your kube and vault systems may now be inconsistent", "instance", d)
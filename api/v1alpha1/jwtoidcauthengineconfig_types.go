@@ -51,6 +51,12 @@ type JWTOIDCAuthEngineConfigSpec struct {
 	// OIDCCredentials consists in OIDCClientID and OIDCClientSecret, which can be created as Kubernetes Secret, VaultSecret or RandomSecret
 	// +kubebuilder:validation:Optional
 	OIDCCredentials *vaultutils.RootCredentialConfig `json:"OIDCCredentials,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 // JWTOIDCAuthEngineConfigStatus defines the observed state of JWTOIDCAuthEngineConfig
@@ -60,6 +66,14 @@ type JWTOIDCAuthEngineConfigStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -186,6 +200,26 @@ func (r *JWTOIDCAuthEngineConfig) SetConditions(conditions []metav1.Condition) {
 	r.Status.Conditions = conditions
 }
 
+func (r *JWTOIDCAuthEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return r.Status.Drift
+}
+
+func (r *JWTOIDCAuthEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	r.Status.Drift = report
+}
+
+func (r *JWTOIDCAuthEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return r.Spec.ManagementPolicy
+}
+
+func (r *JWTOIDCAuthEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return r.Status.Ownership
+}
+
+func (r *JWTOIDCAuthEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	r.Status.Ownership = ownership
+}
+
 func (r *JWTOIDCAuthEngineConfig) SetUsernameAndPassword(OIDCClientID string, OIDCClientSecret string) {
 	r.Spec.JWTOIDCConfig.retrievedClientID = OIDCClientID
 	r.Spec.JWTOIDCConfig.retrievedClientPassword = OIDCClientSecret
@@ -232,7 +266,11 @@ func (r *JWTOIDCAuthEngineConfig) PrepareTLSConfig(context context.Context, obje
 func (r *JWTOIDCAuthEngineConfig) setInternalCredentials(context context.Context) error {
 	log := log.FromContext(context)
 	if r.Spec.OIDCCredentials != nil {
-		kubeClient := context.Value("kubeClient").(client.Client)
+		kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+		if err != nil {
+			log.Error(err, "unable to retrieve kubernetes client")
+			return err
+		}
 		if r.Spec.OIDCCredentials.RandomSecret != nil {
 			randomSecret := &RandomSecret{}
 			err := kubeClient.Get(context, types.NamespacedName{
@@ -58,6 +58,12 @@ type KubernetesAuthEngineRoleSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 var _ vaultutils.VaultObject = &KubernetesAuthEngineRole{}
@@ -123,7 +129,11 @@ func (r *KubernetesAuthEngineRole) findSelectedNamespaceNames(context context.Co
 		log.Error(err, "unable to create selector from label selector", "selector", r.Spec.TargetNamespaces.TargetNamespaceSelector)
 		return nil, err
 	}
-	kubeClient := context.Value("kubeClient").(client.Client)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return nil, err
+	}
 	err = kubeClient.List(context, namespaceList, &client.ListOptions{
 		LabelSelector: labelSelector,
 	})
@@ -220,6 +230,14 @@ type KubernetesAuthEngineRoleStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 func (m *KubernetesAuthEngineRole) GetConditions() []metav1.Condition {
@@ -230,6 +248,26 @@ func (m *KubernetesAuthEngineRole) SetConditions(conditions []metav1.Condition)
 	m.Status.Conditions = conditions
 }
 
+func (m *KubernetesAuthEngineRole) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *KubernetesAuthEngineRole) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *KubernetesAuthEngineRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *KubernetesAuthEngineRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *KubernetesAuthEngineRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 func (m *KubernetesAuthEngineRole) SetInternalNamespaces(namespaces []string) {
 	m.Spec.namespaces = namespaces
 }
@@ -21,7 +21,6 @@ import (
 	"errors"
 	"reflect"
 
-	vault "github.com/hashicorp/vault/api"
 	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,6 +57,12 @@ type KubernetesSecretEngineConfigSpec struct {
 	JWTReference vaultutils.RootCredentialConfig `json:"jwtReference,omitempty"`
 
 	KubeSEConfig `json:",inline"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 // KubernetesSecretEngineConfigStatus defines the observed state of KubernetesSecretEngineConfig
@@ -67,6 +72,14 @@ type KubernetesSecretEngineConfigStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 func (m *KubernetesSecretEngineConfig) GetConditions() []metav1.Condition {
@@ -77,6 +90,26 @@ func (m *KubernetesSecretEngineConfig) SetConditions(conditions []metav1.Conditi
 	m.Status.Conditions = conditions
 }
 
+func (m *KubernetesSecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *KubernetesSecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *KubernetesSecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *KubernetesSecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *KubernetesSecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -145,8 +178,11 @@ func (r *KubernetesSecretEngineConfig) isValid() error {
 
 func (r *KubernetesSecretEngineConfig) setInternalCredentials(context context.Context) error {
 	log := log.FromContext(context)
-	kubeClient := context.Value("kubeClient").(client.Client)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
 	if r.Spec.JWTReference.Secret != nil {
 		secret := &corev1.Secret{}
 		err := kubeClient.Get(context, types.NamespacedName{
@@ -166,11 +202,16 @@ func (r *KubernetesSecretEngineConfig) setInternalCredentials(context context.Co
 		return nil
 	}
 	if r.Spec.JWTReference.VaultSecret != nil {
-		secret, err := vaultClient.Logical().Read(string(r.Spec.JWTReference.VaultSecret.Path))
+		secret, exists, err := vaultutils.ReadSecret(context, string(r.Spec.JWTReference.VaultSecret.Path))
 		if err != nil {
 			log.Error(err, "unable to retrieve vault secret", "instance", r)
 			return err
 		}
+		if !exists {
+			err = errors.New("secret not found")
+			log.Error(err, "unable to retrieve vault secret", "instance", r)
+			return err
+		}
 		r.Spec.retrievedServiceAccountJWT = secret.Data["key"].(string)
 		return nil
 	}
@@ -0,0 +1,117 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLDAPSecretEngineConfigBindDNPrecedence(t *testing.T) {
+	config := &LDAPSecretEngineConfig{Spec: LDAPSecretEngineConfigSpec{LDAPSEConfig: LDAPSEConfig{BindDN: "cn=vault,dc=example,dc=com"}}}
+	config.setBindCredentials("cn=admin,dc=example,dc=com", "s3cr3t")
+	payload := config.GetPayload()
+	if payload["binddn"] != "cn=vault,dc=example,dc=com" || payload["bindpass"] != "s3cr3t" {
+		t.Errorf("expected spec.bindDN to take precedence over the retrieved one, got %v", payload)
+	}
+	if _, ok := payload["userattr"]; ok {
+		t.Errorf("expected userattr not to be sent when not specified")
+	}
+}
+
+func TestLDAPSecretEngineConfigRotatedBindPassIsWritten(t *testing.T) {
+	bindPass := "s3cr3t"
+	var config map[string]interface{}
+	writes := []map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v1/kv/ldap":
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"username": "cn=admin,dc=example,dc=com", "password": bindPass}})
+		case "GET /v1/ldap/config":
+			if config == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": config})
+		case "PUT /v1/ldap/config":
+			payload := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&payload)
+			writes = append(writes, payload)
+			// like Vault, the bind password and the client key are not returned when the configuration is read
+			config = map[string]interface{}{}
+			for key, value := range payload {
+				if key != "bindpass" && key != "client_tls_key" {
+					config[key] = value
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	vaultConfig := vault.DefaultConfig()
+	vaultConfig.Address = server.URL
+	vaultClient, err := vault.NewClient(vaultConfig)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	ctx := vaultutils.WithVaultClient(context.TODO(), vaultClient)
+
+	ldapConfig := &LDAPSecretEngineConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: "team-a", UID: "6c1b0c4e"},
+		Spec: LDAPSecretEngineConfigSpec{
+			Path: "ldap",
+			LDAPSEConfig: LDAPSEConfig{
+				URL:               "ldap://ldap.ldap.svc.cluster.local",
+				Schema:            "openldap",
+				ConnectionTimeout: metav1.Duration{Duration: 30 * time.Second},
+				RequestTimeout:    metav1.Duration{Duration: 90 * time.Second},
+			},
+			BindCredentials: vaultutils.RootCredentialConfig{
+				VaultSecret: &vaultutils.VaultSecretReference{Path: "kv/ldap"},
+				UsernameKey: "username",
+				PasswordKey: "password",
+			},
+		},
+	}
+	reconcile := func() {
+		t.Helper()
+		if err := ldapConfig.PrepareInternalValues(ctx, ldapConfig); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := vaultutils.NewVaultEndpoint(ldapConfig).CreateOrUpdate(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	reconcile()
+	if len(writes) != 1 || writes[0]["bindpass"] != "s3cr3t" {
+		t.Fatalf("expected the configuration to be written with the bind password, got %v", writes)
+	}
+	if ldapConfig.Status.BindPassHash == "" || ldapConfig.Status.BindPassHash == "s3cr3t" {
+		t.Errorf("expected the hash of the bind password to be recorded, got %q", ldapConfig.Status.BindPassHash)
+	}
+
+	reconcile()
+	if len(writes) != 1 {
+		t.Errorf("expected the unchanged configuration not to be written again, got %d writes", len(writes))
+	}
+
+	bindPass = "r0t4t3d"
+	reconcile()
+	if len(writes) != 2 || writes[1]["bindpass"] != "r0t4t3d" {
+		t.Fatalf("expected the configuration to be written with the rotated bind password, got %v", writes)
+	}
+
+	reconcile()
+	if len(writes) != 2 {
+		t.Errorf("expected the configuration not to be written again once the rotated bind password is written, got %d writes", len(writes))
+	}
+}
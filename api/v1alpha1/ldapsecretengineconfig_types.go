@@ -0,0 +1,397 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// LDAPSecretEngineConfigSpec defines the desired state of LDAPSecretEngineConfig
+type LDAPSecretEngineConfigSpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the LDAP secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/config.
+	// The authentication role must have the following capabilities = [ "create", "read", "update", "delete"] on that path.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	LDAPSEConfig `json:",inline"`
+
+	// BindCredentials specifies how to retrieve the bind DN and the bind password used by Vault to manage the LDAP entries. The bind DN is read from the usernameKey key and the password from the passwordKey key. Only VaultSecretReference or LocalObjectRefence can be used, random secret is not allowed.
+	// +kubebuilder:validation:Required
+	BindCredentials vaultutils.RootCredentialConfig `json:"bindCredentials,omitempty"`
+
+	// TLSConfig specifies a Kubernetes secret with the CA certificate used to verify the LDAP server ("ca.crt"), and the client certificate ("tls.crt") and key ("tls.key") presented to the LDAP server. It takes precedence over the certificate, clientTLSCert and clientTLSKey fields.
+	// +kubebuilder:validation:Optional
+	TLSConfig vaultutils.TLSConfig `json:"tLSConfig,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type LDAPSEConfig struct {
+	// URL the LDAP server to connect to. Examples: ldap://ldap.myorg.com, ldaps://ldap.myorg.com:636.
+	// Multiple URLs can be specified with commas, e.g. ldap://ldap.myorg.com,ldap://ldap2.myorg.com; these will be tried in-order.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:default="ldap://127.0.0.1"
+	URL string `json:"url"`
+
+	// Schema the LDAP schema to use when storing entry passwords. Valid schemas include openldap, ad, and racf.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:={"openldap","ad","racf"}
+	// +kubebuilder:default="openldap"
+	Schema string `json:"schema,omitempty"`
+
+	// PasswordPolicy the name of the password policy to use to generate passwords. If not specified, Vault generates passwords with its default policy.
+	// +kubebuilder:validation:Optional
+	PasswordPolicy string `json:"passwordPolicy,omitempty"`
+
+	// BindDN distinguished name of the object to bind when performing user and group search. Example: cn=vault,ou=Users,dc=example,dc=com
+	// If bindDN is provided it takes precedence over the bind DN retrieved from the referenced secrets
+	// +kubebuilder:validation:Optional
+	BindDN string `json:"bindDN,omitempty"`
+
+	// UserDN base DN under which to perform user search in library management and static role rotation. Example: ou=Users,dc=example,dc=com
+	// +kubebuilder:validation:Optional
+	UserDN string `json:"userDN,omitempty"`
+
+	// UserAttr the attribute field name used to perform user search in library management and static role rotation. Vault uses cn for openldap, userPrincipalName for ad and racfid for racf when not specified.
+	// +kubebuilder:validation:Optional
+	UserAttr string `json:"userAttr,omitempty"`
+
+	// UPNDomain the domain (userPrincipalDomain) used to construct a UPN string for authentication.
+	// +kubebuilder:validation:Optional
+	UPNDomain string `json:"UPNDomain,omitempty"`
+
+	// ConnectionTimeout timeout for the connection when making requests against the server before returning back an error.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="30s"
+	ConnectionTimeout metav1.Duration `json:"connectionTimeout,omitempty"`
+
+	// RequestTimeout timeout for the requests against the server before returning back an error.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="90s"
+	RequestTimeout metav1.Duration `json:"requestTimeout,omitempty"`
+
+	// StartTLS if true, issues a StartTLS command after establishing an unencrypted connection.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	StartTLS bool `json:"startTLS"`
+
+	// InsecureTLS if true, skips LDAP server SSL certificate verification - insecure, use with caution!
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	InsecureTLS bool `json:"insecureTLS"`
+
+	// TLSMinVersion minimum TLS version to use. Accepted values are tls10, tls11, tls12 or tls13
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:={"tls10","tls11","tls12","tls13"}
+	// +kubebuilder:default="tls12"
+	TLSMinVersion string `json:"TLSMinVersion,omitempty"`
+
+	// TLSMaxVersion maximum TLS version to use. Accepted values are tls10, tls11, tls12 or tls13
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:={"tls10","tls11","tls12","tls13"}
+	// +kubebuilder:default="tls12"
+	TLSMaxVersion string `json:"TLSMaxVersion,omitempty"`
+
+	// Certificate CA certificate to use when verifying LDAP server certificate, must be x509 PEM encoded.
+	// +kubebuilder:validation:Optional
+	Certificate string `json:"certificate,omitempty"`
+
+	// ClientTLSCert client certificate to provide to the LDAP server, must be x509 PEM encoded.
+	// +kubebuilder:validation:Optional
+	ClientTLSCert string `json:"clientTLSCert,omitempty"`
+
+	// ClientTLSKey client certificate key to provide to the LDAP server, must be x509 PEM encoded.
+	// +kubebuilder:validation:Optional
+	ClientTLSKey string `json:"clientTLSKey,omitempty"`
+
+	retrievedBindDN string `json:"-"`
+
+	retrievedBindPass string `json:"-"`
+
+	retrievedCertificate string `json:"-"`
+
+	retrievedClientTLSCert string `json:"-"`
+
+	retrievedClientTLSKey string `json:"-"`
+}
+
+var _ vaultutils.VaultObject = &LDAPSecretEngineConfig{}
+var _ vaultutils.ConditionsAware = &LDAPSecretEngineConfig{}
+var _ vaultutils.WriteOnlyStateAware = &LDAPSecretEngineConfig{}
+
+func (d *LDAPSecretEngineConfig) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *LDAPSecretEngineConfig) IsDeletable() bool {
+	return true
+}
+
+func (d *LDAPSecretEngineConfig) GetPath() string {
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "config")
+}
+
+func (d *LDAPSecretEngineConfig) GetPayload() map[string]interface{} {
+	return d.Spec.LDAPSEConfig.toMap()
+}
+
+// IsEquivalentToDesiredState compares the configuration read from Vault, which does not return the bind password and the client key, with the desired configuration.
+// The bind password is compared with the hash of the one last written to Vault, so that a rotated password is written again
+func (d *LDAPSecretEngineConfig) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	desiredState := d.Spec.LDAPSEConfig.toMap()
+	delete(desiredState, "bindpass")
+	delete(desiredState, "client_tls_key")
+	for key, value := range desiredState {
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return d.Status.BindPassHash == d.bindPassHash()
+}
+
+// RecordWriteOnlyState records the hash of the bind password held by Vault, once the configuration has been written
+func (d *LDAPSecretEngineConfig) RecordWriteOnlyState() {
+	d.Status.BindPassHash = d.bindPassHash()
+}
+
+// bindPassHash returns the hash of the retrieved bind password salted with the uid of the object, the password itself is never recorded
+func (d *LDAPSecretEngineConfig) bindPassHash() string {
+	hash := sha256.Sum256([]byte(string(d.UID) + d.Spec.retrievedBindPass))
+	return hex.EncodeToString(hash[:])
+}
+
+func (d *LDAPSecretEngineConfig) IsInitialized() bool {
+	return true
+}
+
+func (d *LDAPSecretEngineConfig) PrepareInternalValues(context context.Context, object client.Object) error {
+	return d.setInternalCredentials(context)
+}
+
+func (d *LDAPSecretEngineConfig) PrepareTLSConfig(context context.Context, object client.Object) error {
+	d.Spec.retrievedCertificate = d.Spec.Certificate
+	d.Spec.retrievedClientTLSCert = d.Spec.ClientTLSCert
+	d.Spec.retrievedClientTLSKey = d.Spec.ClientTLSKey
+	if d.Spec.TLSConfig.TLSSecret == nil || d.Spec.TLSConfig.TLSSecret.Name == "" {
+		return nil
+	}
+	log := log.FromContext(context)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
+	secret := &corev1.Secret{}
+	err = kubeClient.Get(context, types.NamespacedName{
+		Namespace: d.Namespace,
+		Name:      d.Spec.TLSConfig.TLSSecret.Name,
+	}, secret)
+	if err != nil {
+		log.Error(err, "unable to retrieve TLS Secret", "instance", d)
+		return err
+	}
+	d.Spec.retrievedCertificate = string(secret.Data["ca.crt"])
+	d.Spec.retrievedClientTLSCert = string(secret.Data["tls.crt"])
+	d.Spec.retrievedClientTLSKey = string(secret.Data["tls.key"])
+	return nil
+}
+
+func (r *LDAPSecretEngineConfig) IsValid() (bool, error) {
+	err := r.isValid()
+	return err == nil, err
+}
+
+func (r *LDAPSecretEngineConfig) isValid() error {
+	if r.Spec.BindCredentials.RandomSecret != nil {
+		return errors.New("spec.bindCredentials.randomSecret is not supported, use spec.bindCredentials.secret or spec.bindCredentials.vaultSecret")
+	}
+	return r.Spec.BindCredentials.ValidateEitherFromVaultSecretOrFromSecret()
+}
+
+func (r *LDAPSecretEngineConfig) setInternalCredentials(context context.Context) error {
+	log := log.FromContext(context)
+	if r.Spec.BindCredentials.Secret != nil {
+		kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+		if err != nil {
+			log.Error(err, "unable to retrieve kubernetes client")
+			return err
+		}
+		secret := &corev1.Secret{}
+		err = kubeClient.Get(context, types.NamespacedName{
+			Namespace: r.Namespace,
+			Name:      r.Spec.BindCredentials.Secret.Name,
+		}, secret)
+		if err != nil {
+			log.Error(err, "unable to retrieve Secret", "instance", r)
+			return err
+		}
+		r.setBindCredentials(string(secret.Data[r.Spec.BindCredentials.UsernameKey]), string(secret.Data[r.Spec.BindCredentials.PasswordKey]))
+		return nil
+	}
+	if r.Spec.BindCredentials.VaultSecret != nil {
+		secret, exists, err := vaultutils.ReadSecret(context, string(r.Spec.BindCredentials.VaultSecret.Path))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			err = errors.New("secret not found")
+			log.Error(err, "unable to retrieve vault secret", "instance", r)
+			return err
+		}
+		bindDN, _ := secret.Data[r.Spec.BindCredentials.UsernameKey].(string)
+		bindPass, _ := secret.Data[r.Spec.BindCredentials.PasswordKey].(string)
+		r.setBindCredentials(bindDN, bindPass)
+		return nil
+	}
+	return errors.New("no means of retrieving a secret was specified")
+}
+
+// setBindCredentials records the retrieved credentials, spec.bindDN takes precedence over the retrieved bind DN
+func (r *LDAPSecretEngineConfig) setBindCredentials(bindDN string, bindPass string) {
+	if r.Spec.BindDN != "" {
+		bindDN = r.Spec.BindDN
+	}
+	r.Spec.retrievedBindDN = bindDN
+	r.Spec.retrievedBindPass = bindPass
+}
+
+func (i *LDAPSEConfig) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["url"] = i.URL
+	payload["schema"] = i.Schema
+	payload["password_policy"] = i.PasswordPolicy
+	payload["binddn"] = i.retrievedBindDN
+	payload["bindpass"] = i.retrievedBindPass
+	payload["userdn"] = i.UserDN
+	// Vault derives the user attribute from the schema when it is not specified
+	if i.UserAttr != "" {
+		payload["userattr"] = i.UserAttr
+	}
+	payload["upndomain"] = i.UPNDomain
+	payload["connection_timeout"] = int64(i.ConnectionTimeout.Seconds())
+	payload["request_timeout"] = int64(i.RequestTimeout.Seconds())
+	payload["starttls"] = i.StartTLS
+	payload["insecure_tls"] = i.InsecureTLS
+	payload["tls_min_version"] = i.TLSMinVersion
+	payload["tls_max_version"] = i.TLSMaxVersion
+	payload["certificate"] = i.retrievedCertificate
+	payload["client_tls_cert"] = i.retrievedClientTLSCert
+	payload["client_tls_key"] = i.retrievedClientTLSKey
+	return payload
+}
+
+// LDAPSecretEngineConfigStatus defines the observed state of LDAPSecretEngineConfig
+type LDAPSecretEngineConfigStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+
+	// BindPassHash the hash of the bind password last written to Vault. Vault does not return the bind password, the configuration is written again when the hash of the retrieved bind password differs
+	// +kubebuilder:validation:Optional
+	BindPassHash string `json:"bindPassHash,omitempty"`
+}
+
+func (m *LDAPSecretEngineConfig) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *LDAPSecretEngineConfig) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *LDAPSecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *LDAPSecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *LDAPSecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *LDAPSecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *LDAPSecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// LDAPSecretEngineConfig is the Schema for the ldapsecretengineconfigs API
+type LDAPSecretEngineConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPSecretEngineConfigSpec   `json:"spec,omitempty"`
+	Status LDAPSecretEngineConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// LDAPSecretEngineConfigList contains a list of LDAPSecretEngineConfig
+type LDAPSecretEngineConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPSecretEngineConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LDAPSecretEngineConfig{}, &LDAPSecretEngineConfigList{})
+}
+
+func (d *LDAPSecretEngineConfig) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
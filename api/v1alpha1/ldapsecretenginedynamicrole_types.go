@@ -0,0 +1,229 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LDAPSecretEngineDynamicRoleSpec defines the desired state of LDAPSecretEngineDynamicRole
+type LDAPSecretEngineDynamicRoleSpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the LDAP secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/role/{metadata.name}.
+	// The authentication role must have the following capabilities = [ "create", "read", "update", "delete"] on that path.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	LDAPSEDynamicRole `json:",inline"`
+
+	// The name of the obejct created in Vault. If this is specified it takes precedence over {metatada.name}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
+	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type LDAPSEDynamicRole struct {
+	// CreationLDIF the LDIF statements executed to create the user, possibly base64 encoded. They are a go template that can access the following context variables: Username, Password, DisplayName, RoleName, IssueTime, IssueTimeSeconds, ExpirationTime, ExpirationTimeSeconds, TTL, TTLSeconds.
+	// +kubebuilder:validation:Required
+	CreationLDIF string `json:"creationLDIF"`
+
+	// DeletionLDIF the LDIF statements executed to delete the user when the credentials expire or are revoked, possibly base64 encoded. They are a go template with the same context variables as creationLDIF.
+	// +kubebuilder:validation:Required
+	DeletionLDIF string `json:"deletionLDIF"`
+
+	// RollbackLDIF the LDIF statements executed when the creation of the user fails, possibly base64 encoded. They are a go template with the same context variables as creationLDIF. The deletionLDIF statements are used when not specified.
+	// +kubebuilder:validation:Optional
+	RollbackLDIF string `json:"rollbackLDIF,omitempty"`
+
+	// UsernameTemplate the template used to generate the usernames. Vault uses v_{{.DisplayName}}_{{.RoleName}}_{{random 10}}_{{unix_time}} when not specified.
+	// +kubebuilder:validation:Optional
+	UsernameTemplate string `json:"usernameTemplate,omitempty"`
+
+	// DefaultTTL the default TTL of the generated credentials. The TTL of the secret engine is used when not specified.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0s"
+	DefaultTTL metav1.Duration `json:"defaultTTL,omitempty"`
+
+	// MaxTTL the maximum TTL of the generated credentials. The maximum TTL of the secret engine is used when not specified.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0s"
+	MaxTTL metav1.Duration `json:"maxTTL,omitempty"`
+}
+
+var _ vaultutils.VaultObject = &LDAPSecretEngineDynamicRole{}
+var _ vaultutils.ConditionsAware = &LDAPSecretEngineDynamicRole{}
+
+func (d *LDAPSecretEngineDynamicRole) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *LDAPSecretEngineDynamicRole) IsDeletable() bool {
+	return true
+}
+
+func (d *LDAPSecretEngineDynamicRole) GetPath() string {
+	if d.Spec.Name != "" {
+		return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "role" + "/" + d.Spec.Name)
+	}
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "role" + "/" + d.Name)
+}
+
+func (d *LDAPSecretEngineDynamicRole) GetPayload() map[string]interface{} {
+	return d.Spec.LDAPSEDynamicRole.toMap()
+}
+
+// IsEquivalentToDesiredState compares the role read from Vault, which returns the TTLs in seconds, with the desired role
+func (d *LDAPSecretEngineDynamicRole) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	for key, value := range d.Spec.LDAPSEDynamicRole.toMap() {
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *LDAPSecretEngineDynamicRole) IsInitialized() bool {
+	return true
+}
+
+func (d *LDAPSecretEngineDynamicRole) PrepareInternalValues(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (d *LDAPSecretEngineDynamicRole) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *LDAPSecretEngineDynamicRole) IsValid() (bool, error) {
+	err := r.Spec.LDAPSEDynamicRole.isValid()
+	return err == nil, err
+}
+
+func (i *LDAPSEDynamicRole) isValid() error {
+	if i.CreationLDIF == "" || i.DeletionLDIF == "" {
+		return errors.New("creationLDIF and deletionLDIF must be specified")
+	}
+	if i.MaxTTL.Duration != 0 && i.DefaultTTL.Duration > i.MaxTTL.Duration {
+		return errors.New("defaultTTL cannot be greater than maxTTL")
+	}
+	return nil
+}
+
+func (i *LDAPSEDynamicRole) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["creation_ldif"] = i.CreationLDIF
+	payload["deletion_ldif"] = i.DeletionLDIF
+	payload["rollback_ldif"] = i.RollbackLDIF
+	payload["username_template"] = i.UsernameTemplate
+	payload["default_ttl"] = int64(i.DefaultTTL.Seconds())
+	payload["max_ttl"] = int64(i.MaxTTL.Seconds())
+	return payload
+}
+
+// LDAPSecretEngineDynamicRoleStatus defines the observed state of LDAPSecretEngineDynamicRole
+type LDAPSecretEngineDynamicRoleStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+}
+
+func (m *LDAPSecretEngineDynamicRole) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *LDAPSecretEngineDynamicRole) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *LDAPSecretEngineDynamicRole) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *LDAPSecretEngineDynamicRole) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *LDAPSecretEngineDynamicRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *LDAPSecretEngineDynamicRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *LDAPSecretEngineDynamicRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// LDAPSecretEngineDynamicRole is the Schema for the ldapsecretenginedynamicroles API
+type LDAPSecretEngineDynamicRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPSecretEngineDynamicRoleSpec   `json:"spec,omitempty"`
+	Status LDAPSecretEngineDynamicRoleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// LDAPSecretEngineDynamicRoleList contains a list of LDAPSecretEngineDynamicRole
+type LDAPSecretEngineDynamicRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPSecretEngineDynamicRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LDAPSecretEngineDynamicRole{}, &LDAPSecretEngineDynamicRoleList{})
+}
+
+func (d *LDAPSecretEngineDynamicRole) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
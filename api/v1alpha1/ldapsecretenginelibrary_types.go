@@ -0,0 +1,222 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LDAPSecretEngineLibrarySpec defines the desired state of LDAPSecretEngineLibrary
+type LDAPSecretEngineLibrarySpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the LDAP secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/library/{metadata.name}.
+	// The authentication role must have the following capabilities = [ "create", "read", "update", "delete"] on that path.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	LDAPSELibrary `json:",inline"`
+
+	// The name of the obejct created in Vault. If this is specified it takes precedence over {metatada.name}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
+	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type LDAPSELibrary struct {
+	// ServiceAccountNames the names of the existing LDAP service accounts that can be checked out. A service account can only belong to one library set.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	ServiceAccountNames []string `json:"serviceAccountNames"`
+
+	// TTL the maximum duration of a check-out before Vault automatically checks the service account back in.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="24h"
+	TTL metav1.Duration `json:"TTL,omitempty"`
+
+	// MaxTTL the maximum duration of a check-out, including its renewals.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="24h"
+	MaxTTL metav1.Duration `json:"maxTTL,omitempty"`
+
+	// DisableCheckInEnforcement if true, any entity with the permission to check in can check in a service account, not only the entity that checked it out.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	DisableCheckInEnforcement bool `json:"disableCheckInEnforcement,omitempty"`
+}
+
+var _ vaultutils.VaultObject = &LDAPSecretEngineLibrary{}
+var _ vaultutils.ConditionsAware = &LDAPSecretEngineLibrary{}
+
+func (d *LDAPSecretEngineLibrary) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *LDAPSecretEngineLibrary) IsDeletable() bool {
+	return true
+}
+
+func (d *LDAPSecretEngineLibrary) GetPath() string {
+	if d.Spec.Name != "" {
+		return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "library" + "/" + d.Spec.Name)
+	}
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "library" + "/" + d.Name)
+}
+
+func (d *LDAPSecretEngineLibrary) GetPayload() map[string]interface{} {
+	return d.Spec.LDAPSELibrary.toMap()
+}
+
+// IsEquivalentToDesiredState compares the library set read from Vault, which returns the TTLs in seconds, with the desired library set
+func (d *LDAPSecretEngineLibrary) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	for key, value := range d.Spec.LDAPSELibrary.toMap() {
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *LDAPSecretEngineLibrary) IsInitialized() bool {
+	return true
+}
+
+func (d *LDAPSecretEngineLibrary) PrepareInternalValues(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (d *LDAPSecretEngineLibrary) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *LDAPSecretEngineLibrary) IsValid() (bool, error) {
+	err := r.Spec.LDAPSELibrary.isValid()
+	return err == nil, err
+}
+
+func (i *LDAPSELibrary) isValid() error {
+	if len(i.ServiceAccountNames) == 0 {
+		return errors.New("at least one service account name must be specified")
+	}
+	if i.MaxTTL.Duration != 0 && i.TTL.Duration > i.MaxTTL.Duration {
+		return errors.New("TTL cannot be greater than maxTTL")
+	}
+	return nil
+}
+
+func (i *LDAPSELibrary) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["service_account_names"] = i.ServiceAccountNames
+	payload["ttl"] = int64(i.TTL.Seconds())
+	payload["max_ttl"] = int64(i.MaxTTL.Seconds())
+	payload["disable_check_in_enforcement"] = i.DisableCheckInEnforcement
+	return payload
+}
+
+// LDAPSecretEngineLibraryStatus defines the observed state of LDAPSecretEngineLibrary
+type LDAPSecretEngineLibraryStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+}
+
+func (m *LDAPSecretEngineLibrary) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *LDAPSecretEngineLibrary) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *LDAPSecretEngineLibrary) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *LDAPSecretEngineLibrary) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *LDAPSecretEngineLibrary) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *LDAPSecretEngineLibrary) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *LDAPSecretEngineLibrary) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// LDAPSecretEngineLibrary is the Schema for the ldapsecretenginelibraries API
+type LDAPSecretEngineLibrary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPSecretEngineLibrarySpec   `json:"spec,omitempty"`
+	Status LDAPSecretEngineLibraryStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// LDAPSecretEngineLibraryList contains a list of LDAPSecretEngineLibrary
+type LDAPSecretEngineLibraryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPSecretEngineLibrary `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LDAPSecretEngineLibrary{}, &LDAPSecretEngineLibraryList{})
+}
+
+func (d *LDAPSecretEngineLibrary) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
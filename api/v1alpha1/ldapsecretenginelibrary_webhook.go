@@ -0,0 +1,75 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var ldapsecretenginelibrarylog = logf.Log.WithName("ldapsecretenginelibrary-resource")
+
+func (r *LDAPSecretEngineLibrary) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-redhatcop-redhat-io-v1alpha1-ldapsecretenginelibrary,mutating=true,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=ldapsecretenginelibraries,verbs=create,versions=v1alpha1,name=mldapsecretenginelibrary.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &LDAPSecretEngineLibrary{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *LDAPSecretEngineLibrary) Default() {
+	ldapsecretenginelibrarylog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-redhatcop-redhat-io-v1alpha1-ldapsecretenginelibrary,mutating=false,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=ldapsecretenginelibraries,verbs=create;update,versions=v1alpha1,name=vldapsecretenginelibrary.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &LDAPSecretEngineLibrary{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *LDAPSecretEngineLibrary) ValidateCreate() (admission.Warnings, error) {
+	ldapsecretenginelibrarylog.Info("validate create", "name", r.Name)
+
+	return nil, r.Spec.LDAPSELibrary.isValid()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *LDAPSecretEngineLibrary) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	ldapsecretenginelibrarylog.Info("validate update", "name", r.Name)
+	oldLibrary := old.(*LDAPSecretEngineLibrary)
+
+	// the path cannot be updated
+	if r.Spec.Path != oldLibrary.Spec.Path {
+		return nil, errors.New("spec.path cannot be updated")
+	}
+	return nil, r.Spec.LDAPSELibrary.isValid()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *LDAPSecretEngineLibrary) ValidateDelete() (admission.Warnings, error) {
+	ldapsecretenginelibrarylog.Info("validate delete", "name", r.Name)
+
+	return nil, nil
+}
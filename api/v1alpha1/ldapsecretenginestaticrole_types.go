@@ -0,0 +1,223 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LDAPSecretEngineStaticRoleSpec defines the desired state of LDAPSecretEngineStaticRole
+type LDAPSecretEngineStaticRoleSpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the LDAP secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/static-role/{metadata.name}.
+	// The authentication role must have the following capabilities = [ "create", "read", "update", "delete"] on that path.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	LDAPSEStaticRole `json:",inline"`
+
+	// The name of the obejct created in Vault. If this is specified it takes precedence over {metatada.name}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
+	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type LDAPSEStaticRole struct {
+	// Username the username of the existing LDAP entry whose password is rotated by Vault. It cannot be changed once the role is created.
+	// +kubebuilder:validation:Required
+	Username string `json:"username"`
+
+	// DN the distinguished name of the existing LDAP entry whose password is rotated by Vault. When specified, the entry is looked up by DN rather than by a search on the userDN and userAttr of the configuration.
+	// +kubebuilder:validation:Optional
+	DN string `json:"dn,omitempty"`
+
+	// RotationPeriod how often Vault rotates the password of the entry, at least 5s.
+	// +kubebuilder:validation:Required
+	RotationPeriod metav1.Duration `json:"rotationPeriod"`
+
+	// SkipImportRotation if true, Vault does not rotate the password of the entry when the role is created. It is only used when the role is created.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	SkipImportRotation bool `json:"skipImportRotation,omitempty"`
+}
+
+var _ vaultutils.VaultObject = &LDAPSecretEngineStaticRole{}
+var _ vaultutils.ConditionsAware = &LDAPSecretEngineStaticRole{}
+
+func (d *LDAPSecretEngineStaticRole) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *LDAPSecretEngineStaticRole) IsDeletable() bool {
+	return true
+}
+
+func (d *LDAPSecretEngineStaticRole) GetPath() string {
+	if d.Spec.Name != "" {
+		return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "static-role" + "/" + d.Spec.Name)
+	}
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "static-role" + "/" + d.Name)
+}
+
+func (d *LDAPSecretEngineStaticRole) GetPayload() map[string]interface{} {
+	return d.Spec.LDAPSEStaticRole.toMap()
+}
+
+// IsEquivalentToDesiredState compares the role read from Vault, which returns the rotation period in seconds, with the desired role. skip_import_rotation only applies to the creation of the role
+func (d *LDAPSecretEngineStaticRole) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	desiredState := d.Spec.LDAPSEStaticRole.toMap()
+	delete(desiredState, "skip_import_rotation")
+	for key, value := range desiredState {
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *LDAPSecretEngineStaticRole) IsInitialized() bool {
+	return true
+}
+
+func (d *LDAPSecretEngineStaticRole) PrepareInternalValues(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (d *LDAPSecretEngineStaticRole) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *LDAPSecretEngineStaticRole) IsValid() (bool, error) {
+	err := r.Spec.LDAPSEStaticRole.isValid()
+	return err == nil, err
+}
+
+func (i *LDAPSEStaticRole) isValid() error {
+	if i.Username == "" {
+		return errors.New("username must be specified")
+	}
+	if i.RotationPeriod.Duration < 5*time.Second {
+		return errors.New("rotationPeriod must be at least 5s")
+	}
+	return nil
+}
+
+func (i *LDAPSEStaticRole) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["username"] = i.Username
+	payload["dn"] = i.DN
+	payload["rotation_period"] = int64(i.RotationPeriod.Seconds())
+	if i.SkipImportRotation {
+		payload["skip_import_rotation"] = i.SkipImportRotation
+	}
+	return payload
+}
+
+// LDAPSecretEngineStaticRoleStatus defines the observed state of LDAPSecretEngineStaticRole
+type LDAPSecretEngineStaticRoleStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+}
+
+func (m *LDAPSecretEngineStaticRole) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *LDAPSecretEngineStaticRole) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *LDAPSecretEngineStaticRole) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *LDAPSecretEngineStaticRole) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *LDAPSecretEngineStaticRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *LDAPSecretEngineStaticRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *LDAPSecretEngineStaticRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// LDAPSecretEngineStaticRole is the Schema for the ldapsecretenginestaticroles API
+type LDAPSecretEngineStaticRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPSecretEngineStaticRoleSpec   `json:"spec,omitempty"`
+	Status LDAPSecretEngineStaticRoleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// LDAPSecretEngineStaticRoleList contains a list of LDAPSecretEngineStaticRole
+type LDAPSecretEngineStaticRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPSecretEngineStaticRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LDAPSecretEngineStaticRole{}, &LDAPSecretEngineStaticRoleList{})
+}
+
+func (d *LDAPSecretEngineStaticRole) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespacedVaultConnectionSpec defines the desired state of NamespacedVaultConnection
+type NamespacedVaultConnectionSpec struct {
+	VaultConnectionSettings `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Address",type=string,JSONPath=`.spec.address`
+//+kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.serverVersion`
+//+kubebuilder:printcolumn:name="Reachable",type=string,JSONPath=`.status.conditions[?(@.type=="Reachable")].status`
+//+kubebuilder:printcolumn:name="Sealed",type=string,JSONPath=`.status.conditions[?(@.type=="Sealed")].status`
+
+// NamespacedVaultConnection is the Schema for the namespacedvaultconnections API. It can be referenced by name from the connection.connectionRef field of resources in the same namespace.
+type NamespacedVaultConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NamespacedVaultConnectionSpec `json:"spec,omitempty"`
+	Status VaultConnectionStatus         `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// NamespacedVaultConnectionList contains a list of NamespacedVaultConnection
+type NamespacedVaultConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedVaultConnection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NamespacedVaultConnection{}, &NamespacedVaultConnectionList{})
+}
+
+var _ vaultutils.ConditionsAware = &NamespacedVaultConnection{}
+
+func (m *NamespacedVaultConnection) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *NamespacedVaultConnection) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *NamespacedVaultConnection) GetVaultConnection() *vaultutils.VaultConnection {
+	return m.Spec.toVaultConnection()
+}
+
+// GetTLSSecretNamespace returns the namespace in which the tls secret of this connection is looked up
+func (m *NamespacedVaultConnection) GetTLSSecretNamespace() string {
+	return m.Namespace
+}
+
+func (m *NamespacedVaultConnection) GetHealthCheckInterval() time.Duration {
+	return m.Spec.getHealthCheckInterval()
+}
+
+func (m *NamespacedVaultConnection) GetVaultConnectionStatus() *VaultConnectionStatus {
+	return &m.Status
+}
+
+func (m *NamespacedVaultConnection) isValid() error {
+	return m.Spec.isValid()
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var namespacedvaultconnectionlog = logf.Log.WithName("namespacedvaultconnection-resource")
+
+func (r *NamespacedVaultConnection) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-redhatcop-redhat-io-v1alpha1-namespacedvaultconnection,mutating=true,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=namespacedvaultconnections,verbs=create;update,versions=v1alpha1,name=mnamespacedvaultconnection.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &NamespacedVaultConnection{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *NamespacedVaultConnection) Default() {
+	namespacedvaultconnectionlog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-redhatcop-redhat-io-v1alpha1-namespacedvaultconnection,mutating=false,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=namespacedvaultconnections,verbs=create;update,versions=v1alpha1,name=vnamespacedvaultconnection.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &NamespacedVaultConnection{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *NamespacedVaultConnection) ValidateCreate() (admission.Warnings, error) {
+	namespacedvaultconnectionlog.Info("validate create", "name", r.Name)
+
+	return nil, r.isValid()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *NamespacedVaultConnection) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	namespacedvaultconnectionlog.Info("validate update", "name", r.Name)
+
+	return nil, r.isValid()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *NamespacedVaultConnection) ValidateDelete() (admission.Warnings, error) {
+	namespacedvaultconnectionlog.Info("validate delete", "name", r.Name)
+
+	return nil, nil
+}
@@ -58,6 +58,12 @@ type PKISecretEngineConfigSpec struct {
 	PKIConfig `json:",inline"`
 
 	PKIIntermediate `json:",inline"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 type PKIType struct {
@@ -279,7 +285,11 @@ func (p *PKISecretEngineConfig) CreateExported(context context.Context, secret *
 	exported := p.Spec.PrivateKeyType == "exported"
 
 	if exported {
-		kubeClient := context.Value("kubeClient").(client.Client)
+		kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+		if err != nil {
+			log.Error(err, "unable to retrieve kubernetes client")
+			return false, err
+		}
 		kubeSecret := &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      p.Name,
@@ -294,7 +304,7 @@ func (p *PKISecretEngineConfig) CreateExported(context context.Context, secret *
 			StringData: payload,
 		}
 
-		err := kubeClient.Create(context, kubeSecret)
+		err = kubeClient.Create(context, kubeSecret)
 		if err != nil {
 			log.Error(err, "unable to create exported secret", "path", kubeSecret)
 			return false, err
@@ -317,15 +327,20 @@ func (p *PKISecretEngineConfig) SetIntermediate(context context.Context) error {
 	if p.Spec.Type == "intermediate" {
 
 		log := log.FromContext(context)
-		vaultClient := context.Value("vaultClient").(*vault.Client)
-
+		if vaultutils.PlanOperation(context, vaultutils.VaultWrite, p.GetIntermediateSetSignedPath()) {
+			return nil
+		}
 		if p.Spec.InternalSign != nil && p.Spec.InternalSign.Name != "" {
 
 			if p.Spec.PKIIntermediate.cSR == "" {
-				kubeClient := context.Value("kubeClient").(client.Client)
+				kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+				if err != nil {
+					log.Error(err, "unable to retrieve kubernetes client")
+					return err
+				}
 				secret := &corev1.Secret{}
 
-				err := kubeClient.Get(context, types.NamespacedName{
+				err = kubeClient.Get(context, types.NamespacedName{
 					Name:      p.Name,
 					Namespace: p.Namespace,
 				}, secret)
@@ -337,7 +352,7 @@ func (p *PKISecretEngineConfig) SetIntermediate(context context.Context) error {
 				p.Spec.PKIIntermediate.cSR = (string(secret.Data["csr"]))
 			}
 
-			secret, err := vaultClient.Logical().Write(p.GetSignIntermediatePath(), p.GetSignIntermediatePayload())
+			secret, err := vaultutils.WriteSecret(context, p.GetSignIntermediatePath(), p.GetSignIntermediatePayload())
 			if err != nil {
 				log.Error(err, "unable to write object at", "path", p.GetIntermediateSetSignedPayload())
 				return err
@@ -353,10 +368,14 @@ func (p *PKISecretEngineConfig) SetIntermediate(context context.Context) error {
 				return err
 			}
 
-			kubeClient := context.Value("kubeClient").(client.Client)
+			kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+			if err != nil {
+				log.Error(err, "unable to retrieve kubernetes client")
+				return err
+			}
 			secret := &corev1.Secret{}
 
-			err := kubeClient.Get(context, types.NamespacedName{
+			err = kubeClient.Get(context, types.NamespacedName{
 				Namespace: p.Namespace,
 				Name:      p.Spec.ExternalSignSecret.Name,
 			}, secret)
@@ -370,7 +389,7 @@ func (p *PKISecretEngineConfig) SetIntermediate(context context.Context) error {
 
 		}
 
-		_, err := vaultClient.Logical().Write(p.GetIntermediateSetSignedPath(), p.GetIntermediateSetSignedPayload())
+		_, err := vaultutils.WriteSecret(context, p.GetIntermediateSetSignedPath(), p.GetIntermediateSetSignedPayload())
 		if err != nil {
 			log.Error(err, "unable to write object at", "path", p.GetIntermediateSetSignedPayload())
 			return err
@@ -483,6 +502,14 @@ type PKISecretEngineConfigStatus struct {
 
 	// +kubebuilder:validation:Optional
 	Signed bool `json:"signed,omitempty"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 var _ vaultutils.ConditionsAware = &PKISecretEngineConfig{}
@@ -495,6 +522,26 @@ func (m *PKISecretEngineConfig) SetConditions(conditions []metav1.Condition) {
 	m.Status.Conditions = conditions
 }
 
+func (m *PKISecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *PKISecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *PKISecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *PKISecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *PKISecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -51,6 +51,12 @@ type QuaySecretEngineConfigSpec struct {
 	// RootCredentials specifies how to retrieve the credentials for this Quay connection.
 	// +kubebuilder:validation:Required
 	RootCredentials vaultutils.RootCredentialConfig `json:"rootCredentials,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 var _ vaultutils.VaultObject = &QuaySecretEngineConfig{}
@@ -96,7 +102,11 @@ func (q *QuaySecretEngineConfig) IsValid() (bool, error) {
 
 func (q *QuaySecretEngineConfig) setInternalCredentials(context context.Context) error {
 	log := log.FromContext(context)
-	kubeClient := context.Value("kubeClient").(client.Client)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
 	if q.Spec.RootCredentials.RandomSecret != nil {
 		randomSecret := &RandomSecret{}
 		err := kubeClient.Get(context, types.NamespacedName{
@@ -174,6 +184,14 @@ type QuaySecretEngineConfigStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 var _ vaultutils.ConditionsAware = &QuaySecretEngineConfig{}
@@ -186,6 +204,26 @@ func (q *QuaySecretEngineConfig) SetConditions(conditions []metav1.Condition) {
 	q.Status.Conditions = conditions
 }
 
+func (q *QuaySecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return q.Status.Drift
+}
+
+func (q *QuaySecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	q.Status.Drift = report
+}
+
+func (q *QuaySecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return q.Spec.ManagementPolicy
+}
+
+func (q *QuaySecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return q.Status.Ownership
+}
+
+func (q *QuaySecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	q.Status.Ownership = ownership
+}
+
 func (q *QuaySecretEngineConfig) SetToken(token string) {
 	q.Spec.retrievedToken = token
 }
@@ -68,6 +68,12 @@ type QuaySecretEngineRoleSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 var _ vaultutils.VaultObject = &QuaySecretEngineRole{}
@@ -145,6 +151,14 @@ type QuaySecretEngineRoleStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 var _ vaultutils.ConditionsAware = &QuaySecretEngineRole{}
@@ -157,6 +171,26 @@ func (q *QuaySecretEngineRole) SetConditions(conditions []metav1.Condition) {
 	q.Status.Conditions = conditions
 }
 
+func (q *QuaySecretEngineRole) GetDriftReport() *vaultutils.DriftReport {
+	return q.Status.Drift
+}
+
+func (q *QuaySecretEngineRole) SetDriftReport(report *vaultutils.DriftReport) {
+	q.Status.Drift = report
+}
+
+func (q *QuaySecretEngineRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return q.Spec.ManagementPolicy
+}
+
+func (q *QuaySecretEngineRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return q.Status.Ownership
+}
+
+func (q *QuaySecretEngineRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	q.Status.Ownership = ownership
+}
+
 type QuayBaseRole struct {
 	// NamespaceType Type of account namespace to manage.
 	// +kubebuilder:validation:Optional
@@ -48,6 +48,12 @@ type QuaySecretEngineStaticRoleSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
 	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 var _ vaultutils.VaultObject = &QuaySecretEngineStaticRole{}
@@ -115,6 +121,14 @@ type QuaySecretEngineStaticRoleStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 var _ vaultutils.ConditionsAware = &QuaySecretEngineStaticRole{}
@@ -127,6 +141,26 @@ func (q *QuaySecretEngineStaticRole) SetConditions(conditions []metav1.Condition
 	q.Status.Conditions = conditions
 }
 
+func (q *QuaySecretEngineStaticRole) GetDriftReport() *vaultutils.DriftReport {
+	return q.Status.Drift
+}
+
+func (q *QuaySecretEngineStaticRole) SetDriftReport(report *vaultutils.DriftReport) {
+	q.Status.Drift = report
+}
+
+func (q *QuaySecretEngineStaticRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return q.Spec.ManagementPolicy
+}
+
+func (q *QuaySecretEngineStaticRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return q.Status.Ownership
+}
+
+func (q *QuaySecretEngineStaticRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	q.Status.Ownership = ownership
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -26,7 +26,6 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/hcl/v2/hclsimple"
-	vault "github.com/hashicorp/vault/api"
 	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	"github.com/scylladb/go-set/u8set"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -87,6 +86,12 @@ type RandomSecretSpec struct {
 	// +kubebuilder:validation:Enum:={"Delete","Retain"}
 	// +kubebuilder:default:="Delete"
 	KvSecretRetainPolicy string `json:"kvSecretRetainPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 const ttlKey string = "ttl"
@@ -176,6 +181,10 @@ type RandomSecretStatus struct {
 
 	//LastVaultSecretUpdate last time when this secret was updated in Vault
 	LastVaultSecretUpdate *metav1.Time `json:"lastVaultSecretUpdate,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
 }
 
 func (m *RandomSecret) GetConditions() []metav1.Condition {
@@ -186,6 +195,18 @@ func (m *RandomSecret) SetConditions(conditions []metav1.Condition) {
 	m.Status.Conditions = conditions
 }
 
+func (m *RandomSecret) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *RandomSecret) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *RandomSecret) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -237,12 +258,11 @@ func (d *RandomSecret) GenerateNewPassword(context context.Context) error {
 		}
 	}
 	if d.Spec.SecretFormat.PasswordPolicyName != "" {
-		vaultClient := context.Value("vaultClient").(*vault.Client)
-		response, err := vaultClient.Logical().Read("/sys/policies/password/" + d.Spec.SecretFormat.PasswordPolicyName + "/generate")
+		response, found, err := vaultutils.ReadSecret(context, "/sys/policies/password/"+d.Spec.SecretFormat.PasswordPolicyName+"/generate")
 		if err != nil {
 			return err
 		} else {
-			if response == nil || response.Data == nil {
+			if !found {
 				return errors.New("no data returned by password policy")
 			}
 			if password, ok := response.Data["password"]; ok {
@@ -0,0 +1,45 @@
+package v1alpha1
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func newTestSSHKey(t *testing.T) ([]byte, string) {
+	t.Helper()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	if err != nil {
+		t.Fatalf("unable to marshal private key: %v", err)
+	}
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		t.Fatalf("unable to convert public key: %v", err)
+	}
+	return pem.EncodeToMemory(block), strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublicKey)))
+}
+
+func TestDeriveSSHPublicKey(t *testing.T) {
+	privateKey, publicKey := newTestSSHKey(t)
+	derived, err := deriveSSHPublicKey(privateKey)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if derived != publicKey {
+		t.Errorf("expected public key %q, got %q", publicKey, derived)
+	}
+	if _, err := deriveSSHPublicKey(nil); err == nil {
+		t.Errorf("expected an error for a missing private key")
+	}
+	if _, err := deriveSSHPublicKey([]byte("not a key")); err == nil {
+		t.Errorf("expected an error for an invalid private key")
+	}
+}
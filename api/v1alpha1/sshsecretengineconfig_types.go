@@ -0,0 +1,340 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// SSHCAPublicKeyConfigMapKey is the key under which the public key of the CA is published in the spec.publicKeyConfigMap ConfigMap
+const SSHCAPublicKeyConfigMapKey = "trusted-user-ca-keys.pem"
+
+// SSHSecretEngineConfigSpec defines the desired state of SSHSecretEngineConfig
+type SSHSecretEngineConfigSpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the SSH secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/config/ca.
+	// The authentication role must have the following capabilities = [ "create", "read", "update", "delete"] on that path.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	SSHCAConfig `json:",inline"`
+
+	// CASecret retrieves the CA to import from a Kubernetes secret of type kubernetes.io/ssh-auth, whose ssh-privatekey key holds the unencrypted private key of the CA. The public key is derived from the private key.
+	// When not specified, Vault generates the CA with keyType and keyBits.
+	// +kubebuilder:validation:Optional
+	CASecret *corev1.LocalObjectReference `json:"caSecret,omitempty"`
+
+	// PublicKeyConfigMap is the ConfigMap, in the namespace of this resource, in which the public key of the CA is published under the trusted-user-ca-keys.pem key. The ConfigMap is created when it does not exist and is owned by this resource.
+	// +kubebuilder:validation:Optional
+	PublicKeyConfigMap *corev1.LocalObjectReference `json:"publicKeyConfigMap,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type SSHCAConfig struct {
+	// KeyType specifies the desired key type of the CA generated by Vault. It is ignored when the CA is imported and cannot be changed once the CA is generated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:={"ssh-rsa","ecdsa-sha2-nistp256","ecdsa-sha2-nistp384","ecdsa-sha2-nistp521","ssh-ed25519"}
+	// +kubebuilder:default:="ssh-rsa"
+	KeyType string `json:"keyType,omitempty"`
+
+	// KeyBits specifies the number of bits of the ssh-rsa CA generated by Vault, 0 uses the Vault default. It cannot be changed once the CA is generated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=0
+	KeyBits int `json:"keyBits,omitempty"`
+
+	retrievedPrivateKey string `json:"-"`
+	retrievedPublicKey  string `json:"-"`
+}
+
+var _ vaultutils.VaultObject = &SSHSecretEngineConfig{}
+var _ vaultutils.VaultSSHCAObject = &SSHSecretEngineConfig{}
+var _ vaultutils.ConditionsAware = &SSHSecretEngineConfig{}
+
+func (d *SSHSecretEngineConfig) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *SSHSecretEngineConfig) IsDeletable() bool {
+	return true
+}
+
+func (d *SSHSecretEngineConfig) GetPath() string {
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "config/ca")
+}
+
+func (d *SSHSecretEngineConfig) GetPayload() map[string]interface{} {
+	return d.Spec.SSHCAConfig.toMap()
+}
+
+// IsEquivalentToDesiredState returns whether Vault holds the imported CA. A CA generated by Vault cannot be compared, the one found in Vault is kept.
+func (d *SSHSecretEngineConfig) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	if d.Spec.CASecret == nil {
+		return true
+	}
+	publicKey, _ := payload["public_key"].(string)
+	return sameSSHPublicKey(d.Spec.retrievedPublicKey, publicKey)
+}
+
+func (d *SSHSecretEngineConfig) IsInitialized() bool {
+	return true
+}
+
+func (d *SSHSecretEngineConfig) PrepareInternalValues(context context.Context, object client.Object) error {
+	if d.Spec.CASecret == nil {
+		return nil
+	}
+	return d.setInternalCredentials(context)
+}
+
+func (d *SSHSecretEngineConfig) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *SSHSecretEngineConfig) IsValid() (bool, error) {
+	err := r.isValid()
+	return err == nil, err
+}
+
+func (r *SSHSecretEngineConfig) isValid() error {
+	if r.Spec.CASecret != nil {
+		if r.Spec.CASecret.Name == "" {
+			return errors.New("caSecret.name must be specified")
+		}
+		return nil
+	}
+	switch r.Spec.KeyType {
+	case "ssh-rsa":
+		if r.Spec.KeyBits != 0 && r.Spec.KeyBits != 2048 && r.Spec.KeyBits != 3072 && r.Spec.KeyBits != 4096 {
+			return errors.New("keyBits must be 0, 2048, 3072 or 4096 for the ssh-rsa keyType")
+		}
+	default:
+		if r.Spec.KeyBits != 0 {
+			return fmt.Errorf("keyBits is implied by the %s keyType and must be 0", r.Spec.KeyType)
+		}
+	}
+	return nil
+}
+
+// GetDesiredPublicKey returns the public key of the imported CA, or an empty string when the CA is generated by Vault
+func (d *SSHSecretEngineConfig) GetDesiredPublicKey() string {
+	return d.Spec.retrievedPublicKey
+}
+
+func (d *SSHSecretEngineConfig) SetPublicKey(publicKey string) {
+	d.Status.PublicKey = publicKey
+}
+
+// PublishPublicKey creates or updates spec.publicKeyConfigMap with the public key of the CA, when the ConfigMap is specified
+func (d *SSHSecretEngineConfig) PublishPublicKey(context context.Context) error {
+	if d.Spec.PublicKeyConfigMap == nil || d.Status.PublicKey == "" {
+		return nil
+	}
+	log := log.FromContext(context)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.Spec.PublicKeyConfigMap.Name,
+			Namespace: d.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(context, kubeClient, configMap, func() error {
+		if configMap.Labels == nil {
+			configMap.Labels = map[string]string{}
+		}
+		configMap.Labels["redhatcop.redhat.io/sshsecretengineconfigs"] = d.Name
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[SSHCAPublicKeyConfigMapKey] = d.Status.PublicKey
+		return controllerutil.SetControllerReference(d, configMap, kubeClient.Scheme())
+	})
+	if err != nil {
+		log.Error(err, "unable to publish the public key of the SSH CA", "configmap", configMap.Name)
+		return err
+	}
+	return nil
+}
+
+func (r *SSHSecretEngineConfig) setInternalCredentials(context context.Context) error {
+	log := log.FromContext(context)
+	kubeClient, err := vaultutils.GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
+	secret := &corev1.Secret{}
+	err = kubeClient.Get(context, types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      r.Spec.CASecret.Name,
+	}, secret)
+	if err != nil {
+		log.Error(err, "unable to retrieve Secret", "instance", r)
+		return err
+	}
+	publicKey, err := deriveSSHPublicKey(secret.Data[corev1.SSHAuthPrivateKey])
+	if err != nil {
+		log.Error(err, "unable to parse the SSH CA private key", "secret", secret.Name)
+		return err
+	}
+	r.Spec.retrievedPrivateKey = string(secret.Data[corev1.SSHAuthPrivateKey])
+	r.Spec.retrievedPublicKey = publicKey
+	return nil
+}
+
+// deriveSSHPublicKey returns the public key, in the authorized_keys format, of the PEM encoded privateKey
+func deriveSSHPublicKey(privateKey []byte) (string, error) {
+	if len(privateKey) == 0 {
+		return "", errors.New("the secret has no " + corev1.SSHAuthPrivateKey + " key")
+	}
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))), nil
+}
+
+// sameSSHPublicKey returns whether the two public keys in the authorized_keys format are the same key, regardless of their comments
+func sameSSHPublicKey(a string, b string) bool {
+	keyA, _, _, _, errA := ssh.ParseAuthorizedKey([]byte(a))
+	keyB, _, _, _, errB := ssh.ParseAuthorizedKey([]byte(b))
+	if errA != nil || errB != nil {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	return bytes.Equal(keyA.Marshal(), keyB.Marshal())
+}
+
+func (i *SSHCAConfig) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	if i.retrievedPrivateKey != "" {
+		payload["generate_signing_key"] = false
+		payload["private_key"] = i.retrievedPrivateKey
+		payload["public_key"] = i.retrievedPublicKey
+		return payload
+	}
+	payload["generate_signing_key"] = true
+	payload["key_type"] = i.KeyType
+	payload["key_bits"] = i.KeyBits
+	return payload
+}
+
+// SSHSecretEngineConfigStatus defines the observed state of SSHSecretEngineConfig
+type SSHSecretEngineConfigStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+
+	// PublicKey is the public key of the CA, to be trusted by the SSH servers accepting the certificates signed by Vault
+	// +kubebuilder:validation:Optional
+	PublicKey string `json:"publicKey,omitempty"`
+}
+
+func (m *SSHSecretEngineConfig) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *SSHSecretEngineConfig) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *SSHSecretEngineConfig) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *SSHSecretEngineConfig) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *SSHSecretEngineConfig) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *SSHSecretEngineConfig) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *SSHSecretEngineConfig) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// SSHSecretEngineConfig is the Schema for the sshsecretengineconfigs API
+type SSHSecretEngineConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SSHSecretEngineConfigSpec   `json:"spec,omitempty"`
+	Status SSHSecretEngineConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SSHSecretEngineConfigList contains a list of SSHSecretEngineConfig
+type SSHSecretEngineConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SSHSecretEngineConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SSHSecretEngineConfig{}, &SSHSecretEngineConfigList{})
+}
+
+func (d *SSHSecretEngineConfig) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var sshsecretengineconfiglog = logf.Log.WithName("sshsecretengineconfig-resource")
+
+func (r *SSHSecretEngineConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-redhatcop-redhat-io-v1alpha1-sshsecretengineconfig,mutating=true,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=sshsecretengineconfigs,verbs=create,versions=v1alpha1,name=msshsecretengineconfig.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &SSHSecretEngineConfig{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *SSHSecretEngineConfig) Default() {
+	sshsecretengineconfiglog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-redhatcop-redhat-io-v1alpha1-sshsecretengineconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=sshsecretengineconfigs,verbs=create;update,versions=v1alpha1,name=vsshsecretengineconfig.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &SSHSecretEngineConfig{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *SSHSecretEngineConfig) ValidateCreate() (admission.Warnings, error) {
+	sshsecretengineconfiglog.Info("validate create", "name", r.Name)
+
+	return nil, r.isValid()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *SSHSecretEngineConfig) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	sshsecretengineconfiglog.Info("validate update", "name", r.Name)
+	oldConfig := old.(*SSHSecretEngineConfig)
+
+	// the path cannot be updated
+	if r.Spec.Path != oldConfig.Spec.Path {
+		return nil, errors.New("spec.path cannot be updated")
+	}
+	// a CA generated by Vault is never regenerated
+	if r.Spec.CASecret == nil && oldConfig.Spec.CASecret == nil {
+		if r.Spec.KeyType != oldConfig.Spec.KeyType {
+			return nil, errors.New("spec.keyType cannot be updated")
+		}
+		if r.Spec.KeyBits != oldConfig.Spec.KeyBits {
+			return nil, errors.New("spec.keyBits cannot be updated")
+		}
+	}
+	return nil, r.isValid()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *SSHSecretEngineConfig) ValidateDelete() (admission.Warnings, error) {
+	sshsecretengineconfiglog.Info("validate delete", "name", r.Name)
+
+	return nil, nil
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SSHSecretEngineRoleSpec defines the desired state of SSHSecretEngineRole
+type SSHSecretEngineRoleSpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the SSH secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/roles/{metadata.name}.
+	// The authentication role must have the following capabilities = [ "create", "read", "update", "delete"] on that path.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	SSHRole `json:",inline"`
+
+	// The name of the obejct created in Vault. If this is specified it takes precedence over {metatada.name}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
+	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type SSHRole struct {
+	// KeyType specifies the type of credentials generated by this role. ca signs the SSH keys of the clients with the CA of the secret engine, otp generates one-time passwords.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:={"ca","otp"}
+	// +kubebuilder:default:="ca"
+	KeyType string `json:"keyType,omitempty"`
+
+	// DefaultUser specifies the default username for which a credential will be generated. It is required for the otp key type.
+	// +kubebuilder:validation:Optional
+	DefaultUser string `json:"defaultUser,omitempty"`
+
+	// DefaultUserTemplate if set, defaultUser can be specified using identity template policies. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	DefaultUserTemplate bool `json:"defaultUserTemplate,omitempty"`
+
+	// AllowedUsers the list of usernames for which a credential can be generated, "*" allows any user.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	AllowedUsers []string `json:"allowedUsers,omitempty"`
+
+	// AllowedUsersTemplate if set, allowedUsers can be specified using identity template policies. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	AllowedUsersTemplate bool `json:"allowedUsersTemplate,omitempty"`
+
+	// AllowedDomains the list of domains for which a host certificate can be signed. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+
+	// AllowedDomainsTemplate if set, allowedDomains can be specified using identity template policies. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	AllowedDomainsTemplate bool `json:"allowedDomainsTemplate,omitempty"`
+
+	// CIDRList the list of CIDR blocks of the hosts for which a one-time password can be generated. Only for the otp key type.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	CIDRList []string `json:"cidrList,omitempty"`
+
+	// ExcludeCIDRList the list of CIDR blocks, subsets of cidrList, for which a one-time password cannot be generated. Only for the otp key type.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	ExcludeCIDRList []string `json:"excludeCidrList,omitempty"`
+
+	// Port specifies the port number for SSH connection, returned with the one-time passwords. Only for the otp key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=22
+	Port int `json:"port,omitempty"`
+
+	// TTL specifies the Time To Live value of the signed certificates. Defaults to system/engine default TTL time. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0s"
+	TTL metav1.Duration `json:"TTL,omitempty"`
+
+	// MaxTTL specifies the maximum Time To Live value of the signed certificates. Defaults to system/engine max TTL time. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0s"
+	MaxTTL metav1.Duration `json:"maxTTL,omitempty"`
+
+	// AllowedCriticalOptions the list of critical options that certificates can have when signed, empty allows any option. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	AllowedCriticalOptions []string `json:"allowedCriticalOptions,omitempty"`
+
+	// AllowedExtensions the list of extensions that certificates can have when signed, "*" allows any extension. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	AllowedExtensions []string `json:"allowedExtensions,omitempty"`
+
+	// DefaultCriticalOptions the critical options certificates are signed with when none are provided. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	DefaultCriticalOptions map[string]string `json:"defaultCriticalOptions,omitempty"`
+
+	// DefaultExtensions the extensions certificates are signed with when none are provided, for example permit-pty. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	DefaultExtensions map[string]string `json:"defaultExtensions,omitempty"`
+
+	// DefaultExtensionsTemplate if set, the values of defaultExtensions can be specified using identity template policies. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	DefaultExtensionsTemplate bool `json:"defaultExtensionsTemplate,omitempty"`
+
+	// AllowUserCertificates specifies if certificates are allowed to be signed for use as a 'user'. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	AllowUserCertificates bool `json:"allowUserCertificates,omitempty"`
+
+	// AllowHostCertificates specifies if certificates are allowed to be signed for use as a 'host'. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	AllowHostCertificates bool `json:"allowHostCertificates,omitempty"`
+
+	// AllowBareDomains specifies if host certificates that are requested are allowed to use the base domains listed in allowedDomains. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	AllowBareDomains bool `json:"allowBareDomains,omitempty"`
+
+	// AllowSubdomains specifies if host certificates that are requested are allowed to be subdomains of those listed in allowedDomains. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	AllowSubdomains bool `json:"allowSubdomains,omitempty"`
+
+	// AllowUserKeyIDs specifies if users can override the key ID for a signed certificate with the key_id field. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	AllowUserKeyIDs bool `json:"allowUserKeyIDs,omitempty"`
+
+	// KeyIDFormat specifies a custom format for the key ID of a signed certificate, for example {{token_display_name}}. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	KeyIDFormat string `json:"keyIDFormat,omitempty"`
+
+	// AlgorithmSigner the algorithm used to sign the certificates when the CA is an RSA key, default uses rsa-sha2-256. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:={"default","ssh-rsa","rsa-sha2-256","rsa-sha2-512"}
+	// +kubebuilder:default:="default"
+	AlgorithmSigner string `json:"algorithmSigner,omitempty"`
+
+	// NotBeforeDuration specifies the duration by which to backdate the ValidAfter property. Only for the ca key type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="30s"
+	NotBeforeDuration metav1.Duration `json:"notBeforeDuration,omitempty"`
+}
+
+var _ vaultutils.VaultObject = &SSHSecretEngineRole{}
+var _ vaultutils.ConditionsAware = &SSHSecretEngineRole{}
+
+func (d *SSHSecretEngineRole) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *SSHSecretEngineRole) IsDeletable() bool {
+	return true
+}
+
+func (d *SSHSecretEngineRole) GetPath() string {
+	if d.Spec.Name != "" {
+		return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "roles" + "/" + d.Spec.Name)
+	}
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "roles" + "/" + d.Name)
+}
+
+func (d *SSHSecretEngineRole) GetPayload() map[string]interface{} {
+	return d.Spec.SSHRole.toMap()
+}
+
+// IsEquivalentToDesiredState compares the role read from Vault, which returns the TTLs in seconds and the lists as comma separated strings, with the desired role
+func (d *SSHSecretEngineRole) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	for key, value := range d.Spec.SSHRole.toMap() {
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *SSHSecretEngineRole) IsInitialized() bool {
+	return true
+}
+
+func (d *SSHSecretEngineRole) PrepareInternalValues(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (d *SSHSecretEngineRole) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *SSHSecretEngineRole) IsValid() (bool, error) {
+	err := r.Spec.SSHRole.isValid()
+	return err == nil, err
+}
+
+func (i *SSHRole) isValid() error {
+	switch i.KeyType {
+	case "otp":
+		if i.DefaultUser == "" {
+			return errors.New("defaultUser must be specified for the otp keyType")
+		}
+	default:
+		if !i.AllowUserCertificates && !i.AllowHostCertificates {
+			return errors.New("either allowUserCertificates or allowHostCertificates must be true for the ca keyType")
+		}
+	}
+	return nil
+}
+
+// toMap returns the parameters that Vault reads back for the key type of the role
+func (i *SSHRole) toMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["key_type"] = i.KeyType
+	payload["default_user"] = i.DefaultUser
+	payload["allowed_users"] = strings.Join(i.AllowedUsers, ",")
+	if i.KeyType == "otp" {
+		payload["cidr_list"] = strings.Join(i.CIDRList, ",")
+		payload["exclude_cidr_list"] = strings.Join(i.ExcludeCIDRList, ",")
+		payload["port"] = i.Port
+		return payload
+	}
+	payload["default_user_template"] = i.DefaultUserTemplate
+	payload["allowed_users_template"] = i.AllowedUsersTemplate
+	payload["allowed_domains"] = strings.Join(i.AllowedDomains, ",")
+	payload["allowed_domains_template"] = i.AllowedDomainsTemplate
+	payload["ttl"] = int64(i.TTL.Seconds())
+	payload["max_ttl"] = int64(i.MaxTTL.Seconds())
+	payload["allowed_critical_options"] = strings.Join(i.AllowedCriticalOptions, ",")
+	payload["allowed_extensions"] = strings.Join(i.AllowedExtensions, ",")
+	payload["default_critical_options"] = i.DefaultCriticalOptions
+	payload["default_extensions"] = i.DefaultExtensions
+	payload["default_extensions_template"] = i.DefaultExtensionsTemplate
+	payload["allow_user_certificates"] = i.AllowUserCertificates
+	payload["allow_host_certificates"] = i.AllowHostCertificates
+	payload["allow_bare_domains"] = i.AllowBareDomains
+	payload["allow_subdomains"] = i.AllowSubdomains
+	payload["allow_user_key_ids"] = i.AllowUserKeyIDs
+	payload["key_id_format"] = i.KeyIDFormat
+	payload["algorithm_signer"] = i.AlgorithmSigner
+	payload["not_before_duration"] = int64(i.NotBeforeDuration.Seconds())
+	return payload
+}
+
+// SSHSecretEngineRoleStatus defines the observed state of SSHSecretEngineRole
+type SSHSecretEngineRoleStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+}
+
+func (m *SSHSecretEngineRole) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *SSHSecretEngineRole) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *SSHSecretEngineRole) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *SSHSecretEngineRole) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *SSHSecretEngineRole) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *SSHSecretEngineRole) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *SSHSecretEngineRole) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// SSHSecretEngineRole is the Schema for the sshsecretengineroles API
+type SSHSecretEngineRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SSHSecretEngineRoleSpec   `json:"spec,omitempty"`
+	Status SSHSecretEngineRoleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SSHSecretEngineRoleList contains a list of SSHSecretEngineRole
+type SSHSecretEngineRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SSHSecretEngineRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SSHSecretEngineRole{}, &SSHSecretEngineRoleList{})
+}
+
+func (d *SSHSecretEngineRole) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
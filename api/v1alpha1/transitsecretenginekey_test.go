@@ -0,0 +1,130 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestTransitSecretEngineKeyIsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   TransitKey
+		valid bool
+	}{
+		{
+			name:  "defaults",
+			key:   TransitKey{Type: "aes256-gcm96"},
+			valid: true,
+		},
+		{
+			name:  "convergent encryption with derivation",
+			key:   TransitKey{Type: "aes256-gcm96", Derived: true, ConvergentEncryption: true},
+			valid: true,
+		},
+		{
+			name:  "convergent encryption without derivation",
+			key:   TransitKey{Type: "aes256-gcm96", ConvergentEncryption: true},
+			valid: false,
+		},
+		{
+			name:  "auto rotate period shorter than an hour",
+			key:   TransitKey{Type: "aes256-gcm96", AutoRotatePeriod: metav1.Duration{Duration: 30 * time.Minute}},
+			valid: false,
+		},
+		{
+			name:  "min encryption version lower than min decryption version",
+			key:   TransitKey{Type: "aes256-gcm96", MinDecryptionVersion: 3, MinEncryptionVersion: 2},
+			valid: false,
+		},
+		{
+			name:  "latest version used for encryption",
+			key:   TransitKey{Type: "aes256-gcm96", MinDecryptionVersion: 3},
+			valid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transitKey := &TransitSecretEngineKey{Spec: TransitSecretEngineKeySpec{TransitKey: tt.key}}
+			valid, err := transitKey.IsValid()
+			if valid != tt.valid {
+				t.Errorf("expected valid to be %v, got %v (%v)", tt.valid, valid, err)
+			}
+		})
+	}
+}
+
+func TestTransitSecretEngineKeyIsEquivalentToDesiredState(t *testing.T) {
+	transitKey := &TransitSecretEngineKey{Spec: TransitSecretEngineKeySpec{TransitKey: TransitKey{
+		Type:             "aes256-gcm96",
+		AutoRotatePeriod: metav1.Duration{Duration: 720 * time.Hour},
+		DeletionAllowed:  true,
+	}}}
+	// the key as read from Vault, with the parameters only used at creation and a min_decryption_version that was never set
+	read := map[string]interface{}{
+		"type":                   "aes256-gcm96",
+		"derived":                false,
+		"exportable":             false,
+		"auto_rotate_period":     json.Number("2592000"),
+		"deletion_allowed":       true,
+		"min_decryption_version": json.Number("1"),
+		"min_encryption_version": json.Number("0"),
+		"latest_version":         json.Number("4"),
+	}
+	if !transitKey.IsEquivalentToDesiredState(read) {
+		t.Errorf("expected the key read from Vault to be equivalent to the desired state")
+	}
+	read["deletion_allowed"] = false
+	if transitKey.IsEquivalentToDesiredState(read) {
+		t.Errorf("expected a change of deletion_allowed to be detected")
+	}
+	read["deletion_allowed"] = true
+	read["auto_rotate_period"] = json.Number("0")
+	if transitKey.IsEquivalentToDesiredState(read) {
+		t.Errorf("expected a change of auto_rotate_period to be detected")
+	}
+}
+
+func TestTransitSecretEngineKeyGetRotationRequest(t *testing.T) {
+	transitKey := &TransitSecretEngineKey{}
+	if transitKey.GetRotationRequest() != "" {
+		t.Errorf("expected no rotation request without the annotation")
+	}
+	transitKey.SetAnnotations(map[string]string{TransitKeyRotateAnnotation: "1"})
+	if transitKey.GetRotationRequest() != "1" {
+		t.Errorf("expected a rotation request for a new annotation value")
+	}
+	transitKey.SetRotated("1")
+	if transitKey.GetRotationRequest() != "" || transitKey.Status.LastRotation == nil {
+		t.Errorf("expected the rotation request to be honoured once")
+	}
+}
+
+func TestTransitSecretEngineKeyRotateSavesStatus(t *testing.T) {
+	transitKey := &TransitSecretEngineKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team-a", Annotations: map[string]string{TransitKeyRotateAnnotation: "1"}},
+		Spec:       TransitSecretEngineKeySpec{Path: "transit"},
+	}
+	ctx := newIdentityTestContext(t, map[string]map[string]interface{}{
+		"PUT /v1/transit/keys/app/rotate": {"latest_version": 2},
+	}, transitKey)
+
+	if err := vaultutils.NewVaultTransitKeyEndpoint(transitKey).Rotate(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	kubeClient, err := vaultutils.GetKubeClientFromContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := &TransitSecretEngineKey{}
+	if err := kubeClient.Get(ctx, client.ObjectKeyFromObject(transitKey), saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Status.LastRotationRequest != "1" || saved.GetRotationRequest() != "" {
+		t.Errorf("expected the rotation to be saved in the status, got %q", saved.Status.LastRotationRequest)
+	}
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TransitKeyRotateAnnotation, when set to a new value on a TransitSecretEngineKey, rotates the key once
+const TransitKeyRotateAnnotation = "redhatcop.redhat.io/rotate"
+
+// TransitSecretEngineKeySpec defines the desired state of TransitSecretEngineKey
+type TransitSecretEngineKeySpec struct {
+	// Connection represents the information needed to connect to Vault. This operator uses the standard Vault environment variables to connect to Vault. If you need to override those settings and for example connect to a different Vault instance, you can do with this section of the CR.
+	// +kubebuilder:validation:Optional
+	Connection *vaultutils.VaultConnection `json:"connection,omitempty"`
+
+	// Authentication is the kube auth configuration to be used to execute this request
+	// +kubebuilder:validation:Required
+	Authentication vaultutils.KubeAuthConfiguration `json:"authentication,omitempty"`
+
+	// Path at which the transit secret engine is mounted.
+	// The final path in Vault will be {[spec.authentication.namespace]}/{spec.path}/keys/{metadata.name}.
+	// The authentication role must have the following capabilities = [ "create", "read", "update", "delete"] on that path and capabilities = [ "update"] on its config and rotate sub-paths.
+	// +kubebuilder:validation:Required
+	Path vaultutils.Path `json:"path,omitempty"`
+
+	// +kubebuilder:validation:Required
+	TransitKey `json:",inline"`
+
+	// The name of the obejct created in Vault. If this is specified it takes precedence over {metatada.name}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`[a-z0-9]([-a-z0-9]*[a-z0-9])?`
+	Name string `json:"name,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the corresponding Vault object. Adopt takes over an object that already exists in Vault. CreateOnly fails reconciliation when the object already exists in Vault and was not created by this resource. Observe never changes Vault and only reports the differences with the desired state.
+	// Deleting this resource deletes the Vault object only if the operator created or adopted it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy vaultutils.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+type TransitKey struct {
+	// Type the type of key to create. The type cannot be changed once the key is created.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:={"aes128-gcm96","aes256-gcm96","chacha20-poly1305","ed25519","ecdsa-p256","ecdsa-p384","ecdsa-p521","rsa-2048","rsa-3072","rsa-4096","hmac"}
+	// +kubebuilder:default:="aes256-gcm96"
+	Type string `json:"type,omitempty"`
+
+	// Derived specifies if key derivation is to be used. If enabled, all encrypt/decrypt requests to this named key must provide a context which is used for key derivation. It cannot be changed once the key is created.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	Derived bool `json:"derived,omitempty"`
+
+	// ConvergentEncryption if enabled, the key will support convergent encryption, where the same plaintext creates the same ciphertext. This requires derived to be set to true. It cannot be changed once the key is created.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	ConvergentEncryption bool `json:"convergentEncryption,omitempty"`
+
+	// Exportable enables keys to be exportable. This allows for all the valid keys in the key ring to be exported. Once set, this cannot be disabled.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	Exportable bool `json:"exportable,omitempty"`
+
+	// AllowPlaintextBackup if set, enables taking backup of named key in the plaintext format. Once set, this cannot be disabled.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	AllowPlaintextBackup bool `json:"allowPlaintextBackup,omitempty"`
+
+	// AutoRotatePeriod the period at which this key should be rotated automatically. Setting this to "0" disables automatic key rotation. This value cannot be shorter than one hour.
+	// +kubebuilder:validation:Optional
+	AutoRotatePeriod metav1.Duration `json:"autoRotatePeriod,omitempty"`
+
+	// DeletionAllowed specifies if the key is allowed to be deleted. Vault refuses to delete a key without it, in which case deleting this resource leaves the key in Vault.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	DeletionAllowed bool `json:"deletionAllowed,omitempty"`
+
+	// MinDecryptionVersion specifies the minimum version of ciphertext allowed to be decrypted. Adjusting this as part of a key rotation policy can prevent old copies of ciphertext from being decrypted, should they fall into the wrong hands. 0 does not change the minimum version.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MinDecryptionVersion int `json:"minDecryptionVersion,omitempty"`
+
+	// MinEncryptionVersion specifies the minimum version of the key that can be used to encrypt plaintext, sign payloads, or generate HMACs. Must be 0 (which will use the latest version) or a value greater or equal to minDecryptionVersion.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MinEncryptionVersion int `json:"minEncryptionVersion,omitempty"`
+}
+
+var _ vaultutils.VaultObject = &TransitSecretEngineKey{}
+var _ vaultutils.VaultTransitKeyObject = &TransitSecretEngineKey{}
+
+func (d *TransitSecretEngineKey) GetVaultConnection() *vaultutils.VaultConnection {
+	return d.Spec.Connection
+}
+
+func (d *TransitSecretEngineKey) GetPath() string {
+	if d.Spec.Name != "" {
+		return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "keys" + "/" + d.Spec.Name)
+	}
+	return vaultutils.CleansePath(string(d.Spec.Path) + "/" + "keys" + "/" + d.Name)
+}
+
+// GetPayload returns the parameters the key is created with
+func (d *TransitSecretEngineKey) GetPayload() map[string]interface{} {
+	return d.Spec.TransitKey.toMap()
+}
+
+// IsEquivalentToDesiredState returns whether the key read from Vault has the desired config. The parameters that can only be set at creation are not compared.
+func (d *TransitSecretEngineKey) IsEquivalentToDesiredState(payload map[string]interface{}) bool {
+	for key, value := range d.GetConfigPayload() {
+		if fmt.Sprint(value) != fmt.Sprint(payload[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *TransitSecretEngineKey) IsInitialized() bool {
+	return true
+}
+
+// IsDeletable returns whether the key is deleted with this resource, Vault only allows the deletion of the keys configured with deletionAllowed
+func (d *TransitSecretEngineKey) IsDeletable() bool {
+	return d.Spec.DeletionAllowed
+}
+
+func (d *TransitSecretEngineKey) PrepareInternalValues(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (d *TransitSecretEngineKey) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+
+func (r *TransitSecretEngineKey) IsValid() (bool, error) {
+	err := r.Spec.TransitKey.isValid()
+	return err == nil, err
+}
+
+func (d *TransitSecretEngineKey) GetConfigPath() string {
+	return d.GetPath() + "/config"
+}
+
+// GetConfigPayload returns the parameters of the key that can be changed after its creation
+func (d *TransitSecretEngineKey) GetConfigPayload() map[string]interface{} {
+	return d.Spec.TransitKey.toConfigMap()
+}
+
+func (d *TransitSecretEngineKey) GetRotatePath() string {
+	return d.GetPath() + "/rotate"
+}
+
+// GetRotationRequest returns the value of the rotate annotation that has not been honoured yet, if any
+func (d *TransitSecretEngineKey) GetRotationRequest() string {
+	request := d.GetAnnotations()[TransitKeyRotateAnnotation]
+	if request == d.Status.LastRotationRequest {
+		return ""
+	}
+	return request
+}
+
+func (d *TransitSecretEngineKey) SetRotated(request string) {
+	now := metav1.Now()
+	d.Status.LastRotation = &now
+	d.Status.LastRotationRequest = request
+}
+
+func (d *TransitSecretEngineKey) SetLatestVersion(version int) {
+	d.Status.LatestVersion = version
+}
+
+func (i *TransitKey) toMap() map[string]interface{} {
+	payload := i.toConfigMap()
+	payload["type"] = i.Type
+	payload["derived"] = i.Derived
+	payload["convergent_encryption"] = i.ConvergentEncryption
+	return payload
+}
+
+func (i *TransitKey) toConfigMap() map[string]interface{} {
+	payload := map[string]interface{}{}
+	payload["auto_rotate_period"] = int64(i.AutoRotatePeriod.Seconds())
+	payload["deletion_allowed"] = i.DeletionAllowed
+	// Vault reads a min_decryption_version of 1 for the keys where it was never set
+	if i.MinDecryptionVersion != 0 {
+		payload["min_decryption_version"] = i.MinDecryptionVersion
+	}
+	payload["min_encryption_version"] = i.MinEncryptionVersion
+	// exportable and allow_plaintext_backup cannot be disabled once enabled, they are only sent to enable them
+	if i.Exportable {
+		payload["exportable"] = true
+	}
+	if i.AllowPlaintextBackup {
+		payload["allow_plaintext_backup"] = true
+	}
+	return payload
+}
+
+func (i *TransitKey) isValid() error {
+	if i.ConvergentEncryption && !i.Derived {
+		return errors.New("derived must be true when convergentEncryption is enabled")
+	}
+	if i.AutoRotatePeriod.Duration != 0 && i.AutoRotatePeriod.Hours() < 1 {
+		return errors.New("autoRotatePeriod must be 0 or at least one hour")
+	}
+	if i.MinEncryptionVersion != 0 && i.MinEncryptionVersion < i.MinDecryptionVersion {
+		return errors.New("minEncryptionVersion must be 0 or greater or equal to minDecryptionVersion")
+	}
+	return nil
+}
+
+// TransitSecretEngineKeyStatus defines the observed state of TransitSecretEngineKey
+type TransitSecretEngineKeyStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Drift reports the differences found between Vault and the desired state the last time drift was detected and corrected
+	// +kubebuilder:validation:Optional
+	Drift *vaultutils.DriftReport `json:"drift,omitempty"`
+
+	// Ownership records whether the operator created or adopted the corresponding Vault object
+	// +kubebuilder:validation:Optional
+	Ownership vaultutils.VaultOwnership `json:"ownership,omitempty"`
+
+	// LatestVersion is the latest version of the key in Vault
+	// +kubebuilder:validation:Optional
+	LatestVersion int `json:"latestVersion,omitempty"`
+
+	// LastRotation is the time the key was last rotated on demand
+	// +kubebuilder:validation:Optional
+	LastRotation *metav1.Time `json:"lastRotation,omitempty"`
+
+	// LastRotationRequest is the value of the redhatcop.redhat.io/rotate annotation the key was last rotated for
+	// +kubebuilder:validation:Optional
+	LastRotationRequest string `json:"lastRotationRequest,omitempty"`
+}
+
+var _ vaultutils.ConditionsAware = &TransitSecretEngineKey{}
+
+func (m *TransitSecretEngineKey) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *TransitSecretEngineKey) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *TransitSecretEngineKey) GetDriftReport() *vaultutils.DriftReport {
+	return m.Status.Drift
+}
+
+func (m *TransitSecretEngineKey) SetDriftReport(report *vaultutils.DriftReport) {
+	m.Status.Drift = report
+}
+
+func (m *TransitSecretEngineKey) GetManagementPolicy() vaultutils.ManagementPolicy {
+	return m.Spec.ManagementPolicy
+}
+
+func (m *TransitSecretEngineKey) GetVaultOwnership() vaultutils.VaultOwnership {
+	return m.Status.Ownership
+}
+
+func (m *TransitSecretEngineKey) SetVaultOwnership(ownership vaultutils.VaultOwnership) {
+	m.Status.Ownership = ownership
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Latest Version",type=integer,JSONPath=`.status.latestVersion`
+
+// TransitSecretEngineKey is the Schema for the transitsecretenginekeys API
+type TransitSecretEngineKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitSecretEngineKeySpec   `json:"spec,omitempty"`
+	Status TransitSecretEngineKeyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TransitSecretEngineKeyList contains a list of TransitSecretEngineKey
+type TransitSecretEngineKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitSecretEngineKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TransitSecretEngineKey{}, &TransitSecretEngineKeyList{})
+}
+
+func (d *TransitSecretEngineKey) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
+	return &d.Spec.Authentication
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var transitsecretenginekeylog = logf.Log.WithName("transitsecretenginekey-resource")
+
+func (r *TransitSecretEngineKey) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-redhatcop-redhat-io-v1alpha1-transitsecretenginekey,mutating=true,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=transitsecretenginekeys,verbs=create,versions=v1alpha1,name=mtransitsecretenginekey.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &TransitSecretEngineKey{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *TransitSecretEngineKey) Default() {
+	transitsecretenginekeylog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-redhatcop-redhat-io-v1alpha1-transitsecretenginekey,mutating=false,failurePolicy=fail,sideEffects=None,groups=redhatcop.redhat.io,resources=transitsecretenginekeys,verbs=create;update,versions=v1alpha1,name=vtransitsecretenginekey.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &TransitSecretEngineKey{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *TransitSecretEngineKey) ValidateCreate() (admission.Warnings, error) {
+	transitsecretenginekeylog.Info("validate create", "name", r.Name)
+
+	return nil, r.Spec.TransitKey.isValid()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *TransitSecretEngineKey) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	transitsecretenginekeylog.Info("validate update", "name", r.Name)
+	oldKey := old.(*TransitSecretEngineKey)
+
+	// the path cannot be updated
+	if r.Spec.Path != oldKey.Spec.Path {
+		return nil, errors.New("spec.path cannot be updated")
+	}
+	// the key is only created with these parameters
+	if r.Spec.Type != oldKey.Spec.Type {
+		return nil, errors.New("spec.type cannot be updated")
+	}
+	if r.Spec.Derived != oldKey.Spec.Derived {
+		return nil, errors.New("spec.derived cannot be updated")
+	}
+	if r.Spec.ConvergentEncryption != oldKey.Spec.ConvergentEncryption {
+		return nil, errors.New("spec.convergentEncryption cannot be updated")
+	}
+	// Vault does not allow to disable these parameters once enabled
+	if oldKey.Spec.Exportable && !r.Spec.Exportable {
+		return nil, errors.New("spec.exportable cannot be disabled")
+	}
+	if oldKey.Spec.AllowPlaintextBackup && !r.Spec.AllowPlaintextBackup {
+		return nil, errors.New("spec.allowPlaintextBackup cannot be disabled")
+	}
+	return nil, r.Spec.TransitKey.isValid()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *TransitSecretEngineKey) ValidateDelete() (admission.Warnings, error) {
+	transitsecretenginekeylog.Info("validate delete", "name", r.Name)
+
+	return nil, nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vault "github.com/hashicorp/vault/api"
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	KubernetesAuthMethod = "kubernetes"
+	JWTAuthMethod        = "jwt"
+	AppRoleAuthMethod    = "approle"
+	CertAuthMethod       = "cert"
+)
+
+// +kubebuilder:object:generate=true
+type AppRoleAuthentication struct {
+	// Secret is the namespace-local secret holding the role_id and secret_id used to log in.
+	// +kubebuilder:validation:Required
+	Secret *corev1.LocalObjectReference `json:"secret,omitempty"`
+
+	// RoleIDKey is the key of the secret holding the role_id
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="role_id"
+	RoleIDKey string `json:"roleIDKey,omitempty"`
+
+	// SecretIDKey is the key of the secret holding the secret_id. If the key is not present in the secret, the login is attempted with the role_id only, which requires bind_secret_id to be false on the role.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="secret_id"
+	SecretIDKey string `json:"secretIDKey,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+type JWTAuthentication struct {
+	// Audiences are the audiences of the service account token presented to Vault. They must match the bound_audiences of the Vault role. If not specified, the token is issued for the default audiences of the Kubernetes API server.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Audiences []string `json:"audiences,omitempty"`
+
+	// ExpirationSeconds is the requested validity of the service account token presented to Vault. The default is 600.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=600
+	ExpirationSeconds int64 `json:"expirationSeconds,omitempty"`
+}
+
+// VaultAuthenticator logs a vault client in with a specific auth method. The returned secret must carry the auth information of the new token.
+type VaultAuthenticator interface {
+	Login(context context.Context, vaultClient *vault.Client, kubeNamespace string) (*vault.Secret, error)
+}
+
+func (kc *KubeAuthConfiguration) GetMethod() string {
+	if kc.Method == "" {
+		return KubernetesAuthMethod
+	}
+	return kc.Method
+}
+
+func (kc *KubeAuthConfiguration) getAuthenticator() (VaultAuthenticator, error) {
+	switch kc.GetMethod() {
+	case KubernetesAuthMethod:
+		return &kubernetesAuthenticator{kc: kc}, nil
+	case JWTAuthMethod:
+		return &jwtAuthenticator{kc: kc}, nil
+	case AppRoleAuthMethod:
+		if kc.AppRole == nil || kc.AppRole.Secret == nil {
+			return nil, errors.New("authentication.appRole.secret must be specified when using the approle auth method")
+		}
+		return &appRoleAuthenticator{kc: kc}, nil
+	case CertAuthMethod:
+		return &certAuthenticator{kc: kc}, nil
+	default:
+		return nil, fmt.Errorf("unsupported auth method %s", kc.Method)
+	}
+}
+
+// kubernetesAuthenticator logs in with a token of the configured service account through the kubernetes auth method
+type kubernetesAuthenticator struct {
+	kc *KubeAuthConfiguration
+}
+
+func (a *kubernetesAuthenticator) Login(context context.Context, vaultClient *vault.Client, kubeNamespace string) (*vault.Secret, error) {
+	log := log.FromContext(context)
+	jwt, err := a.kc.getJWTToken(context, kubeNamespace)
+	if err != nil {
+		log.Error(err, "unable to retrieve jwt token for", "namespace", kubeNamespace, "serviceaccount", a.kc.GetServiceAccountName())
+		return nil, err
+	}
+	return vaultClient.Logical().WriteWithContext(context, a.kc.GetKubeAuthPath(), map[string]interface{}{
+		"jwt":  jwt,
+		"role": a.kc.GetRole(),
+	})
+}
+
+// jwtAuthenticator logs in with a token of the configured service account, issued for the configured audiences, through the jwt/oidc auth method
+type jwtAuthenticator struct {
+	kc *KubeAuthConfiguration
+}
+
+func (a *jwtAuthenticator) Login(context context.Context, vaultClient *vault.Client, kubeNamespace string) (*vault.Secret, error) {
+	log := log.FromContext(context)
+	expiration := int64(600)
+	var audiences []string
+	if a.kc.JWT != nil {
+		audiences = a.kc.JWT.Audiences
+		if a.kc.JWT.ExpirationSeconds > 0 {
+			expiration = a.kc.JWT.ExpirationSeconds
+		}
+	}
+	jwt, err := GetJWTTokenWithAudiences(context, a.kc.GetServiceAccountName(), kubeNamespace, expiration, audiences)
+	if err != nil {
+		log.Error(err, "unable to retrieve jwt token for", "namespace", kubeNamespace, "serviceaccount", a.kc.GetServiceAccountName(), "audiences", audiences)
+		return nil, err
+	}
+	return vaultClient.Logical().WriteWithContext(context, a.kc.GetKubeAuthPath(), map[string]interface{}{
+		"jwt":  jwt,
+		"role": a.kc.GetRole(),
+	})
+}
+
+// appRoleAuthenticator logs in with the role_id and secret_id found in a namespace-local secret through the approle auth method
+type appRoleAuthenticator struct {
+	kc *KubeAuthConfiguration
+}
+
+func (a *appRoleAuthenticator) Login(context context.Context, vaultClient *vault.Client, kubeNamespace string) (*vault.Secret, error) {
+	log := log.FromContext(context)
+	kubeClient, err := GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to read the approle secret")
+		return nil, err
+	}
+	secret := &corev1.Secret{}
+	err = kubeClient.Get(context, types.NamespacedName{Namespace: kubeNamespace, Name: a.kc.AppRole.Secret.Name}, secret)
+	if err != nil {
+		log.Error(err, "unable to retrieve approle", "secret", a.kc.AppRole.Secret.Name, "namespace", kubeNamespace)
+		return nil, err
+	}
+	roleIDKey := a.kc.AppRole.RoleIDKey
+	if roleIDKey == "" {
+		roleIDKey = "role_id"
+	}
+	secretIDKey := a.kc.AppRole.SecretIDKey
+	if secretIDKey == "" {
+		secretIDKey = "secret_id"
+	}
+	roleID, ok := secret.Data[roleIDKey]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in secret %s", roleIDKey, a.kc.AppRole.Secret.Name)
+	}
+	payload := map[string]interface{}{
+		"role_id": string(roleID),
+	}
+	if secretID, ok := secret.Data[secretIDKey]; ok {
+		payload["secret_id"] = string(secretID)
+	}
+	return vaultClient.Logical().WriteWithContext(context, a.kc.GetKubeAuthPath(), payload)
+}
+
+// certAuthenticator logs in with the client certificate configured on the vault connection through the cert auth method. Role, if set, is sent as the name of the certificate role to authenticate against.
+type certAuthenticator struct {
+	kc *KubeAuthConfiguration
+}
+
+func (a *certAuthenticator) Login(context context.Context, vaultClient *vault.Client, kubeNamespace string) (*vault.Secret, error) {
+	payload := map[string]interface{}{}
+	if a.kc.GetRole() != "" {
+		payload["name"] = a.kc.GetRole()
+	}
+	return vaultClient.Logical().WriteWithContext(context, a.kc.GetKubeAuthPath(), payload)
+}
+
+// GetJWTTokenWithAudiences requests a token for the passed service account, valid for the passed duration and issued for the passed audiences. If audiences is empty the token is issued for the default audiences of the Kubernetes API server.
+func GetJWTTokenWithAudiences(context context.Context, serviceAccountName string, kubeNamespace string, duration int64, audiences []string) (string, error) {
+	log := log.FromContext(context)
+
+	restConfig, err := GetRestConfigFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes rest config")
+		return "", err
+	}
+
+	treq := &authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			ExpirationSeconds: &duration,
+			Audiences:         audiences,
+		},
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		log.Error(err, "unable to create kubernetes clientset")
+		return "", err
+	}
+
+	treq, err = clientset.CoreV1().ServiceAccounts(kubeNamespace).CreateToken(context, serviceAccountName, treq, metav1.CreateOptions{})
+	if err != nil {
+		log.Error(err, "unable to create service account token request", "in namespace", kubeNamespace, "for service account", serviceAccountName)
+		return "", err
+	}
+
+	return treq.Status.Token, nil
+}
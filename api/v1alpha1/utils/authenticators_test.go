@@ -0,0 +1,311 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// testKubeAPI is a kubernetes API server answering the service account token requests and the reads of secrets, it records the token requests it receives
+type testKubeAPI struct {
+	mutex         sync.Mutex
+	secrets       map[string]*corev1.Secret
+	tokenRequests []authv1.TokenRequestSpec
+}
+
+func newTestKubeAPI(t *testing.T, secrets ...*corev1.Secret) (*testKubeAPI, *rest.Config) {
+	api := &testKubeAPI{secrets: map[string]*corev1.Secret{}}
+	for _, secret := range secrets {
+		api.secrets["/api/v1/namespaces/"+secret.Namespace+"/secrets/"+secret.Name] = secret
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1/namespaces/team-a/serviceaccounts/default/token" {
+			request := &authv1.TokenRequest{}
+			json.NewDecoder(r.Body).Decode(request)
+			api.mutex.Lock()
+			api.tokenRequests = append(api.tokenRequests, request.Spec)
+			api.mutex.Unlock()
+			request.TypeMeta = metav1.TypeMeta{APIVersion: "authentication.k8s.io/v1", Kind: "TokenRequest"}
+			request.Status.Token = "projected-token"
+			json.NewEncoder(w).Encode(request)
+			return
+		}
+		secret, ok := api.secrets[r.URL.Path]
+		if r.Method != http.MethodGet || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&metav1.Status{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"}, Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+			return
+		}
+		secret.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}
+		json.NewEncoder(w).Encode(secret)
+	}))
+	t.Cleanup(server.Close)
+	return api, &rest.Config{Host: server.URL}
+}
+
+// testVaultLogin is a vault answering the logins with a token, it records the path and payload of the last login
+type testVaultLogin struct {
+	path    string
+	payload map[string]interface{}
+}
+
+func (l *testVaultLogin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l.path = r.URL.Path
+	l.payload = map[string]interface{}{}
+	json.NewDecoder(r.Body).Decode(&l.payload)
+	json.NewEncoder(w).Encode(map[string]interface{}{"auth": map[string]interface{}{"client_token": "s.login", "lease_duration": 3600}})
+}
+
+func TestAuthenticatorsLogin(t *testing.T) {
+	appRoleSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "approle", Namespace: "team-a"},
+		Data:       map[string][]byte{"role_id": []byte("role"), "secret_id": []byte("secret"), "custom_role_id": []byte("custom-role"), "custom_secret_id": []byte("custom-secret")},
+	}
+	roleOnlySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "role-only", Namespace: "team-a"},
+		Data:       map[string][]byte{"role_id": []byte("role")},
+	}
+
+	tests := []struct {
+		name              string
+		kc                *KubeAuthConfiguration
+		expectedPath      string
+		expectedPayload   map[string]interface{}
+		expectedTokenSpec *authv1.TokenRequestSpec
+		expectError       bool
+	}{
+		{
+			name:            "approle with the default keys",
+			kc:              &KubeAuthConfiguration{Method: AppRoleAuthMethod, Path: "approle", AppRole: &AppRoleAuthentication{Secret: &corev1.LocalObjectReference{Name: "approle"}}},
+			expectedPath:    "/v1/auth/approle/login",
+			expectedPayload: map[string]interface{}{"role_id": "role", "secret_id": "secret"},
+		},
+		{
+			name:            "approle with custom keys",
+			kc:              &KubeAuthConfiguration{Method: AppRoleAuthMethod, Path: "team-a/approle", AppRole: &AppRoleAuthentication{Secret: &corev1.LocalObjectReference{Name: "approle"}, RoleIDKey: "custom_role_id", SecretIDKey: "custom_secret_id"}},
+			expectedPath:    "/v1/auth/team-a/approle/login",
+			expectedPayload: map[string]interface{}{"role_id": "custom-role", "secret_id": "custom-secret"},
+		},
+		{
+			name:            "approle without secret_id",
+			kc:              &KubeAuthConfiguration{Method: AppRoleAuthMethod, Path: "approle", AppRole: &AppRoleAuthentication{Secret: &corev1.LocalObjectReference{Name: "role-only"}}},
+			expectedPath:    "/v1/auth/approle/login",
+			expectedPayload: map[string]interface{}{"role_id": "role"},
+		},
+		{
+			name:        "approle with a missing secret",
+			kc:          &KubeAuthConfiguration{Method: AppRoleAuthMethod, Path: "approle", AppRole: &AppRoleAuthentication{Secret: &corev1.LocalObjectReference{Name: "missing"}}},
+			expectError: true,
+		},
+		{
+			name:        "approle with a missing role_id key",
+			kc:          &KubeAuthConfiguration{Method: AppRoleAuthMethod, Path: "approle", AppRole: &AppRoleAuthentication{Secret: &corev1.LocalObjectReference{Name: "approle"}, RoleIDKey: "missing"}},
+			expectError: true,
+		},
+		{
+			name:        "approle without secret reference",
+			kc:          &KubeAuthConfiguration{Method: AppRoleAuthMethod, Path: "approle"},
+			expectError: true,
+		},
+		{
+			name:              "jwt with audiences",
+			kc:                &KubeAuthConfiguration{Method: JWTAuthMethod, Path: "jwt", Role: "reader", JWT: &JWTAuthentication{Audiences: []string{"vault"}, ExpirationSeconds: 900}},
+			expectedPath:      "/v1/auth/jwt/login",
+			expectedPayload:   map[string]interface{}{"jwt": "projected-token", "role": "reader"},
+			expectedTokenSpec: &authv1.TokenRequestSpec{Audiences: []string{"vault"}, ExpirationSeconds: ptrTo(int64(900))},
+		},
+		{
+			name:              "jwt with the default audiences",
+			kc:                &KubeAuthConfiguration{Method: JWTAuthMethod, Path: "oidc", Role: "reader"},
+			expectedPath:      "/v1/auth/oidc/login",
+			expectedPayload:   map[string]interface{}{"jwt": "projected-token", "role": "reader"},
+			expectedTokenSpec: &authv1.TokenRequestSpec{ExpirationSeconds: ptrTo(int64(600))},
+		},
+		{
+			name:            "cert with a role",
+			kc:              &KubeAuthConfiguration{Method: CertAuthMethod, Path: "cert", Role: "web"},
+			expectedPath:    "/v1/auth/cert/login",
+			expectedPayload: map[string]interface{}{"name": "web"},
+		},
+		{
+			name:            "cert without role",
+			kc:              &KubeAuthConfiguration{Method: CertAuthMethod, Path: "cert"},
+			expectedPath:    "/v1/auth/cert/login",
+			expectedPayload: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			login := &testVaultLogin{}
+			server := httptest.NewServer(login)
+			defer server.Close()
+			vaultClient, _ := newTestVaultClient(t, server.URL, "")
+			kubeAPI, restConfig := newTestKubeAPI(t)
+			ctx := WithKubeClient(context.TODO(), fake.NewClientBuilder().WithObjects(appRoleSecret, roleOnlySecret).Build())
+			ctx = WithRestConfig(ctx, restConfig)
+
+			secret, err := tt.kc.Login(ctx, vaultClient, "team-a")
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				if login.path != "" {
+					t.Errorf("expected no login, got a login at %s", login.path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if secret.Auth.ClientToken != "s.login" {
+				t.Errorf("expected the login token, got %s", secret.Auth.ClientToken)
+			}
+			if login.path != tt.expectedPath {
+				t.Errorf("expected a login at %s, got %s", tt.expectedPath, login.path)
+			}
+			if !reflect.DeepEqual(login.payload, tt.expectedPayload) {
+				t.Errorf("expected the login payload %v, got %v", tt.expectedPayload, login.payload)
+			}
+			if tt.expectedTokenSpec != nil && (len(kubeAPI.tokenRequests) != 1 || !reflect.DeepEqual(kubeAPI.tokenRequests[0], *tt.expectedTokenSpec)) {
+				t.Errorf("expected the token request %v, got %v", *tt.expectedTokenSpec, kubeAPI.tokenRequests)
+			}
+		})
+	}
+}
+
+func ptrTo[T any](value T) *T {
+	return &value
+}
+
+// newTestClientCertificate returns a self-signed client certificate and its key, PEM-encoded
+func newTestClientCertificate(t *testing.T, commonName string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestCertAuthenticatorPresentsClientCertificate(t *testing.T) {
+	var presented []string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, certificate := range r.TLS.PeerCertificates {
+			presented = append(presented, certificate.Subject.CommonName)
+		}
+		(&testVaultLogin{}).ServeHTTP(w, r)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	clientCert, clientKey := newTestClientCertificate(t, "operator")
+
+	tests := []struct {
+		name              string
+		secret            *corev1.Secret
+		expectedPresented []string
+		expectError       bool
+	}{
+		{
+			name:              "client certificate of the tls secret",
+			secret:            &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "vault-tls", Namespace: "team-a"}, Data: map[string][]byte{"ca.crt": caCert, "tls.crt": clientCert, "tls.key": clientKey}},
+			expectedPresented: []string{"operator"},
+		},
+		{
+			name:   "tls secret without client certificate",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "vault-tls", Namespace: "team-a"}, Data: map[string][]byte{"ca.crt": caCert}},
+		},
+		{
+			name:        "client certificate without key",
+			secret:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "vault-tls", Namespace: "team-a"}, Data: map[string][]byte{"ca.crt": caCert, "tls.crt": clientCert}},
+			expectError: true,
+		},
+		{
+			name:        "missing tls secret",
+			secret:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "team-a"}},
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			presented = nil
+			_, restConfig := newTestKubeAPI(t, tt.secret)
+			ctx := WithRestConfig(context.TODO(), restConfig)
+			connection := &VaultConnection{Address: server.URL, TLSConfig: &TLSConfig{TLSSecret: &corev1.LocalObjectReference{Name: "vault-tls"}}}
+
+			config, err := connection.getConnectionConfig(ctx, "team-a")
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			vaultClient, err := vault.NewClient(config)
+			if err != nil {
+				t.Fatalf("unable to create vault client: %v", err)
+			}
+			kc := &KubeAuthConfiguration{Method: CertAuthMethod, Path: "cert"}
+			if _, err := kc.Login(ctx, vaultClient, "team-a"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(presented, tt.expectedPresented) {
+				t.Errorf("expected the client certificates %v to be presented, got %v", tt.expectedPresented, presented)
+			}
+		})
+	}
+}
@@ -18,19 +18,17 @@ package utils
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
-	"os"
+	"net/http"
 	"strings"
-	"sync"
 
-	"github.com/go-logr/logr"
 	vault "github.com/hashicorp/vault/api"
-	authv1 "k8s.io/api/authentication/v1"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -53,8 +51,6 @@ func AddOrReplaceCondition(c metav1.Condition, conditions []metav1.Condition) []
 	return conditions
 }
 
-var vaultClientCache = VaultClientCache{}
-
 // +kubebuilder:object:generate=true
 // +kubebuilder:validation:Pattern:=`^(?:/?[\w;:@&=\$-\.\+]*)+/?`
 type Path string
@@ -78,15 +74,30 @@ type KubeAuthConfiguration struct {
 	//Namespace is the Vault namespace to be used in all the operations withing this connection/authentication. Only available in Vault Enterprise.
 	// +kubebuilder:validation:Optional
 	Namespace string `json:"namespace,omitempty"`
+
+	// Method is the auth method used by the operator to log in to Vault. "kubernetes" (the default) logs in with a service account token, "jwt" logs in with a service account token requested for the configured audiences, "approle" logs in with the role_id and secret_id found in a secret, "cert" logs in with the client certificate of the connection tLSConfig.tlsSecret. Path must point to a mount of the chosen auth method.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum={"kubernetes","jwt","approle","cert"}
+	// +kubebuilder:default=kubernetes
+	Method string `json:"method,omitempty"`
+
+	// AppRole holds the configuration for the approle auth method. Required when method is approle.
+	// +kubebuilder:validation:Optional
+	AppRole *AppRoleAuthentication `json:"appRole,omitempty"`
+
+	// JWT holds the configuration for the jwt auth method. Optional when method is jwt.
+	// +kubebuilder:validation:Optional
+	JWT *JWTAuthentication `json:"jwt,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
+// +kubebuilder:validation:XValidation:rule="!has(self.connectionRef) || ((!has(self.address) || size(self.address) == 0) && !has(self.tLSConfig))",message="address and tLSConfig cannot be specified together with connectionRef"
 type VaultConnection struct {
 	// +kubebuilder:validation:Optional
 	TLSConfig *TLSConfig `json:"tLSConfig,omitempty"`
 
-	// Address Address of the Vault server expressed as a URL and port, for example: https://127.0.0.1:8200/
-	// +kubebuilder:validation:Required
+	// Address Address of the Vault server expressed as a URL and port, for example: https://127.0.0.1:8200/. Required unless connectionRef is specified.
+	// +kubebuilder:validation:Optional
 	Address string `json:"address,omitempty"`
 
 	// Timeout Timeout variable. The default value is 60s.
@@ -96,10 +107,10 @@ type VaultConnection struct {
 	// MaxRetries Maximum number of retries when certain error codes are encountered. The default is 2, for three total attempts. Set this to 0 or less to disable retrying. Error codes that are retried are 412 (client consistency requirement not satisfied) and all 5xx except for 501 (not implemented).
 	// +kubebuilder:validation:Optional
 	MaxRetries *int `json:"maxRetries,omitempty"`
-}
 
-type VaultClientCache struct {
-	clients sync.Map
+	// ConnectionRef references a VaultConnection or NamespacedVaultConnection holding the connection settings. When specified, address and tLSConfig must be left empty, timeOut and maxRetries override the values of the referenced connection.
+	// +kubebuilder:validation:Optional
+	ConnectionRef *VaultConnectionReference `json:"connectionRef,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -121,32 +132,30 @@ type TLSConfig struct {
 	TLSServerName *string `json:"tlsServerName,omitempty"`
 }
 
-func (cache *VaultClientCache) Get(kc *KubeAuthConfiguration, kubeNamespace string) *vault.Client {
-	if client, ok := cache.clients.Load(kc.getCacheKey(kubeNamespace)); ok {
-		return client.(*vault.Client)
-	}
-
-	return nil
-}
-
-func (cache *VaultClientCache) Put(kc *KubeAuthConfiguration, kubeNamespace string, client *vault.Client) {
-	cache.clients.Store(kc.getCacheKey(kubeNamespace), client)
-}
-
-func (cache *VaultClientCache) Delete(kc *KubeAuthConfiguration, kubeNamespace string) {
-	cache.clients.Delete(kc.getCacheKey(kubeNamespace))
-}
-
 func (vc *VaultConnection) getConnectionConfig(context context.Context, kubeNamespace string) (*vault.Config, error) {
 	log := log.FromContext(context)
-	restConfig := context.Value("restConfig").(*rest.Config)
+	if vc.ConnectionRef != nil {
+		resolved, resolvedNamespace, err := vc.resolveConnectionRef(context, kubeNamespace)
+		if err != nil {
+			log.Error(err, "unable to resolve", "connectionRef", vc.ConnectionRef)
+			return nil, err
+		}
+		return resolved.getConnectionConfig(context, resolvedNamespace)
+	}
+	restConfig, err := GetRestConfigFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes rest config")
+		return nil, err
+	}
 	clientset, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
 		log.Error(err, "unable to create kubernetes clientset")
 		return nil, err
 	}
 	config := vault.DefaultConfig()
-	config.Address = vc.Address
+	if vc.Address != "" {
+		config.Address = vc.Address
+	}
 	if vc.TimeOut != nil {
 		config.Timeout = vc.TimeOut.Duration
 	}
@@ -155,6 +164,8 @@ func (vc *VaultConnection) getConnectionConfig(context context.Context, kubeName
 	}
 	if vc.TLSConfig != nil {
 		tlsConfig := vault.TLSConfig{}
+		// the client certificate of the secret is PEM-encoded, whereas the vault TLS configuration only loads client certificates from files
+		var clientCert, clientKey []byte
 		if vc.TLSConfig.TLSSecret != nil {
 			tlsSecret, err := clientset.CoreV1().Secrets(kubeNamespace).Get(context, vc.TLSConfig.TLSSecret.Name, metav1.GetOptions{})
 			if err != nil {
@@ -164,12 +175,8 @@ func (vc *VaultConnection) getConnectionConfig(context context.Context, kubeName
 			if ca, ok := tlsSecret.Data["ca.crt"]; ok {
 				tlsConfig.CACertBytes = ca
 			}
-			if key, ok := tlsSecret.Data["tls.key"]; ok {
-				tlsConfig.ClientKey = string(key)
-			}
-			if crt, ok := tlsSecret.Data["tls.crt"]; ok {
-				tlsConfig.ClientCert = string(crt)
-			}
+			clientKey = tlsSecret.Data["tls.key"]
+			clientCert = tlsSecret.Data["tls.crt"]
 		}
 		if vc.TLSConfig.Cacert != nil {
 			tlsConfig.CACert = *vc.TLSConfig.Cacert
@@ -178,7 +185,22 @@ func (vc *VaultConnection) getConnectionConfig(context context.Context, kubeName
 			tlsConfig.TLSServerName = *vc.TLSConfig.TLSServerName
 		}
 		tlsConfig.Insecure = vc.TLSConfig.SkipVerify
-		config.ConfigureTLS(&tlsConfig)
+		err = config.ConfigureTLS(&tlsConfig)
+		if err != nil {
+			log.Error(err, "unable to configure the vault connection TLS")
+			return nil, err
+		}
+		if len(clientCert) != 0 || len(clientKey) != 0 {
+			certificate, err := tls.X509KeyPair(clientCert, clientKey)
+			if err != nil {
+				log.Error(err, "unable to load the client certificate of", "secret", vc.TLSConfig.TLSSecret.Name)
+				return nil, err
+			}
+			// as vault does for the certificates loaded from files, the certificate is presented whatever the CAs the server accepts, the cert auth method verifies it
+			config.HttpClient.Transport.(*http.Transport).TLSClientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				return &certificate, nil
+			}
+		}
 	}
 	return config, nil
 }
@@ -200,69 +222,55 @@ func (kc *KubeAuthConfiguration) GetServiceAccountName() string {
 	return "default"
 }
 
-func (kc *KubeAuthConfiguration) getCacheKey(kubeNamespace string) string {
-	return fmt.Sprintf("%s:%s:%s:%s:%s", kubeNamespace, kc.ServiceAccount.Name, kc.Path, kc.Role, kc.Namespace)
+// getCacheKey identifies the vault identity a client is logged in as, so that clients are only shared between objects that would log in the same way against the same vault server
+func (kc *KubeAuthConfiguration) getCacheKey(context context.Context, kubeNamespace string) string {
+	return fmt.Sprintf("%s:%s:%s:%s:%s:%s:%s", getConnectionKey(context), kubeNamespace, kc.GetMethod(), kc.GetServiceAccountName(), kc.Path, kc.Role, kc.Namespace)
 }
 
-func (kc *KubeAuthConfiguration) GetVaultClient(context context.Context, kubeNamespace string) (*vault.Client, error) {
-	log := log.FromContext(context)
+func getConnectionKey(context context.Context) string {
+	vaultConnection := GetVaultConnectionFromContext(context)
+	if vaultConnection == nil {
+		return ""
+	}
+	if vaultConnection.ConnectionRef != nil {
+		return vaultConnection.ConnectionRef.GetKind() + "/" + vaultConnection.ConnectionRef.Name
+	}
+	return vaultConnection.Address
+}
 
-	var vaultClient *vault.Client
+func (kc *KubeAuthConfiguration) GetVaultClient(context context.Context, kubeNamespace string) (_ *vault.Client, err error) {
+	context, span := StartSpan(context, "GetVaultClient", attribute.String("vault.auth.method", kc.GetMethod()), attribute.String("k8s.namespace.name", kubeNamespace))
+	defer func() { EndSpan(span, err) }()
+	log := log.FromContext(context)
 
-	if cacheVaultToken, ok := os.LookupEnv("CACHE_VAULT_TOKEN"); ok && cacheVaultToken == "true" {
-		vaultClient := vaultClientCache.Get(kc, kubeNamespace)
-		if vaultClient != nil {
-			// Check if the client's token is still valid.
-			_, err := vaultClient.Auth().Token().LookupSelf()
-			if err == nil {
-				log.V(1).Info("Returning cached client")
-				return vaultClient, nil
-			}
+	if !IsVaultClientCacheEnabled() {
+		vaultClient, _, err := kc.createVaultClient(context, kubeNamespace)
+		if err != nil {
+			log.Error(err, "unable to create vault client")
+			return nil, err
 		}
+		return vaultClient, nil
 	}
 
-	jwt, err := kc.getJWTToken(context, kubeNamespace)
-	if err != nil {
-		log.Error(err, "unable to retrieve jwt token for", "namespace", kubeNamespace, "serviceaccount", kc.GetServiceAccountName())
-		return nil, err
-	}
-	vaultClient, err = kc.createVaultClient(context, jwt, kubeNamespace)
+	cacheKey := kc.getCacheKey(context, kubeNamespace)
+	vaultClient, err := vaultClientCache.GetOrLogin(cacheKey, func(vaultClient *vault.Client) error {
+		// Check if the client's token is still valid.
+		return observeVaultRequest(context, vaultClient, vaultRequestLookupSelf, func(vaultClient *vault.Client) error {
+			_, err := vaultClient.Auth().Token().LookupSelfWithContext(context)
+			return err
+		})
+	}, func() (*vault.Client, *vault.Secret, error) {
+		return kc.createVaultClient(context, kubeNamespace)
+	}, log.WithValues("namespace", kubeNamespace))
 	if err != nil {
 		log.Error(err, "unable to create vault client")
 		return nil, err
 	}
-
-	if cacheVaultToken, ok := os.LookupEnv("CACHE_VAULT_TOKEN"); !ok || cacheVaultToken == "true" {
-		vaultClientCache.Put(kc, kubeNamespace, vaultClient)
-	}
 	return vaultClient, nil
 }
 
 func GetJWTTokenWithDuration(context context.Context, serviceAccountName string, kubeNamespace string, duration int64) (string, error) {
-	log := log.FromContext(context)
-
-	restConfig := context.Value("restConfig").(*rest.Config)
-
-	treq := &authv1.TokenRequest{
-		Spec: authv1.TokenRequestSpec{
-			ExpirationSeconds: &duration,
-		},
-	}
-
-	clientset, err := kubernetes.NewForConfig(restConfig)
-
-	if err != nil {
-		log.Error(err, "unable to create kubernetes clientset")
-		return "", err
-	}
-
-	treq, err = clientset.CoreV1().ServiceAccounts(kubeNamespace).CreateToken(context, serviceAccountName, treq, metav1.CreateOptions{})
-	if err != nil {
-		log.Error(err, "unable to create service account token request", "in namespace", kubeNamespace, "for service account", serviceAccountName)
-		return "", err
-	}
-
-	return treq.Status.Token, nil
+	return GetJWTTokenWithAudiences(context, serviceAccountName, kubeNamespace, duration, nil)
 }
 
 func GetJWTToken(context context.Context, serviceAccountName string, kubeNamespace string) (string, error) {
@@ -275,17 +283,17 @@ func (kc *KubeAuthConfiguration) getJWTToken(context context.Context, kubeNamesp
 	return GetJWTToken(context, kc.GetServiceAccountName(), kubeNamespace)
 }
 
-func (kc *KubeAuthConfiguration) createVaultClient(context context.Context, jwt string, namespace string) (*vault.Client, error) {
+func (kc *KubeAuthConfiguration) createVaultClient(context context.Context, namespace string) (*vault.Client, *vault.Secret, error) {
 	log := log.FromContext(context)
 	log.V(1).Info("Creating new client")
-	vaultConnection := context.Value("vaultConnection").(*VaultConnection)
+	vaultConnection := GetVaultConnectionFromContext(context)
 	var config *vault.Config
+	var err error
 	if vaultConnection != nil {
-		var err error
 		config, err = vaultConnection.getConnectionConfig(context, namespace)
 		if err != nil {
 			log.Error(err, "unable initialize vault connection configuration")
-			return nil, err
+			return nil, nil, err
 		}
 	} else {
 		config = vault.DefaultConfig()
@@ -294,57 +302,44 @@ func (kc *KubeAuthConfiguration) createVaultClient(context context.Context, jwt
 	client, err := vault.NewClient(config)
 	if err != nil {
 		log.Error(err, "unable initialize vault client")
-		return nil, err
+		return nil, nil, err
 	}
 	if kc.GetNamespace() != "" {
 		client.SetNamespace(kc.GetNamespace())
 	}
-	secret, err := client.Logical().Write(kc.GetKubeAuthPath(), map[string]interface{}{
-		"jwt":  jwt,
-		"role": kc.GetRole(),
-	})
-
+	secret, err := kc.Login(context, client, namespace)
 	if err != nil {
-		log.Error(err, "unable to login to vault")
-		return nil, err
+		return nil, nil, err
 	}
 
 	client.SetToken(secret.Auth.ClientToken)
-	if cacheVaultToken, ok := os.LookupEnv("CACHE_VAULT_TOKEN"); ok && cacheVaultToken == "true" {
-		go kc.startLifetimeWatcher(client, namespace, secret, log)
-	}
 
-	return client, nil
+	return client, secret, nil
 }
 
-// If the TTL for the token is less than its lease duration, the lifetime watcher renews the token until
-// its lease expires.
-func (kc *KubeAuthConfiguration) startLifetimeWatcher(client *vault.Client, kubeNamespace string, secret *vault.Secret, log logr.Logger) {
-	watcher, err := client.NewLifetimeWatcher(&vault.LifetimeWatcherInput{
-		Secret: secret,
-	})
-
+// Login logs vaultClient in with the auth method of this configuration, using the service accounts and secrets of kubeNamespace, and returns the secret carrying the auth information of the new token. The token is not set on vaultClient.
+func (kc *KubeAuthConfiguration) Login(context context.Context, vaultClient *vault.Client, kubeNamespace string) (*vault.Secret, error) {
+	log := log.FromContext(context)
+	authenticator, err := kc.getAuthenticator()
 	if err != nil {
-		log.Error(err, "Unable to start lifetime watcher.")
+		log.Error(err, "unable to select authenticator")
+		return nil, err
 	}
-
-	go watcher.Start()
-	defer watcher.Stop()
-
-	for {
-		select {
-		case err := <-watcher.DoneCh():
-			if err != nil {
-				log.Error(err, "error while renewing token")
-			}
-
-			log.V(1).Info("Deleting cached client")
-			vaultClientCache.Delete(kc, kubeNamespace)
-		case renewal := <-watcher.RenewCh():
-			log.V(1).Info(fmt.Sprintf("Successfully renewed token: %#v", renewal))
-		}
-
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestLogin, func(vaultClient *vault.Client) error {
+		secret, err = authenticator.Login(context, vaultClient, kubeNamespace)
+		return err
+	})
+	if err == nil && (secret == nil || secret.Auth == nil) {
+		err = errors.New("vault login returned no auth information")
+	}
+	if err != nil {
+		vaultLogins.WithLabelValues(kc.GetMethod(), "failure").Inc()
+		log.Error(err, "unable to login to vault", "method", kc.GetMethod())
+		return nil, err
 	}
+	vaultLogins.WithLabelValues(kc.GetMethod(), "success").Inc()
+	return secret, nil
 }
 
 func CleansePath(path string) string {
@@ -0,0 +1,206 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const redactedValue = "<redacted>"
+
+// sensitiveKeyFragments and sensitiveKeySuffixes identify the payload keys whose values must never be written to a drift report
+var sensitiveKeyFragments = []string{"password", "secret", "private_key", "credential", "bindpass"}
+var sensitiveKeySuffixes = []string{"token", "_key", "jwt"}
+
+// +kubebuilder:validation:Enum:={"Added","Removed","Changed"}
+type DriftChange string
+
+const (
+	// DriftAdded means the key is set in Vault but it is not part of the desired state
+	DriftAdded DriftChange = "Added"
+	// DriftRemoved means the key is part of the desired state but it is not set in Vault
+	DriftRemoved DriftChange = "Removed"
+	// DriftChanged means the key is set in Vault with a value different from the desired state
+	DriftChanged DriftChange = "Changed"
+)
+
+// DriftAware objects record in their status the drift last detected, and corrected, in Vault
+type DriftAware interface {
+	GetDriftReport() *DriftReport
+	SetDriftReport(report *DriftReport)
+}
+
+// +kubebuilder:object:generate=true
+type DriftReport struct {
+	// DetectedAt is the time at which the drift was detected and corrected
+	// +kubebuilder:validation:Required
+	DetectedAt metav1.Time `json:"detectedAt"`
+
+	// Fields lists the fields that differed between Vault and the desired state
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Fields []DriftedField `json:"fields,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+type DriftedField struct {
+	// Path is the Vault path at which the field was read
+	// +kubebuilder:validation:Required
+	Path string `json:"path"`
+
+	// Key is the name of the field in the Vault payload
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+
+	// Change is the kind of difference detected
+	// +kubebuilder:validation:Required
+	Change DriftChange `json:"change"`
+
+	// Desired is the value of the field in the desired state. Values of sensitive fields are redacted.
+	// +kubebuilder:validation:Optional
+	Desired string `json:"desired,omitempty"`
+
+	// Actual is the value of the field found in Vault. Values of sensitive fields are redacted.
+	// +kubebuilder:validation:Optional
+	Actual string `json:"actual,omitempty"`
+}
+
+// Summary returns a one line description of the drifted fields, suitable for events and condition messages
+func (dr *DriftReport) Summary() string {
+	counts := map[DriftChange][]string{}
+	for _, field := range dr.Fields {
+		counts[field.Change] = append(counts[field.Change], field.Key)
+	}
+	parts := []string{}
+	for _, change := range []DriftChange{DriftAdded, DriftRemoved, DriftChanged} {
+		if keys, ok := counts[change]; ok {
+			parts = append(parts, fmt.Sprintf("%s: %s", strings.ToLower(string(change)), strings.Join(keys, ", ")))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// ComputeDrift returns the fields that differ between the payload read from Vault at path and the desired payload. Keys set in Vault to an empty value and absent from the desired payload are considered defaults, and sensitive keys absent from Vault are considered write-only; neither is reported.
+func ComputeDrift(path string, desired map[string]interface{}, actual map[string]interface{}) []DriftedField {
+	fields := []DriftedField{}
+	for key, desiredValue := range desired {
+		actualValue, found := actual[key]
+		if !found {
+			// sensitive fields are usually write-only, Vault does not return them
+			if !isSensitiveKey(key) {
+				fields = append(fields, DriftedField{Path: path, Key: key, Change: DriftRemoved, Desired: driftValue(key, desiredValue)})
+			}
+			continue
+		}
+		if normalizeDriftValue(desiredValue) != normalizeDriftValue(actualValue) {
+			fields = append(fields, DriftedField{Path: path, Key: key, Change: DriftChanged, Desired: driftValue(key, desiredValue), Actual: driftValue(key, actualValue)})
+		}
+	}
+	for key, actualValue := range actual {
+		if _, found := desired[key]; found || isEmptyDriftValue(actualValue) {
+			continue
+		}
+		fields = append(fields, DriftedField{Path: path, Key: key, Change: DriftAdded, Actual: driftValue(key, actualValue)})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Key < fields[j].Key
+	})
+	return fields
+}
+
+func isSensitiveKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(lowerKey, fragment) {
+			return true
+		}
+	}
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(lowerKey, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func driftValue(key string, value interface{}) string {
+	if isSensitiveKey(key) {
+		return redactedValue
+	}
+	return normalizeDriftValue(value)
+}
+
+// normalizeDriftValue renders values as json, so that values read from Vault (json.Number, []interface{}) compare equal to the values built by GetPayload (int, []string)
+func normalizeDriftValue(value interface{}) string {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.String {
+		return v.String()
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
+
+func isEmptyDriftValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if n, ok := value.(json.Number); ok {
+		return n.String() == "0"
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	}
+	return v.IsZero()
+}
+
+// driftRecorder accumulates the drift detected, and corrected, by an endpoint during a reconcile cycle
+type driftRecorder struct {
+	drifted bool
+	fields  []DriftedField
+}
+
+func (dr *driftRecorder) recordDrift(path string, desired map[string]interface{}, actual map[string]interface{}) {
+	dr.drifted = true
+	dr.fields = append(dr.fields, ComputeDrift(path, desired, actual)...)
+}
+
+// GetDriftReport returns the drift corrected by the endpoint, or nil if the state found in Vault matched the desired state
+func (dr *driftRecorder) GetDriftReport() *DriftReport {
+	if !dr.drifted {
+		return nil
+	}
+	return &DriftReport{
+		DetectedAt: metav1.Now(),
+		Fields:     dr.fields,
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestComputeDrift(t *testing.T) {
+	audience := "vault"
+	desired := map[string]interface{}{
+		"audience":       &audience,
+		"token_ttl":      3600,
+		"token_policies": []string{"a", "b"},
+		"bound_audience": "vault",
+		"password":       "desired-secret",
+		"bindpass":       "write-only",
+		"description":    "managed",
+	}
+	actual := map[string]interface{}{
+		"audience":       "vault",
+		"token_ttl":      json.Number("600"),
+		"token_policies": []interface{}{"a", "b"},
+		"bound_audience": "vault",
+		"password":       "actual-secret",
+		"token_type":     "default",
+		"token_max_ttl":  json.Number("0"),
+		"alias_metadata": map[string]interface{}{},
+	}
+
+	expected := []DriftedField{
+		{Path: "auth/kubernetes/role/test", Key: "description", Change: DriftRemoved, Desired: "managed"},
+		{Path: "auth/kubernetes/role/test", Key: "password", Change: DriftChanged, Desired: redactedValue, Actual: redactedValue},
+		{Path: "auth/kubernetes/role/test", Key: "token_ttl", Change: DriftChanged, Desired: "3600", Actual: "600"},
+		{Path: "auth/kubernetes/role/test", Key: "token_type", Change: DriftAdded, Actual: "default"},
+	}
+
+	fields := ComputeDrift("auth/kubernetes/role/test", desired, actual)
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("unexpected drift\nexpected: %+v\ngot:      %+v", expected, fields)
+	}
+}
+
+func TestDriftRecorder(t *testing.T) {
+	recorder := driftRecorder{}
+	if recorder.GetDriftReport() != nil {
+		t.Errorf("expected no drift report before drift is recorded")
+	}
+	recorder.recordDrift("sys/policy/test", map[string]interface{}{"policy": "a"}, map[string]interface{}{"policy": "b"})
+	report := recorder.GetDriftReport()
+	if report == nil || len(report.Fields) != 1 {
+		t.Fatalf("expected a drift report with one field, got %+v", report)
+	}
+	if summary := report.Summary(); summary != "changed: policy" {
+		t.Errorf("unexpected summary %q", summary)
+	}
+}
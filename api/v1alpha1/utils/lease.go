@@ -0,0 +1,62 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+
+	vault "github.com/hashicorp/vault/api"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// RenewLease asks vault to extend the lease by increment seconds through sys/leases/renew. The lease duration granted by vault is capped by the max TTL of the lease, it can be shorter than increment.
+func RenewLease(context context.Context, leaseID string, increment int) (*vault.Secret, error) {
+	log := log.FromContext(context)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return nil, err
+	}
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestRenew, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Sys().RenewWithContext(context, leaseID, increment)
+		return err
+	})
+	if err != nil {
+		log.Error(err, "unable to renew lease", "lease_id", leaseID)
+		return nil, err
+	}
+	return secret, nil
+}
+
+// RevokeLease revokes the lease through sys/leases/revoke, invalidating the secret it was issued for
+func RevokeLease(context context.Context, leaseID string) error {
+	log := log.FromContext(context)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return err
+	}
+	err = observeVaultRequest(context, vaultClient, vaultRequestRevoke, func(vaultClient *vault.Client) error {
+		return vaultClient.Sys().RevokeWithContext(context, leaseID)
+	})
+	if err != nil {
+		log.Error(err, "unable to revoke lease", "lease_id", leaseID)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRenewAndRevokeLease(t *testing.T) {
+	requests := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		requests[r.Method+" "+r.URL.Path] = body
+		switch r.URL.Path {
+		case "/v1/sys/leases/renew":
+			w.Header().Set("Content-Type", "application/json")
+			// the max TTL of the lease caps the requested increment
+			_, _ = w.Write([]byte(`{"lease_id": "database/creds/app/abcd", "renewable": true, "lease_duration": 1800}`))
+		case "/v1/sys/leases/revoke":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	vaultClient, _ := newTestVaultClient(t, server.URL, "test")
+	ctx := WithVaultClient(context.Background(), vaultClient)
+
+	secret, err := RenewLease(ctx, "database/creds/app/abcd", 3600)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if secret.LeaseDuration != 1800 {
+		t.Errorf("expected the lease duration granted by vault, got %d", secret.LeaseDuration)
+	}
+	renewal := requests["PUT /v1/sys/leases/renew"]
+	if renewal["lease_id"] != "database/creds/app/abcd" || renewal["increment"] != float64(3600) {
+		t.Errorf("unexpected renewal request %v", renewal)
+	}
+
+	if err := RevokeLease(ctx, "database/creds/app/abcd"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if revocation := requests["PUT /v1/sys/leases/revoke"]; revocation["lease_id"] != "database/creds/app/abcd" {
+		t.Errorf("unexpected revocation request %v", revocation)
+	}
+}
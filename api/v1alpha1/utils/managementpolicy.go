@@ -0,0 +1,124 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum:={"CreateOnly","Adopt","Observe"}
+type ManagementPolicy string
+
+const (
+	// ManagementPolicyCreateOnly only manages Vault objects created by the operator, reconciliation fails if the object already exists in Vault
+	ManagementPolicyCreateOnly ManagementPolicy = "CreateOnly"
+	// ManagementPolicyAdopt manages the Vault object whether it was created by the operator or it already existed
+	ManagementPolicyAdopt ManagementPolicy = "Adopt"
+	// ManagementPolicyObserve never changes the Vault object, differences with the desired state are only reported
+	ManagementPolicyObserve ManagementPolicy = "Observe"
+)
+
+// +kubebuilder:validation:Enum:={"Created","Adopted"}
+type VaultOwnership string
+
+const (
+	VaultOwnershipCreated VaultOwnership = "Created"
+	VaultOwnershipAdopted VaultOwnership = "Adopted"
+)
+
+// OwnershipMarkerKey is the metadata key under which the operator records the resource owning a Vault object, for Vault objects that carry free form metadata
+const OwnershipMarkerKey = "vault-config-operator.redhatcop.redhat.io/owner"
+
+// ErrVaultObjectNotOwned is returned when the management policy forbids taking over an object that already exists in Vault
+var ErrVaultObjectNotOwned = errors.New("object already exists in vault and was not created by this resource")
+
+// ManagementPolicyAware objects declare how the operator manages the corresponding Vault object and record whether the operator created or adopted it
+type ManagementPolicyAware interface {
+	metav1.Object
+	GetManagementPolicy() ManagementPolicy
+	GetVaultOwnership() VaultOwnership
+	SetVaultOwnership(ownership VaultOwnership)
+}
+
+// OwnershipMarkerAware objects carry in their Vault payload a metadata map in which the ownership marker is recorded
+type OwnershipMarkerAware interface {
+	// GetOwnershipMarker returns the ownership marker found in the passed payload read from Vault, or an empty string
+	GetOwnershipMarker(payload map[string]interface{}) string
+}
+
+// GetOwnershipMarker returns the value identifying obj as the owner of a Vault object
+func GetOwnershipMarker(obj metav1.Object) string {
+	return obj.GetNamespace() + "/" + obj.GetName() + "/" + string(obj.GetUID())
+}
+
+// GetOwnershipMarkerFromMetadata extracts the ownership marker from a metadata map as read from Vault
+func GetOwnershipMarkerFromMetadata(metadata interface{}) string {
+	switch m := metadata.(type) {
+	case map[string]interface{}:
+		if marker, ok := m[OwnershipMarkerKey].(string); ok {
+			return marker
+		}
+	case map[string]string:
+		return m[OwnershipMarkerKey]
+	}
+	return ""
+}
+
+// GetManagementPolicy returns the management policy of obj, Adopt for objects that are not ManagementPolicyAware
+func GetManagementPolicy(obj interface{}) ManagementPolicy {
+	if aware, ok := obj.(ManagementPolicyAware); ok && aware.GetManagementPolicy() != "" {
+		return aware.GetManagementPolicy()
+	}
+	return ManagementPolicyAdopt
+}
+
+// CheckManagementPolicy returns an error when the management policy of obj forbids managing the Vault object at path. found tells whether the object exists in Vault and payload is its current state.
+func CheckManagementPolicy(obj interface{}, path string, found bool, payload map[string]interface{}) error {
+	aware, ok := obj.(ManagementPolicyAware)
+	if !ok || !found || aware.GetVaultOwnership() != "" || aware.GetManagementPolicy() != ManagementPolicyCreateOnly {
+		return nil
+	}
+	if markerAware, ok := obj.(OwnershipMarkerAware); ok && markerAware.GetOwnershipMarker(payload) == GetOwnershipMarker(aware) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s, the managementPolicy is %s", ErrVaultObjectNotOwned, path, ManagementPolicyCreateOnly)
+}
+
+// RecordVaultOwnership records on obj that the operator created, or adopted when found is true, the corresponding Vault object. Ownership is recorded only once.
+func RecordVaultOwnership(obj interface{}, found bool) {
+	aware, ok := obj.(ManagementPolicyAware)
+	if !ok || aware.GetVaultOwnership() != "" {
+		return
+	}
+	if found && aware.GetManagementPolicy() != ManagementPolicyCreateOnly {
+		aware.SetVaultOwnership(VaultOwnershipAdopted)
+		return
+	}
+	aware.SetVaultOwnership(VaultOwnershipCreated)
+}
+
+// IsVaultObjectOwned returns whether the operator created or adopted the Vault object corresponding to obj, and may therefore delete it
+func IsVaultObjectOwned(obj interface{}) bool {
+	aware, ok := obj.(ManagementPolicyAware)
+	if !ok {
+		return true
+	}
+	return aware.GetManagementPolicy() != ManagementPolicyObserve && aware.GetVaultOwnership() != ""
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type managedObject struct {
+	metav1.ObjectMeta
+	policy    ManagementPolicy
+	ownership VaultOwnership
+}
+
+func (m *managedObject) GetManagementPolicy() ManagementPolicy {
+	return m.policy
+}
+
+func (m *managedObject) GetVaultOwnership() VaultOwnership {
+	return m.ownership
+}
+
+func (m *managedObject) SetVaultOwnership(ownership VaultOwnership) {
+	m.ownership = ownership
+}
+
+func (m *managedObject) GetOwnershipMarker(payload map[string]interface{}) string {
+	return GetOwnershipMarkerFromMetadata(payload["metadata"])
+}
+
+func TestManagementPolicy(t *testing.T) {
+	tests := []struct {
+		name              string
+		policy            ManagementPolicy
+		ownership         VaultOwnership
+		found             bool
+		payload           map[string]interface{}
+		expectError       bool
+		expectedOwnership VaultOwnership
+	}{
+		{name: "create missing object", policy: ManagementPolicyCreateOnly, found: false, expectedOwnership: VaultOwnershipCreated},
+		{name: "refuse existing object", policy: ManagementPolicyCreateOnly, found: true, expectError: true},
+		{name: "manage object created earlier", policy: ManagementPolicyCreateOnly, ownership: VaultOwnershipCreated, found: true, expectedOwnership: VaultOwnershipCreated},
+		{name: "recognize ownership marker", policy: ManagementPolicyCreateOnly, found: true, payload: map[string]interface{}{
+			"metadata": map[string]interface{}{OwnershipMarkerKey: "team-a/reader/1234"},
+		}, expectedOwnership: VaultOwnershipCreated},
+		{name: "refuse foreign ownership marker", policy: ManagementPolicyCreateOnly, found: true, payload: map[string]interface{}{
+			"metadata": map[string]interface{}{OwnershipMarkerKey: "team-b/reader/5678"},
+		}, expectError: true},
+		{name: "adopt existing object", policy: ManagementPolicyAdopt, found: true, expectedOwnership: VaultOwnershipAdopted},
+		{name: "create with adopt policy", policy: ManagementPolicyAdopt, found: false, expectedOwnership: VaultOwnershipCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &managedObject{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "reader", UID: "1234"},
+				policy:     tt.policy,
+				ownership:  tt.ownership,
+			}
+			err := CheckManagementPolicy(obj, "sys/policy/reader", tt.found, tt.payload)
+			if tt.expectError {
+				if !errors.Is(err, ErrVaultObjectNotOwned) {
+					t.Fatalf("expected ErrVaultObjectNotOwned, got %v", err)
+				}
+				if IsVaultObjectOwned(obj) {
+					t.Errorf("expected refused object not to be deletable")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			RecordVaultOwnership(obj, tt.found)
+			if obj.ownership != tt.expectedOwnership {
+				t.Errorf("expected ownership %s, got %s", tt.expectedOwnership, obj.ownership)
+			}
+			if !IsVaultObjectOwned(obj) {
+				t.Errorf("expected owned object to be deletable")
+			}
+		})
+	}
+}
+
+func TestObserveManagementPolicy(t *testing.T) {
+	obj := &managedObject{policy: ManagementPolicyObserve, ownership: VaultOwnershipAdopted}
+	if !IsObserveOnly(obj) {
+		t.Errorf("expected Observe management policy to imply observe-only")
+	}
+	if IsVaultObjectOwned(obj) {
+		t.Errorf("expected observed object never to be deleted")
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "vault_config_operator"
+
+// operation label values of the vault request metrics
+const (
+	vaultRequestRead       = "read"
+	vaultRequestReadSecret = "read_secret"
+	vaultRequestWrite      = "write"
+	vaultRequestDelete     = "delete"
+	vaultRequestLogin      = "login"
+	vaultRequestRenew      = "renew"
+	vaultRequestRevoke     = "revoke"
+	vaultRequestList       = "list"
+	vaultRequestEncrypt    = "encrypt"
+	vaultRequestHealth     = "health"
+	vaultRequestLookupSelf = "lookup_self"
+)
+
+var vaultRequestLabels = []string{"operation", "kind", "vault_address", "status"}
+
+var (
+	vaultClientCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "client_cache_hits_total",
+		Help:      "Number of vault client lookups served from the client cache.",
+	})
+	vaultClientCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "client_cache_misses_total",
+		Help:      "Number of vault client lookups not found in the client cache, or found with an invalid token.",
+	})
+	vaultClientCacheEvictions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "client_cache_evictions_total",
+		Help:      "Number of vault clients removed from the client cache, by reason.",
+	}, []string{"reason"})
+	vaultClientCacheSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "client_cache_size",
+		Help:      "Number of vault clients currently held in the client cache.",
+	})
+	vaultLogins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "vault_logins_total",
+		Help:      "Number of vault logins performed by the operator, by auth method and result.",
+	}, []string{"method", "result"})
+	vaultTokenRenewalFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "vault_token_renewal_failures_total",
+		Help:      "Number of cached vault tokens whose renewal failed.",
+	})
+	vaultRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "vault_requests_total",
+		Help:      "Number of requests sent to vault, by operation, kind of the reconciled resource, vault address and http status.",
+	}, vaultRequestLabels)
+	vaultRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "vault_request_duration_seconds",
+		Help:      "Duration of the requests sent to vault, by operation, kind of the reconciled resource, vault address and http status.",
+		Buckets:   prometheus.DefBuckets,
+	}, vaultRequestLabels)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		vaultClientCacheHits,
+		vaultClientCacheMisses,
+		vaultClientCacheEvictions,
+		vaultClientCacheSize,
+		vaultLogins,
+		vaultTokenRenewalFailures,
+		vaultRequests,
+		vaultRequestDuration,
+	)
+}
+
+// observeVaultRequest performs request with a copy of vaultClient that captures the http status of the response, and records the request metrics and span. The status is "error" when no response was received.
+func observeVaultRequest(context context.Context, vaultClient *vault.Client, operation string, request func(vaultClient *vault.Client) error) (err error) {
+	context, span := StartSpan(context, "vault "+operation, attribute.String("vault.operation", operation), attribute.String("server.address", vaultClient.Address()))
+	defer func() { EndSpan(span, err) }()
+	status := "error"
+	observedClient := traceVaultRequests(context, vaultClient, span).WithResponseCallbacks(func(response *vault.Response) {
+		status = strconv.Itoa(response.StatusCode)
+		span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+	})
+	start := time.Now()
+	err = request(observedClient)
+	labels := prometheus.Labels{
+		"operation":     operation,
+		"kind":          GetReconciledKindFromContext(context),
+		"vault_address": vaultClient.Address(),
+		"status":        status,
+	}
+	vaultRequests.With(labels).Inc()
+	vaultRequestDuration.With(labels).Observe(time.Since(start).Seconds())
+	return err
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestVaultRequestMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/sys/policy/reader":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data": {"name": "reader", "rules": ""}}`))
+		case "/v1/sys/policy/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	vaultClient, _ := newTestVaultClient(t, server.URL, "test")
+	ctx := WithReconciledKind(WithVaultClient(context.Background(), vaultClient), "Policy")
+
+	labels := func(operation string, status string) prometheus.Labels {
+		return prometheus.Labels{"operation": operation, "kind": "Policy", "vault_address": server.URL, "status": status}
+	}
+	if _, found, err := read(ctx, "sys/policy/reader"); err != nil || !found {
+		t.Fatalf("expected policy to be found, got %v, %v", found, err)
+	}
+	if _, found, _ := read(ctx, "sys/policy/missing"); found {
+		t.Fatalf("expected policy not to be found")
+	}
+	if _, _, err := ReadSecret(ctx, "sys/policy/forbidden"); err == nil {
+		t.Fatalf("expected forbidden read to fail")
+	}
+
+	for _, tt := range []struct {
+		operation string
+		status    string
+	}{
+		{vaultRequestRead, "200"},
+		{vaultRequestRead, "404"},
+		{vaultRequestReadSecret, "403"},
+	} {
+		if count := testutil.ToFloat64(vaultRequests.With(labels(tt.operation, tt.status))); count != 1 {
+			t.Errorf("expected one %s request with status %s, got %v", tt.operation, tt.status, count)
+		}
+	}
+	if count := testutil.CollectAndCount(vaultRequestDuration); count < 3 {
+		t.Errorf("expected request durations to be recorded, got %d series", count)
+	}
+}
+
+func TestVaultRequestMetricsWithoutResponse(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	address := server.URL
+	server.Close()
+	vaultClient, _ := newTestVaultClient(t, address, "test")
+	vaultClient.SetMaxRetries(0)
+	ctx := WithVaultClient(context.Background(), vaultClient)
+
+	if err := write(ctx, "sys/policy/reader", map[string]interface{}{"policy": ""}); err == nil {
+		t.Fatalf("expected write to an unreachable vault to fail")
+	}
+	labels := prometheus.Labels{"operation": vaultRequestWrite, "kind": "", "vault_address": address, "status": "error"}
+	if count := testutil.ToFloat64(vaultRequests.With(labels)); count != 1 {
+		t.Errorf("expected one failed write, got %v", count)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ObserveOnlyAnnotation, when set to "true" on a resource, makes the operator report the changes it would make in Vault instead of making them
+const ObserveOnlyAnnotation = "redhatcop.redhat.io/observe-only"
+
+type VaultOperation string
+
+const (
+	VaultWrite  VaultOperation = "write"
+	VaultDelete VaultOperation = "delete"
+)
+
+var observeOnly bool
+
+// SetObserveOnly turns observe-only mode on for every resource managed by the operator
+func SetObserveOnly(enabled bool) {
+	observeOnly = enabled
+}
+
+// IsObserveOnly returns whether changes to Vault must only be reported for the passed object, either because the operator runs in observe-only mode, because the object carries the observe-only annotation or because its management policy is Observe
+func IsObserveOnly(obj metav1.Object) bool {
+	if observeOnly || GetManagementPolicy(obj) == ManagementPolicyObserve {
+		return true
+	}
+	value, ok := obj.GetAnnotations()[ObserveOnlyAnnotation]
+	return ok && strings.EqualFold(value, "true")
+}
+
+// PlannedOperation is a write or delete that was not performed because the reconcile cycle runs in observe-only mode
+type PlannedOperation struct {
+	Operation VaultOperation
+	Path      string
+}
+
+func (po PlannedOperation) String() string {
+	return string(po.Operation) + " " + po.Path
+}
+
+type operationPlan struct {
+	mutex      sync.Mutex
+	operations []PlannedOperation
+}
+
+// WithObserveOnly returns a context in which the writes and deletes issued through this package are recorded instead of being sent to Vault
+func WithObserveOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operationPlanContextKey, &operationPlan{})
+}
+
+// IsObserveOnlyContext returns whether the context was prepared with WithObserveOnly
+func IsObserveOnlyContext(ctx context.Context) bool {
+	_, ok := ctx.Value(operationPlanContextKey).(*operationPlan)
+	return ok
+}
+
+// PlanOperation records the passed operation and returns true when the context is observe-only, in which case the caller must not perform it
+func PlanOperation(ctx context.Context, operation VaultOperation, path string) bool {
+	plan, ok := ctx.Value(operationPlanContextKey).(*operationPlan)
+	if !ok {
+		return false
+	}
+	log.FromContext(ctx).Info("observe-only, skipping vault operation", "operation", operation, "path", path)
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+	plan.operations = append(plan.operations, PlannedOperation{Operation: operation, Path: path})
+	return true
+}
+
+// GetPlannedOperations returns the operations recorded in an observe-only context
+func GetPlannedOperations(ctx context.Context) []PlannedOperation {
+	plan, ok := ctx.Value(operationPlanContextKey).(*operationPlan)
+	if !ok {
+		return nil
+	}
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+	return append([]PlannedOperation{}, plan.operations...)
+}
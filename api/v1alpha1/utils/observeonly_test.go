@@ -0,0 +1,67 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsObserveOnly(t *testing.T) {
+	annotated := &metav1.ObjectMeta{Annotations: map[string]string{ObserveOnlyAnnotation: "true"}}
+	plain := &metav1.ObjectMeta{}
+
+	if !IsObserveOnly(annotated) {
+		t.Errorf("expected annotated object to be observe-only")
+	}
+	if IsObserveOnly(plain) {
+		t.Errorf("expected object without annotation not to be observe-only")
+	}
+
+	SetObserveOnly(true)
+	defer SetObserveOnly(false)
+	if !IsObserveOnly(plain) {
+		t.Errorf("expected every object to be observe-only when the operator runs in observe-only mode")
+	}
+}
+
+func TestObserveOnlyContextSkipsWrites(t *testing.T) {
+	// no vault client in the context: the write would fail if it was not skipped
+	ctx := WithObserveOnly(context.TODO())
+
+	secret, err := writeWithResponse(ctx, "sys/policy/test", map[string]interface{}{"policy": ""})
+	if err != nil || secret != nil {
+		t.Fatalf("expected write to be skipped, got %v, %v", secret, err)
+	}
+	if !PlanOperation(ctx, VaultDelete, "sys/policy/other") {
+		t.Fatalf("expected delete to be planned")
+	}
+
+	expected := []PlannedOperation{
+		{Operation: VaultWrite, Path: "sys/policy/test"},
+		{Operation: VaultDelete, Path: "sys/policy/other"},
+	}
+	if operations := GetPlannedOperations(ctx); !reflect.DeepEqual(operations, expected) {
+		t.Errorf("expected %v, got %v", expected, operations)
+	}
+	if PlanOperation(context.TODO(), VaultWrite, "sys/policy/test") {
+		t.Errorf("expected operations not to be planned outside an observe-only context")
+	}
+}
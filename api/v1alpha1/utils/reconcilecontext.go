@@ -0,0 +1,99 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vault "github.com/hashicorp/vault/api"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrMissingContextValue is returned when a reconcile scoped value has not been set in the context
+var ErrMissingContextValue = errors.New("value not found in reconcile context")
+
+// contextKey is unexported so that reconcile scoped values can only be set and retrieved via the functions in this file
+type contextKey int
+
+const (
+	kubeClientContextKey contextKey = iota
+	restConfigContextKey
+	vaultConnectionContextKey
+	vaultClientContextKey
+	operationPlanContextKey
+	reconciledKindContextKey
+)
+
+func WithKubeClient(ctx context.Context, kubeClient client.Client) context.Context {
+	return context.WithValue(ctx, kubeClientContextKey, kubeClient)
+}
+
+func WithRestConfig(ctx context.Context, restConfig *rest.Config) context.Context {
+	return context.WithValue(ctx, restConfigContextKey, restConfig)
+}
+
+func WithVaultConnection(ctx context.Context, vaultConnection *VaultConnection) context.Context {
+	return context.WithValue(ctx, vaultConnectionContextKey, vaultConnection)
+}
+
+func WithVaultClient(ctx context.Context, vaultClient *vault.Client) context.Context {
+	return context.WithValue(ctx, vaultClientContextKey, vaultClient)
+}
+
+// WithReconciledKind records the kind of the resource being reconciled, which labels the metrics of the vault requests
+func WithReconciledKind(ctx context.Context, kind string) context.Context {
+	return context.WithValue(ctx, reconciledKindContextKey, kind)
+}
+
+func GetKubeClientFromContext(ctx context.Context) (client.Client, error) {
+	kubeClient, ok := ctx.Value(kubeClientContextKey).(client.Client)
+	if !ok || kubeClient == nil {
+		return nil, fmt.Errorf("kubernetes client: %w", ErrMissingContextValue)
+	}
+	return kubeClient, nil
+}
+
+func GetRestConfigFromContext(ctx context.Context) (*rest.Config, error) {
+	restConfig, ok := ctx.Value(restConfigContextKey).(*rest.Config)
+	if !ok || restConfig == nil {
+		return nil, fmt.Errorf("kubernetes rest config: %w", ErrMissingContextValue)
+	}
+	return restConfig, nil
+}
+
+// GetVaultConnectionFromContext returns the vault connection of the object being reconciled, nil means the connection is configured via the VAULT_* environment variables
+func GetVaultConnectionFromContext(ctx context.Context) *VaultConnection {
+	vaultConnection, _ := ctx.Value(vaultConnectionContextKey).(*VaultConnection)
+	return vaultConnection
+}
+
+func GetVaultClientFromContext(ctx context.Context) (*vault.Client, error) {
+	vaultClient, ok := ctx.Value(vaultClientContextKey).(*vault.Client)
+	if !ok || vaultClient == nil {
+		return nil, fmt.Errorf("vault client: %w", ErrMissingContextValue)
+	}
+	return vaultClient, nil
+}
+
+// GetReconciledKindFromContext returns the kind of the resource being reconciled, or an empty string outside of a reconcile cycle
+func GetReconciledKindFromContext(ctx context.Context) string {
+	kind, _ := ctx.Value(reconciledKindContextKey).(string)
+	return kind
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	vault "github.com/hashicorp/vault/api"
+	"k8s.io/client-go/rest"
+)
+
+func TestMissingContextValuesReturnErrors(t *testing.T) {
+	ctx := context.TODO()
+
+	if _, err := GetKubeClientFromContext(ctx); !errors.Is(err, ErrMissingContextValue) {
+		t.Errorf("expected missing kube client error, got %v", err)
+	}
+	if _, err := GetRestConfigFromContext(ctx); !errors.Is(err, ErrMissingContextValue) {
+		t.Errorf("expected missing rest config error, got %v", err)
+	}
+	if _, err := GetVaultClientFromContext(ctx); !errors.Is(err, ErrMissingContextValue) {
+		t.Errorf("expected missing vault client error, got %v", err)
+	}
+	if GetVaultConnectionFromContext(ctx) != nil {
+		t.Errorf("expected no vault connection")
+	}
+	if err := write(ctx, "secret/foo", map[string]interface{}{}); !errors.Is(err, ErrMissingContextValue) {
+		t.Errorf("expected write without vault client to fail, got %v", err)
+	}
+	if _, _, err := read(ctx, "secret/foo"); !errors.Is(err, ErrMissingContextValue) {
+		t.Errorf("expected read without vault client to fail, got %v", err)
+	}
+}
+
+func TestContextValuesRoundTrip(t *testing.T) {
+	vaultClient, err := vault.NewClient(vault.DefaultConfig())
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	restConfig := &rest.Config{Host: "https://kubernetes.default.svc"}
+	vaultConnection := &VaultConnection{Address: "https://vault.example.com:8200"}
+
+	ctx := WithVaultClient(context.TODO(), vaultClient)
+	ctx = WithRestConfig(ctx, restConfig)
+	ctx = WithVaultConnection(ctx, vaultConnection)
+
+	if got, err := GetVaultClientFromContext(ctx); err != nil || got != vaultClient {
+		t.Errorf("expected vault client to be returned, got %v, %v", got, err)
+	}
+	if got, err := GetRestConfigFromContext(ctx); err != nil || got != restConfig {
+		t.Errorf("expected rest config to be returned, got %v, %v", got, err)
+	}
+	if got := GetVaultConnectionFromContext(ctx); got != vaultConnection {
+		t.Errorf("expected vault connection to be returned, got %v", got)
+	}
+}
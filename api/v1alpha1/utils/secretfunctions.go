@@ -0,0 +1,90 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ListSecret lists the keys at path, the keys ending with / are folders. An empty list is returned when there are no keys at path.
+func ListSecret(context context.Context, path string) ([]string, error) {
+	log := log.FromContext(context)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return nil, err
+	}
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestList, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Logical().ListWithContext(context, path)
+		return err
+	})
+	if err != nil {
+		log.Error(err, "unable to list keys at", "path", path)
+		return nil, err
+	}
+	keys := []string{}
+	if secret == nil || secret.Data == nil {
+		return keys, nil
+	}
+	values, ok := secret.Data["keys"].([]interface{})
+	if !ok {
+		return keys, nil
+	}
+	for _, value := range values {
+		if key, ok := value.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// TransitEncrypt encrypts plaintext with the key of the transit secret engine mounted at mount, and returns the ciphertext
+func TransitEncrypt(context context.Context, mount string, key string, plaintext string) (string, error) {
+	log := log.FromContext(context)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return "", err
+	}
+	path := strings.Trim(mount, "/") + "/encrypt/" + key
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestEncrypt, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Logical().WriteWithContext(context, path, map[string]interface{}{
+			"plaintext": base64.StdEncoding.EncodeToString([]byte(plaintext)),
+		})
+		return err
+	})
+	if err != nil {
+		log.Error(err, "unable to encrypt with", "path", path)
+		return "", err
+	}
+	if secret == nil || secret.Data == nil {
+		return "", fmt.Errorf("no ciphertext returned by %s", path)
+	}
+	ciphertext, ok := secret.Data["ciphertext"].(string)
+	if !ok {
+		return "", fmt.Errorf("no ciphertext returned by %s", path)
+	}
+	return ciphertext, nil
+}
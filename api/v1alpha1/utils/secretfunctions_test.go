@@ -0,0 +1,90 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/kv/metadata/app" && (r.Method == "LIST" || r.URL.Query().Get("list") == "true") {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data": {"keys": ["db", "api", "nested/"]}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	vaultClient, _ := newTestVaultClient(t, server.URL, "test")
+	ctx := WithVaultClient(context.Background(), vaultClient)
+
+	keys, err := ListSecret(ctx, "kv/metadata/app")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(keys, []string{"db", "api", "nested/"}) {
+		t.Errorf("unexpected keys %v", keys)
+	}
+
+	keys, err = ListSecret(ctx, "kv/metadata/missing")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("expected no keys, got %v", keys)
+	}
+}
+
+func TestTransitEncrypt(t *testing.T) {
+	requests := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		requests[r.Method+" "+r.URL.Path] = body
+		if r.URL.Path == "/v1/transit/encrypt/app" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data": {"ciphertext": "vault:v1:abcd", "key_version": 1}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	vaultClient, _ := newTestVaultClient(t, server.URL, "test")
+	ctx := WithVaultClient(context.Background(), vaultClient)
+
+	ciphertext, err := TransitEncrypt(ctx, "/transit/", "app", "s3cr3t")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if ciphertext != "vault:v1:abcd" {
+		t.Errorf("unexpected ciphertext %s", ciphertext)
+	}
+	if request := requests["PUT /v1/transit/encrypt/app"]; request["plaintext"] != base64.StdEncoding.EncodeToString([]byte("s3cr3t")) {
+		t.Errorf("unexpected encryption request %v", request)
+	}
+
+	if _, err := TransitEncrypt(ctx, "transit", "missing", "s3cr3t"); err == nil {
+		t.Errorf("expected an error for a missing key")
+	}
+}
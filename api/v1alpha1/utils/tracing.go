@@ -0,0 +1,84 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"net/http"
+
+	vault "github.com/hashicorp/vault/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/redhat-cop/vault-config-operator"
+
+// SetupTracing exports the spans of the operator with OTLP over http and propagates the trace context with the W3C traceparent header. The exporter is configured with the standard OTEL_EXPORTER_OTLP_* environment variables.
+// The returned function flushes the pending spans and stops the exporter. Until SetupTracing is called, spans are not recorded.
+func SetupTracing(context context.Context) (func(context.Context) error, error) {
+	exporter, err := otlptracehttp.New(context)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName("vault-config-operator")))
+	if err != nil {
+		return nil, err
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tracerProvider.Shutdown, nil
+}
+
+// StartSpan starts a span of the operator tracer, child of the span of context if any
+func StartSpan(context context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(context, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan records err, if any, as the outcome of span and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// traceVaultRequests returns a copy of vaultClient that propagates the trace context of context to vault, so that the requests can be correlated with the vault audit log, and that records the path and status of each request on span
+func traceVaultRequests(context context.Context, vaultClient *vault.Client, span trace.Span) *vault.Client {
+	return vaultClient.WithRequestCallbacks(func(request *vault.Request) {
+		// the headers are a copy of the client headers, they can be modified
+		if request.Headers == nil {
+			request.Headers = http.Header{}
+		}
+		otel.GetTextMapPropagator().Inject(context, propagation.HeaderCarrier(request.Headers))
+		span.SetAttributes(
+			attribute.String("http.request.method", request.Method),
+			attribute.String("url.path", request.URL.Path),
+		)
+	})
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func setupTestTracing(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	// the global delegates cannot be restored once set, the noop implementations behave the same
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+	return recorder
+}
+
+func TestVaultRequestTracing(t *testing.T) {
+	recorder := setupTestTracing(t)
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	vaultClient, _ := newTestVaultClient(t, server.URL, "test")
+
+	ctx, parent := StartSpan(context.Background(), "Reconcile")
+	ctx = WithVaultClient(ctx, vaultClient)
+	_, _, err := ReadSecret(ctx, "sys/policy/forbidden")
+	if err == nil {
+		t.Fatalf("expected forbidden read to fail")
+	}
+	EndSpan(parent, nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	request := spans[0]
+	if request.Name() != "vault read_secret" || request.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("expected a vault read_secret span child of the reconcile span, got %s", request.Name())
+	}
+	if request.Status().Code != codes.Error {
+		t.Errorf("expected the failed request to be reported, got %v", request.Status())
+	}
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range request.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	if attributes["url.path"].AsString() != "/v1/sys/policy/forbidden" || attributes["http.response.status_code"].AsInt64() != http.StatusForbidden {
+		t.Errorf("unexpected request attributes %v", request.Attributes())
+	}
+
+	// vault receives the trace context of the request span
+	expected := "00-" + request.SpanContext().TraceID().String() + "-" + request.SpanContext().SpanID().String() + "-01"
+	if traceparent != expected {
+		t.Errorf("expected traceparent %s, got %s", expected, traceparent)
+	}
+	if vaultClient.Headers().Get("traceparent") != "" {
+		t.Errorf("expected the headers of the vault client not to be modified")
+	}
+}
+
+func TestVaultRequestWithoutTracing(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	vaultClient, _ := newTestVaultClient(t, server.URL, "test")
+
+	if _, _, err := read(WithVaultClient(context.Background(), vaultClient), "sys/policy/missing"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if traceparent != "" {
+		t.Errorf("expected no trace context to be sent when tracing is not set up, got %s", traceparent)
+	}
+}
+
+func TestWriteSecretTracing(t *testing.T) {
+	recorder := setupTestTracing(t)
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	vaultClient, _ := newTestVaultClient(t, server.URL, "test")
+
+	ctx := WithVaultClient(context.Background(), vaultClient)
+	if _, err := WriteSecret(ctx, "identity/group-alias", map[string]interface{}{"name": "admins"}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Name() != "vault write" {
+		t.Fatalf("expected a vault write span, got %v", spans)
+	}
+	expected := "00-" + spans[0].SpanContext().TraceID().String() + "-" + spans[0].SpanContext().SpanID().String() + "-01"
+	if traceparent != expected {
+		t.Errorf("expected traceparent %s, got %s", expected, traceparent)
+	}
+}
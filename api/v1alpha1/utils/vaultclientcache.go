@@ -0,0 +1,236 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"container/list"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	vault "github.com/hashicorp/vault/api"
+	"golang.org/x/sync/singleflight"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const defaultVaultClientCacheSize = 1000
+
+// defaultEvictedTokenRevocationDelay leaves the reconcile cycles that retrieved a client before it was evicted the time to complete before its token is revoked
+const defaultEvictedTokenRevocationDelay = 5 * time.Minute
+
+const (
+	evictionReasonCapacity = "capacity"
+	evictionReasonExpired  = "expired"
+	evictionReasonInvalid  = "invalid"
+)
+
+var vaultClientCache = NewVaultClientCache(vaultClientCacheSizeFromEnv())
+
+// IsVaultClientCacheEnabled returns whether vault clients are cached between reconcile cycles
+// Controlled via CACHE_VAULT_TOKEN environment variable (default: false)
+func IsVaultClientCacheEnabled() bool {
+	cacheVaultToken, ok := os.LookupEnv("CACHE_VAULT_TOKEN")
+	return ok && cacheVaultToken == "true"
+}
+
+// vaultClientCacheSizeFromEnv returns the maximum number of cached vault clients
+// Controlled via VAULT_CLIENT_CACHE_SIZE environment variable (default: 1000)
+func vaultClientCacheSizeFromEnv() int {
+	if size, ok := os.LookupEnv("VAULT_CLIENT_CACHE_SIZE"); ok {
+		if sizeInt, err := strconv.Atoi(size); err == nil && sizeInt > 0 {
+			return sizeInt
+		}
+		ctrl.Log.WithName("vault-client-cache").Info("ignoring invalid VAULT_CLIENT_CACHE_SIZE", "value", size)
+	}
+	return defaultVaultClientCacheSize
+}
+
+type vaultClientCacheEntry struct {
+	key    string
+	client *vault.Client
+	// done is closed when the entry leaves the cache, it stops the lifetime watcher of the entry
+	done chan struct{}
+}
+
+// VaultClientCache is a size bounded, least recently used, cache of logged in vault clients. Each cached client has a lifetime watcher that renews its token and removes it from the cache when the token can no longer be renewed.
+type VaultClientCache struct {
+	mutex   sync.Mutex
+	maxSize int
+	entries map[string]*list.Element
+	lru     *list.List
+	// logins serializes the logins for the same key, so that concurrent cache misses share a single client
+	logins singleflight.Group
+	// revocationDelay is the time after which the token of a client evicted for capacity is revoked
+	revocationDelay time.Duration
+}
+
+func NewVaultClientCache(maxSize int) *VaultClientCache {
+	return &VaultClientCache{
+		maxSize:         maxSize,
+		entries:         map[string]*list.Element{},
+		lru:             list.New(),
+		revocationDelay: defaultEvictedTokenRevocationDelay,
+	}
+}
+
+// GetOrLogin returns the client cached for key when validate accepts it, otherwise it logs in with login and caches the new client. Concurrent logins for the same key are serialized and share their result.
+func (cache *VaultClientCache) GetOrLogin(key string, validate func(*vault.Client) error, login func() (*vault.Client, *vault.Secret, error), log logr.Logger) (*vault.Client, error) {
+	if client := cache.Get(key); client != nil {
+		err := validate(client)
+		if err == nil {
+			vaultClientCacheHits.Inc()
+			return client, nil
+		}
+		log.V(1).Info("cached client token is no longer valid", "error", err.Error())
+		cache.Invalidate(key, client)
+	}
+	vaultClientCacheMisses.Inc()
+	result, err, _ := cache.logins.Do(key, func() (interface{}, error) {
+		// a login for the same key may have completed while this one was waiting
+		if client := cache.Get(key); client != nil {
+			return client, nil
+		}
+		client, secret, err := login()
+		if err != nil {
+			return nil, err
+		}
+		return cache.Put(key, client, secret, log), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*vault.Client), nil
+}
+
+// Get returns the client cached for key, or nil
+func (cache *VaultClientCache) Get(key string) *vault.Client {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, ok := cache.entries[key]; ok {
+		cache.lru.MoveToFront(element)
+		return element.Value.(*vaultClientCacheEntry).client
+	}
+	return nil
+}
+
+// Put caches client under key and starts renewing the token described by secret, and returns the cached client. If a client is already cached for key it is kept and returned, and the token of client is revoked as no one else uses it. If the cache is full the least recently used client is evicted and its token revoked once the revocation delay has elapsed.
+func (cache *VaultClientCache) Put(key string, client *vault.Client, secret *vault.Secret, log logr.Logger) *vault.Client {
+	cache.mutex.Lock()
+	if element, ok := cache.entries[key]; ok {
+		cache.lru.MoveToFront(element)
+		cached := element.Value.(*vaultClientCacheEntry).client
+		cache.mutex.Unlock()
+		revoke(client, log)
+		return cached
+	}
+	entry := &vaultClientCacheEntry{
+		key:    key,
+		client: client,
+		done:   make(chan struct{}),
+	}
+	evicted := []*vaultClientCacheEntry{}
+	cache.entries[key] = cache.lru.PushFront(entry)
+	for cache.lru.Len() > cache.maxSize {
+		evicted = append(evicted, cache.removeElement(cache.lru.Back(), evictionReasonCapacity))
+	}
+	vaultClientCacheSize.Set(float64(cache.lru.Len()))
+	cache.mutex.Unlock()
+
+	for _, e := range evicted {
+		evictedClient := e.client
+		time.AfterFunc(cache.revocationDelay, func() { revoke(evictedClient, log) })
+	}
+	go cache.watch(entry, secret, log)
+	return client
+}
+
+// Invalidate removes client from the cache, if it is still the client cached for key, without revoking its token. It is used when the token is known to be invalid already.
+func (cache *VaultClientCache) Invalidate(key string, client *vault.Client) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, ok := cache.entries[key]; ok && element.Value.(*vaultClientCacheEntry).client == client {
+		cache.removeElement(element, evictionReasonInvalid)
+		vaultClientCacheSize.Set(float64(cache.lru.Len()))
+	}
+}
+
+// Len returns the number of cached clients
+func (cache *VaultClientCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.lru.Len()
+}
+
+// removeEntry removes entry from the cache, if it is still the entry cached for its key. Must be called without holding the lock.
+func (cache *VaultClientCache) removeEntry(entry *vaultClientCacheEntry, reason string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, ok := cache.entries[entry.key]; ok && element.Value.(*vaultClientCacheEntry) == entry {
+		cache.removeElement(element, reason)
+		vaultClientCacheSize.Set(float64(cache.lru.Len()))
+	}
+}
+
+// removeElement must be called holding the lock
+func (cache *VaultClientCache) removeElement(element *list.Element, reason string) *vaultClientCacheEntry {
+	entry := cache.lru.Remove(element).(*vaultClientCacheEntry)
+	delete(cache.entries, entry.key)
+	close(entry.done)
+	vaultClientCacheEvictions.WithLabelValues(reason).Inc()
+	return entry
+}
+
+func revoke(client *vault.Client, log logr.Logger) {
+	err := client.Auth().Token().RevokeSelf("")
+	if err != nil {
+		log.Error(err, "unable to revoke token of unused vault client")
+	}
+}
+
+// watch renews the token of a cached client until the entry leaves the cache or the token can no longer be renewed, in which case the entry is removed from the cache.
+func (cache *VaultClientCache) watch(entry *vaultClientCacheEntry, secret *vault.Secret, log logr.Logger) {
+	watcher, err := entry.client.NewLifetimeWatcher(&vault.LifetimeWatcherInput{
+		Secret: secret,
+	})
+	if err != nil {
+		log.Error(err, "unable to start lifetime watcher, the client will not be cached")
+		cache.removeEntry(entry, evictionReasonInvalid)
+		return
+	}
+
+	go watcher.Start()
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-entry.done:
+			return
+		case err := <-watcher.DoneCh():
+			if err != nil {
+				vaultTokenRenewalFailures.Inc()
+				log.Error(err, "error while renewing token")
+			}
+			log.V(1).Info("Deleting cached client")
+			cache.removeEntry(entry, evictionReasonExpired)
+			return
+		case renewal := <-watcher.RenewCh():
+			log.V(1).Info("Successfully renewed token", "renewedAt", renewal.RenewedAt)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func newTestVaultClient(t *testing.T, address string, token string) (*vault.Client, *vault.Secret) {
+	config := vault.DefaultConfig()
+	config.Address = address
+	client, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	client.SetToken(token)
+	// non renewable tokens are watched until they expire, without calling vault
+	return client, &vault.Secret{Auth: &vault.SecretAuth{ClientToken: token, LeaseDuration: 3600}}
+}
+
+func TestVaultClientCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	var mutex sync.Mutex
+	revoked := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth/token/revoke-self" {
+			mutex.Lock()
+			revoked[r.Header.Get("X-Vault-Token")] = true
+			mutex.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cache := NewVaultClientCache(2)
+	cache.revocationDelay = 10 * time.Millisecond
+	for _, key := range []string{"a", "b"} {
+		client, secret := newTestVaultClient(t, server.URL, key)
+		cache.Put(key, client, secret, logr.Discard())
+	}
+	// touch "a" so that "b" becomes the least recently used client
+	if cache.Get("a") == nil {
+		t.Fatalf("expected client a to be cached")
+	}
+	client, secret := newTestVaultClient(t, server.URL, "c")
+	cache.Put("c", client, secret, logr.Discard())
+
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 cached clients, got %d", cache.Len())
+	}
+	if cache.Get("b") != nil {
+		t.Errorf("expected client b to be evicted")
+	}
+	if cache.Get("a") == nil || cache.Get("c") == nil {
+		t.Errorf("expected clients a and c to be cached")
+	}
+	mutex.Lock()
+	if len(revoked) != 0 {
+		t.Errorf("expected the token of client b to be revoked after the revocation delay only, got %v", revoked)
+	}
+	mutex.Unlock()
+	time.Sleep(100 * time.Millisecond)
+	mutex.Lock()
+	defer mutex.Unlock()
+	if !revoked["b"] || len(revoked) != 1 {
+		t.Errorf("expected only the token of client b to be revoked, got %v", revoked)
+	}
+}
+
+func TestVaultClientCacheInvalidate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected call to vault: %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	cache := NewVaultClientCache(2)
+	client, secret := newTestVaultClient(t, server.URL, "a")
+	cache.Put("a", client, secret, logr.Discard())
+	other, _ := newTestVaultClient(t, server.URL, "b")
+	cache.Invalidate("a", other)
+	if cache.Get("a") != client {
+		t.Errorf("expected client a not to be removed by the invalidation of another client")
+	}
+	cache.Invalidate("a", client)
+
+	if cache.Get("a") != nil || cache.Len() != 0 {
+		t.Errorf("expected client a to be removed from the cache")
+	}
+}
+
+func TestVaultClientCachePutKeepsCachedClient(t *testing.T) {
+	var mutex sync.Mutex
+	revoked := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth/token/revoke-self" {
+			mutex.Lock()
+			revoked[r.Header.Get("X-Vault-Token")] = true
+			mutex.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cache := NewVaultClientCache(2)
+	first, secret := newTestVaultClient(t, server.URL, "first")
+	if cached := cache.Put("a", first, secret, logr.Discard()); cached != first {
+		t.Fatalf("expected the first client to be cached")
+	}
+	second, secret := newTestVaultClient(t, server.URL, "second")
+	if cached := cache.Put("a", second, secret, logr.Discard()); cached != first {
+		t.Errorf("expected the client already cached to be kept")
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if revoked["first"] || !revoked["second"] {
+		t.Errorf("expected only the token of the unused client to be revoked, got %v", revoked)
+	}
+}
+
+func TestVaultClientCacheGetOrLoginSharesConcurrentLogins(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected call to vault: %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	cache := NewVaultClientCache(2)
+	var logins int32
+	release := make(chan struct{})
+	login := func() (*vault.Client, *vault.Secret, error) {
+		atomic.AddInt32(&logins, 1)
+		<-release
+		client, secret := newTestVaultClient(t, server.URL, "a")
+		return client, secret, nil
+	}
+	valid := func(*vault.Client) error { return nil }
+
+	var wg sync.WaitGroup
+	clients := make([]*vault.Client, 10)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := cache.GetOrLogin("a", valid, login, logr.Discard())
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			clients[i] = client
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if logins != 1 {
+		t.Errorf("expected a single login, got %d", logins)
+	}
+	for _, client := range clients {
+		if client == nil || client != clients[0] {
+			t.Fatalf("expected all the reconcile cycles to share the same client")
+		}
+	}
+}
+
+func TestVaultClientCacheGetOrLoginCountsValidatedHits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cache := NewVaultClientCache(2)
+	client, secret := newTestVaultClient(t, server.URL, "expired")
+	cache.Put("a", client, secret, logr.Discard())
+	login := func() (*vault.Client, *vault.Secret, error) {
+		client, secret := newTestVaultClient(t, server.URL, "renewed")
+		return client, secret, nil
+	}
+
+	hits := testutil.ToFloat64(vaultClientCacheHits)
+	misses := testutil.ToFloat64(vaultClientCacheMisses)
+	renewed, err := cache.GetOrLogin("a", func(*vault.Client) error { return errors.New("permission denied") }, login, logr.Discard())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if renewed == client {
+		t.Errorf("expected the rejected client to be replaced")
+	}
+	if testutil.ToFloat64(vaultClientCacheHits) != hits || testutil.ToFloat64(vaultClientCacheMisses) != misses+1 {
+		t.Errorf("expected a rejected cached client to be counted as a miss only")
+	}
+	cached, err := cache.GetOrLogin("a", func(*vault.Client) error { return nil }, login, logr.Discard())
+	if err != nil || cached != renewed {
+		t.Errorf("expected the renewed client to be returned from the cache")
+	}
+	if testutil.ToFloat64(vaultClientCacheHits) != hits+1 {
+		t.Errorf("expected a validated cached client to be counted as a hit")
+	}
+}
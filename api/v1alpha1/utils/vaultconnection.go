@@ -0,0 +1,164 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vault "github.com/hashicorp/vault/api"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	VaultConnectionKind           = "VaultConnection"
+	NamespacedVaultConnectionKind = "NamespacedVaultConnection"
+)
+
+var vaultConnectionGroupVersion = schema.GroupVersion{Group: "redhatcop.redhat.io", Version: "v1alpha1"}
+
+// +kubebuilder:object:generate=true
+type VaultConnectionReference struct {
+	// Kind is the kind of the referenced connection. VaultConnection is cluster-scoped, NamespacedVaultConnection is looked up in the namespace of the referencing resource.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum={"VaultConnection","NamespacedVaultConnection"}
+	// +kubebuilder:default=VaultConnection
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the referenced connection.
+	// +kubebuilder:validation:Required
+	Name string `json:"name,omitempty"`
+}
+
+func (ref *VaultConnectionReference) GetKind() string {
+	if ref.Kind == "" {
+		return VaultConnectionKind
+	}
+	return ref.Kind
+}
+
+// ValidateConnectionRef checks that a connection either references a VaultConnection or defines the connection inline, not both.
+func (vc *VaultConnection) ValidateConnectionRef() error {
+	if vc.ConnectionRef == nil {
+		return nil
+	}
+	if vc.ConnectionRef.Name == "" {
+		return errors.New("connectionRef.name must be specified")
+	}
+	if vc.Address != "" || vc.TLSConfig != nil {
+		return errors.New("address and tLSConfig cannot be specified together with connectionRef")
+	}
+	return nil
+}
+
+// resolveConnectionRef retrieves the referenced VaultConnection or NamespacedVaultConnection and returns its connection settings along with the namespace in which the connection tls secret must be looked up.
+func (vc *VaultConnection) resolveConnectionRef(context context.Context, kubeNamespace string) (*VaultConnection, string, error) {
+	log := log.FromContext(context)
+	err := vc.ValidateConnectionRef()
+	if err != nil {
+		return nil, "", err
+	}
+	kubeClient, err := GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to resolve connectionRef")
+		return nil, "", err
+	}
+	key := types.NamespacedName{Name: vc.ConnectionRef.Name}
+	kind := vc.ConnectionRef.GetKind()
+	switch kind {
+	case VaultConnectionKind:
+	case NamespacedVaultConnectionKind:
+		key.Namespace = kubeNamespace
+	default:
+		return nil, "", fmt.Errorf("unsupported connectionRef kind %s", kind)
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(vaultConnectionGroupVersion.WithKind(kind))
+	err = kubeClient.Get(context, key, obj)
+	if err != nil {
+		log.Error(err, "unable to retrieve", "kind", kind, "name", key.String())
+		return nil, "", err
+	}
+	spec, _, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return nil, "", err
+	}
+	resolved := &VaultConnection{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(spec, resolved)
+	if err != nil {
+		log.Error(err, "unable to decode", "kind", kind, "name", key.String())
+		return nil, "", err
+	}
+	if resolved.ConnectionRef != nil {
+		return nil, "", fmt.Errorf("%s %s cannot itself reference another connection", kind, key.String())
+	}
+	resolvedNamespace := kubeNamespace
+	if kind == VaultConnectionKind {
+		resolvedNamespace, _, err = unstructured.NestedString(obj.Object, "spec", "tlsSecretNamespace")
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if vc.TimeOut != nil {
+		resolved.TimeOut = vc.TimeOut
+	}
+	if vc.MaxRetries != nil {
+		resolved.MaxRetries = vc.MaxRetries
+	}
+	return resolved, resolvedNamespace, nil
+}
+
+// GetAddress returns the address of the Vault server this connection, as found in an object of kubeNamespace, connects to. A nil connection connects to the address of the standard Vault environment variables.
+func (vc *VaultConnection) GetAddress(context context.Context, kubeNamespace string) (string, error) {
+	if vc == nil {
+		return vault.DefaultConfig().Address, nil
+	}
+	if vc.ConnectionRef != nil {
+		resolved, resolvedNamespace, err := vc.resolveConnectionRef(context, kubeNamespace)
+		if err != nil {
+			return "", err
+		}
+		return resolved.GetAddress(context, resolvedNamespace)
+	}
+	if vc.Address == "" {
+		return vault.DefaultConfig().Address, nil
+	}
+	return vc.Address, nil
+}
+
+// GetHealth performs an unauthenticated call to sys/health using this connection configuration. The tls secret, if any, is looked up in kubeNamespace.
+func (vc *VaultConnection) GetHealth(context context.Context, kubeNamespace string) (*vault.HealthResponse, error) {
+	config, err := vc.getConnectionConfig(context, kubeNamespace)
+	if err != nil {
+		return nil, err
+	}
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	var health *vault.HealthResponse
+	err = observeVaultRequest(context, vaultClient, vaultRequestHealth, func(vaultClient *vault.Client) error {
+		health, err = vaultClient.Sys().HealthWithContext(context)
+		return err
+	})
+	return health, err
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newConnectionObject(kind string, namespace string, name string, spec map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	obj.SetGroupVersionKind(vaultConnectionGroupVersion.WithKind(kind))
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestResolveConnectionRef(t *testing.T) {
+	kubeClient := fake.NewClientBuilder().WithObjects(
+		newConnectionObject(VaultConnectionKind, "", "shared", map[string]interface{}{
+			"address":            "https://vault.example.com:8200",
+			"tlsSecretNamespace": "vault-config-operator",
+			"tLSConfig": map[string]interface{}{
+				"tlsSecret": map[string]interface{}{"name": "vault-tls"},
+			},
+			"timeOut": "30s",
+		}),
+		newConnectionObject(NamespacedVaultConnectionKind, "team-a", "local", map[string]interface{}{
+			"address": "https://vault.team-a.svc:8200",
+		}),
+	).Build()
+	ctx := WithKubeClient(context.TODO(), kubeClient)
+
+	tests := []struct {
+		name              string
+		connection        *VaultConnection
+		expectedAddress   string
+		expectedNamespace string
+		expectedTimeOut   time.Duration
+		expectError       bool
+	}{
+		{
+			name:              "cluster-scoped connection uses tlsSecretNamespace",
+			connection:        &VaultConnection{ConnectionRef: &VaultConnectionReference{Name: "shared"}},
+			expectedAddress:   "https://vault.example.com:8200",
+			expectedNamespace: "vault-config-operator",
+			expectedTimeOut:   30 * time.Second,
+		},
+		{
+			name:              "local timeout overrides the referenced one",
+			connection:        &VaultConnection{ConnectionRef: &VaultConnectionReference{Name: "shared"}, TimeOut: &metav1.Duration{Duration: time.Minute}},
+			expectedAddress:   "https://vault.example.com:8200",
+			expectedNamespace: "vault-config-operator",
+			expectedTimeOut:   time.Minute,
+		},
+		{
+			name:              "namespaced connection is looked up in the resource namespace",
+			connection:        &VaultConnection{ConnectionRef: &VaultConnectionReference{Kind: NamespacedVaultConnectionKind, Name: "local"}},
+			expectedAddress:   "https://vault.team-a.svc:8200",
+			expectedNamespace: "team-a",
+		},
+		{
+			name:        "address and connectionRef are mutually exclusive",
+			connection:  &VaultConnection{Address: "https://other:8200", ConnectionRef: &VaultConnectionReference{Name: "shared"}},
+			expectError: true,
+		},
+		{
+			name:        "missing connection",
+			connection:  &VaultConnection{ConnectionRef: &VaultConnectionReference{Name: "missing"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, namespace, err := tt.connection.resolveConnectionRef(ctx, "team-a")
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("resolveConnectionRef() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveConnectionRef() unexpected error: %v", err)
+			}
+			if resolved.Address != tt.expectedAddress {
+				t.Errorf("resolveConnectionRef() address = %v, expected %v", resolved.Address, tt.expectedAddress)
+			}
+			if namespace != tt.expectedNamespace {
+				t.Errorf("resolveConnectionRef() namespace = %v, expected %v", namespace, tt.expectedNamespace)
+			}
+			if tt.expectedTimeOut != 0 && (resolved.TimeOut == nil || resolved.TimeOut.Duration != tt.expectedTimeOut) {
+				t.Errorf("resolveConnectionRef() timeOut = %v, expected %v", resolved.TimeOut, tt.expectedTimeOut)
+			}
+		})
+	}
+}
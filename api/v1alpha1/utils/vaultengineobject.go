@@ -47,8 +47,16 @@ func NewVaultEngineEndpoint(obj client.Object) *VaultEngineEndpoint {
 
 func (ve *VaultEngineEndpoint) retrieveAccessor(context context.Context) (string, bool, error) {
 	log := log.FromContext(context)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
-	secret, err := vaultClient.Logical().Read(ve.vaultEngineObject.GetEngineListPath())
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return "", false, err
+	}
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestRead, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Logical().Read(ve.vaultEngineObject.GetEngineListPath())
+		return err
+	})
 	if err != nil {
 		log.Error(err, "unable to read engines at", "path", ve.vaultEngineObject.GetEngineListPath())
 		return "", false, err
@@ -90,6 +98,7 @@ func (ve *VaultEngineEndpoint) CreateOrUpdateTuneConfig(context context.Context)
 	}
 
 	if !ve.vaultObject.IsEquivalentToDesiredState(currentTunePayload) {
+		ve.recordDrift(ve.vaultEngineObject.GetEngineTunePath(), ve.vaultEngineObject.GetTunePayload(), currentTunePayload)
 		return write(context, ve.vaultEngineObject.GetEngineTunePath(), ve.vaultEngineObject.GetTunePayload())
 	}
 
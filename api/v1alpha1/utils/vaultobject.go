@@ -40,6 +40,7 @@ type VaultObject interface {
 }
 
 type VaultEndpoint struct {
+	driftRecorder
 	vaultObject VaultObject
 }
 
@@ -53,12 +54,22 @@ func NewVaultEndpoint(obj client.Object) *VaultEndpoint {
 // This is similar to vaultClient.KVv2(mountPath string).DeleteMetadata(ctx context.Context, secretPath string) but works better with existing interface
 func (ve *VaultEndpoint) DeleteKVv2IfExists(context context.Context) error {
 	log := log.FromContext(context)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return err
+	}
 
 	// should match pathToDelete := fmt.Sprintf("%s/metadata/%s", kv.mountPath, secretPath)
 	pathToDelete := strings.Replace(ve.vaultObject.GetPath(), "/data/", "/metadata/", 1)
+	if PlanOperation(context, VaultDelete, pathToDelete) {
+		return nil
+	}
 
-	_, err := vaultClient.Logical().Delete(pathToDelete)
+	err = observeVaultRequest(context, vaultClient, vaultRequestDelete, func(vaultClient *vault.Client) error {
+		_, err := vaultClient.Logical().Delete(pathToDelete)
+		return err
+	})
 	if err != nil {
 		if respErr, ok := err.(*vault.ResponseError); ok {
 			if respErr.StatusCode == 404 {
@@ -73,8 +84,18 @@ func (ve *VaultEndpoint) DeleteKVv2IfExists(context context.Context) error {
 
 func (ve *VaultEndpoint) DeleteIfExists(context context.Context) error {
 	log := log.FromContext(context)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
-	_, err := vaultClient.Logical().Delete(ve.vaultObject.GetPath())
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return err
+	}
+	if PlanOperation(context, VaultDelete, ve.vaultObject.GetPath()) {
+		return nil
+	}
+	err = observeVaultRequest(context, vaultClient, vaultRequestDelete, func(vaultClient *vault.Client) error {
+		_, err := vaultClient.Logical().Delete(ve.vaultObject.GetPath())
+		return err
+	})
 	if err != nil {
 		if respErr, ok := err.(*vault.ResponseError); ok {
 			if respErr.StatusCode == 404 {
@@ -108,16 +129,70 @@ func (ve *VaultEndpoint) CreateOrUpdate(context context.Context) error {
 		log.Error(err, "unable to read object at", "path", ve.vaultObject.GetPath())
 		return err
 	}
+	err = CheckManagementPolicy(ve.vaultObject, ve.vaultObject.GetPath(), found, currentPayload)
+	if err != nil {
+		log.Error(err, "unable to manage object at", "path", ve.vaultObject.GetPath())
+		return err
+	}
 	if !found {
-		return write(context, ve.vaultObject.GetPath(), ve.vaultObject.GetPayload())
+		err = write(context, ve.vaultObject.GetPath(), ve.vaultObject.GetPayload())
 	} else {
 		if !ve.vaultObject.IsEquivalentToDesiredState(currentPayload) {
-			return write(context, ve.vaultObject.GetPath(), ve.vaultObject.GetPayload())
+			ve.recordDrift(ve.vaultObject.GetPath(), ve.vaultObject.GetPayload(), currentPayload)
+			err = write(context, ve.vaultObject.GetPath(), ve.vaultObject.GetPayload())
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if !IsObserveOnlyContext(context) {
+		RecordVaultOwnership(ve.vaultObject, found)
+		if aware, ok := ve.vaultObject.(WriteOnlyStateAware); ok {
+			aware.RecordWriteOnlyState()
 		}
 	}
 	return nil
 }
 
+// WriteOnlyStateAware objects send values that Vault does not return when they are read, such as passwords. They record in their status a digest of the values that Vault holds, so that IsEquivalentToDesiredState can detect when those values change
+type WriteOnlyStateAware interface {
+	RecordWriteOnlyState()
+}
+
+// VaultLeaseConfigObject objects also configure the lease of the credentials generated by their secret engine, at a path of its own
+type VaultLeaseConfigObject interface {
+	GetLeasePath() string
+	// GetLeasePayload returns the lease configuration, or nil when the object leaves the lease configuration to Vault
+	GetLeasePayload() map[string]interface{}
+	IsLeaseEquivalentToDesiredState(payload map[string]interface{}) bool
+}
+
+// CreateOrUpdateLease writes the lease configuration of the objects that have one, when it differs from the one read from Vault
+func (ve *VaultEndpoint) CreateOrUpdateLease(context context.Context) error {
+	log := log.FromContext(context)
+	leaseObject, ok := ve.vaultObject.(VaultLeaseConfigObject)
+	if !ok {
+		return nil
+	}
+	payload := leaseObject.GetLeasePayload()
+	if payload == nil {
+		return nil
+	}
+	path := leaseObject.GetLeasePath()
+	currentPayload, found, err := read(context, path)
+	if err != nil {
+		log.Error(err, "unable to read object at", "path", path)
+		return err
+	}
+	if found {
+		if leaseObject.IsLeaseEquivalentToDesiredState(currentPayload) {
+			return nil
+		}
+		ve.recordDrift(path, payload, currentPayload)
+	}
+	return write(context, path, payload)
+}
+
 type RabbitMQEngineConfigVaultObject interface {
 	VaultObject
 	GetLeasePath() string
@@ -0,0 +1,106 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type leaseObject struct {
+	leasePayload map[string]interface{}
+}
+
+func (l *leaseObject) GetPath() string                                                { return "aws/config/root" }
+func (l *leaseObject) GetPayload() map[string]interface{}                             { return nil }
+func (l *leaseObject) IsEquivalentToDesiredState(payload map[string]interface{}) bool { return true }
+func (l *leaseObject) IsInitialized() bool                                            { return true }
+func (l *leaseObject) IsValid() (bool, error)                                         { return true, nil }
+func (l *leaseObject) IsDeletable() bool                                              { return false }
+func (l *leaseObject) PrepareInternalValues(context context.Context, object client.Object) error {
+	return nil
+}
+func (l *leaseObject) PrepareTLSConfig(context context.Context, object client.Object) error {
+	return nil
+}
+func (l *leaseObject) GetKubeAuthConfiguration() *KubeAuthConfiguration { return nil }
+func (l *leaseObject) GetVaultConnection() *VaultConnection             { return nil }
+func (l *leaseObject) GetLeasePath() string                             { return "aws/config/lease" }
+func (l *leaseObject) GetLeasePayload() map[string]interface{}          { return l.leasePayload }
+func (l *leaseObject) IsLeaseEquivalentToDesiredState(payload map[string]interface{}) bool {
+	return fmt.Sprint(payload["lease"]) == fmt.Sprint(l.leasePayload["lease"])
+}
+
+func TestCreateOrUpdateLease(t *testing.T) {
+	tests := []struct {
+		name          string
+		leasePayload  map[string]interface{}
+		currentLease  string
+		expectWrite   bool
+		expectDrifted bool
+	}{
+		{name: "skip without lease configuration", leasePayload: nil},
+		{name: "write missing lease", leasePayload: map[string]interface{}{"lease": "1h0m0s"}, expectWrite: true},
+		{name: "keep equivalent lease", leasePayload: map[string]interface{}{"lease": "1h0m0s"}, currentLease: "1h0m0s"},
+		{name: "correct drifted lease", leasePayload: map[string]interface{}{"lease": "1h0m0s"}, currentLease: "2h0m0s", expectWrite: true, expectDrifted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			written := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/aws/config/lease" {
+					t.Errorf("unexpected request to %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				switch r.Method {
+				case http.MethodGet:
+					if tt.currentLease == "" {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.Header().Set("Content-Type", "application/json")
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"lease": tt.currentLease}})
+				case http.MethodPut, http.MethodPost:
+					written = true
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			defer server.Close()
+			vaultClient, _ := newTestVaultClient(t, server.URL, "test")
+			ctx := WithVaultClient(context.Background(), vaultClient)
+
+			endpoint := &VaultEndpoint{vaultObject: &leaseObject{leasePayload: tt.leasePayload}}
+			if err := endpoint.CreateOrUpdateLease(ctx); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if written != tt.expectWrite {
+				t.Errorf("expected write %t, got %t", tt.expectWrite, written)
+			}
+			if drifted := endpoint.GetDriftReport() != nil; drifted != tt.expectDrifted {
+				t.Errorf("expected drift %t, got %t", tt.expectDrifted, drifted)
+			}
+		})
+	}
+}
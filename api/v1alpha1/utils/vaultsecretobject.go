@@ -11,6 +11,8 @@ type VaultSecretObject interface {
 	GetPath() string
 	GetRequestMethod() string
 	GetPostRequestPayload() map[string]string
+	// GetVersion returns the version of the KV v2 secret to read, 0 for the latest version or for other secrets
+	GetVersion() int
 	GetVaultConnection() *VaultConnection
 }
 
@@ -26,6 +28,9 @@ type VaultSecretEndpoint struct {
 
 func (ve *VaultSecretEndpoint) GetSecret(context context.Context) (*vault.Secret, bool, error) {
 	if ve.vaultSecretObject.GetRequestMethod() == "GET" {
+		if ve.vaultSecretObject.GetVersion() > 0 {
+			return ReadSecretVersion(context, ve.vaultSecretObject.GetPath(), ve.vaultSecretObject.GetVersion())
+		}
 		return ReadSecret(context, ve.vaultSecretObject.GetPath())
 	}
 	if ve.vaultSecretObject.GetRequestMethod() == "POST" {
@@ -0,0 +1,124 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// VaultSSHCAObject is the CA of an SSH secret engine, which Vault refuses to overwrite once configured
+type VaultSSHCAObject interface {
+	VaultObject
+	GetDesiredPublicKey() string
+	SetPublicKey(publicKey string)
+	PublishPublicKey(context context.Context) error
+}
+
+type VaultSSHCAEndpoint struct {
+	*VaultEndpoint
+	vaultSSHCAObject VaultSSHCAObject
+}
+
+func NewVaultSSHCAEndpoint(obj client.Object) *VaultSSHCAEndpoint {
+	return &VaultSSHCAEndpoint{
+		vaultSSHCAObject: obj.(VaultSSHCAObject),
+		VaultEndpoint:    NewVaultEndpoint(obj),
+	}
+}
+
+// CreateOrUpdate configures the CA when none is configured, and replaces the CA when it is not the imported one
+func (ve *VaultSSHCAEndpoint) CreateOrUpdate(context context.Context) error {
+	log := log.FromContext(context)
+	path := ve.vaultSSHCAObject.GetPath()
+	currentPayload, found, err := readSSHCA(context, path)
+	if err != nil {
+		log.Error(err, "unable to read object at", "path", path)
+		return err
+	}
+	err = CheckManagementPolicy(ve.vaultSSHCAObject, path, found, currentPayload)
+	if err != nil {
+		log.Error(err, "unable to manage object at", "path", path)
+		return err
+	}
+	configure := !found
+	if found && !ve.vaultSSHCAObject.IsEquivalentToDesiredState(currentPayload) {
+		// only the public keys are reported, the private key must not end up in the status
+		ve.recordDrift(path, map[string]interface{}{"public_key": ve.vaultSSHCAObject.GetDesiredPublicKey()}, map[string]interface{}{"public_key": currentPayload["public_key"]})
+		// Vault refuses to configure a CA over an existing one
+		err = ve.DeleteIfExists(context)
+		if err != nil {
+			return err
+		}
+		configure = true
+	}
+	if configure {
+		err = write(context, path, ve.vaultSSHCAObject.GetPayload())
+		if err != nil {
+			return err
+		}
+	}
+	if !IsObserveOnlyContext(context) {
+		RecordVaultOwnership(ve.vaultSSHCAObject, found)
+	}
+	return nil
+}
+
+// RecordPublicKey records the public key of the CA, as read from Vault
+func (ve *VaultSSHCAEndpoint) RecordPublicKey(context context.Context) error {
+	payload, found, err := readSSHCA(context, ve.vaultSSHCAObject.GetPath())
+	if err != nil || !found {
+		// in observe-only mode a CA to configure is not found
+		return err
+	}
+	publicKey, _ := payload["public_key"].(string)
+	ve.vaultSSHCAObject.SetPublicKey(strings.TrimSpace(publicKey))
+	return nil
+}
+
+func (ve *VaultSSHCAEndpoint) PublishPublicKey(context context.Context) error {
+	return ve.vaultSSHCAObject.PublishPublicKey(context)
+}
+
+// readSSHCA reads the CA config, Vault answers with a bad request rather than not found when no CA is configured
+func readSSHCA(context context.Context, path string) (map[string]interface{}, bool, error) {
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		return nil, false, err
+	}
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestRead, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Logical().Read(path)
+		return err
+	})
+	if err != nil {
+		if respErr, ok := err.(*vault.ResponseError); ok {
+			if respErr.StatusCode == 404 || (respErr.StatusCode == 400 && strings.Contains(strings.Join(respErr.Errors, " "), "keys haven't been configured yet")) {
+				return nil, false, nil
+			}
+		}
+		return nil, false, err
+	}
+	if secret == nil {
+		return nil, false, nil
+	}
+	return secret.Data, true, nil
+}
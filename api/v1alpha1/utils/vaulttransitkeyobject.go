@@ -0,0 +1,132 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// VaultTransitKeyObject is a transit key, created with the payload of the VaultObject at its path, whose mutable parameters are written to its config endpoint
+type VaultTransitKeyObject interface {
+	VaultObject
+	GetConfigPath() string
+	GetConfigPayload() map[string]interface{}
+	GetRotatePath() string
+	GetRotationRequest() string
+	SetRotated(request string)
+	SetLatestVersion(version int)
+}
+
+type VaultTransitKeyEndpoint struct {
+	*VaultEndpoint
+	vaultTransitKeyObject VaultTransitKeyObject
+}
+
+func NewVaultTransitKeyEndpoint(obj client.Object) *VaultTransitKeyEndpoint {
+	return &VaultTransitKeyEndpoint{
+		vaultTransitKeyObject: obj.(VaultTransitKeyObject),
+		VaultEndpoint:         NewVaultEndpoint(obj),
+	}
+}
+
+// CreateOrUpdate creates the key when it does not exist and writes its config when the key differs from the desired config
+func (ve *VaultTransitKeyEndpoint) CreateOrUpdate(context context.Context) error {
+	log := log.FromContext(context)
+	path := ve.vaultTransitKeyObject.GetPath()
+	configPath := ve.vaultTransitKeyObject.GetConfigPath()
+	configPayload := ve.vaultTransitKeyObject.GetConfigPayload()
+	currentPayload, found, err := read(context, path)
+	if err != nil {
+		log.Error(err, "unable to read object at", "path", path)
+		return err
+	}
+	err = CheckManagementPolicy(ve.vaultTransitKeyObject, path, found, currentPayload)
+	if err != nil {
+		log.Error(err, "unable to manage object at", "path", path)
+		return err
+	}
+	if !found {
+		err = write(context, path, ve.vaultTransitKeyObject.GetPayload())
+		if err == nil {
+			// the creation only accepts part of the config, such as deletion_allowed or the minimum versions
+			err = write(context, configPath, configPayload)
+		}
+	} else if !ve.vaultTransitKeyObject.IsEquivalentToDesiredState(currentPayload) {
+		currentConfig := map[string]interface{}{}
+		for key := range configPayload {
+			currentConfig[key] = currentPayload[key]
+		}
+		ve.recordDrift(configPath, configPayload, currentConfig)
+		err = write(context, configPath, configPayload)
+	}
+	if err != nil {
+		return err
+	}
+	if !IsObserveOnlyContext(context) {
+		RecordVaultOwnership(ve.vaultTransitKeyObject, found)
+	}
+	return nil
+}
+
+// Rotate rotates the key when a rotation is requested, and records the rotation.
+// The rotation is saved in the status as soon as the key is rotated, so that a failure of the following calls does not rotate the key again on the next reconcile cycle.
+func (ve *VaultTransitKeyEndpoint) Rotate(context context.Context) error {
+	log := log.FromContext(context)
+	request := ve.vaultTransitKeyObject.GetRotationRequest()
+	if request == "" {
+		return nil
+	}
+	err := write(context, ve.vaultTransitKeyObject.GetRotatePath(), nil)
+	if err != nil {
+		return err
+	}
+	if IsObserveOnlyContext(context) {
+		return nil
+	}
+	ve.vaultTransitKeyObject.SetRotated(request)
+	kubeClient, err := GetKubeClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve kubernetes client")
+		return err
+	}
+	err = kubeClient.Status().Update(context, ve.vaultTransitKeyObject.(client.Object), &client.SubResourceUpdateOptions{})
+	if err != nil {
+		log.Error(err, "unable to record the rotation of the transit key, the key may be rotated again", "path", ve.vaultTransitKeyObject.GetPath())
+		return err
+	}
+	return nil
+}
+
+// RecordLatestVersion records the latest version of the key, as read from Vault
+func (ve *VaultTransitKeyEndpoint) RecordLatestVersion(context context.Context) error {
+	payload, found, err := read(context, ve.vaultTransitKeyObject.GetPath())
+	if err != nil || !found {
+		// in observe-only mode a key to create is not found
+		return err
+	}
+	version, err := strconv.Atoi(fmt.Sprint(payload["latest_version"]))
+	if err != nil {
+		return fmt.Errorf("unable to parse the latest version of the transit key at %s: %w", ve.vaultTransitKeyObject.GetPath(), err)
+	}
+	ve.vaultTransitKeyObject.SetLatestVersion(version)
+	return nil
+}
@@ -18,6 +18,7 @@ package utils
 
 import (
 	"context"
+	"strconv"
 
 	vault "github.com/hashicorp/vault/api"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -31,10 +32,27 @@ func write(context context.Context, path string, payload map[string]interface{})
 	return nil
 }
 
+// writeWithResponse returns a nil secret when the write is skipped because the context is observe-only
 func writeWithResponse(context context.Context, path string, payload map[string]interface{}) (*vault.Secret, error) {
+	if PlanOperation(context, VaultWrite, path) {
+		return nil, nil
+	}
+	return WriteSecret(context, path, payload)
+}
+
+// WriteSecret writes payload at path and returns the response. The write is not planned in observe-only mode, callers changing Vault must check PlanOperation first.
+func WriteSecret(context context.Context, path string, payload map[string]interface{}) (*vault.Secret, error) {
 	log := log.FromContext(context)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
-	secret, err := vaultClient.Logical().Write(path, payload)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return nil, err
+	}
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestWrite, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Logical().Write(path, payload)
+		return err
+	})
 	if err != nil {
 		log.Error(err, "unable to write object at", "path", path)
 		return nil, err
@@ -44,8 +62,16 @@ func writeWithResponse(context context.Context, path string, payload map[string]
 
 func read(context context.Context, path string) (map[string]interface{}, bool, error) {
 	log := log.FromContext(context)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
-	secret, err := vaultClient.Logical().Read(path)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return nil, false, err
+	}
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestRead, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Logical().Read(path)
+		return err
+	})
 	if err != nil {
 		if respErr, ok := err.(*vault.ResponseError); ok {
 			if respErr.StatusCode == 404 || respErr.StatusCode == 204 {
@@ -62,9 +88,26 @@ func read(context context.Context, path string) (map[string]interface{}, bool, e
 }
 
 func ReadSecret(context context.Context, path string) (*vault.Secret, bool, error) {
+	return readSecret(context, path, nil)
+}
+
+// ReadSecretVersion reads the given version of the KV v2 secret at path
+func ReadSecretVersion(context context.Context, path string, version int) (*vault.Secret, bool, error) {
+	return readSecret(context, path, map[string][]string{"version": {strconv.Itoa(version)}})
+}
+
+func readSecret(context context.Context, path string, query map[string][]string) (*vault.Secret, bool, error) {
 	log := log.FromContext(context)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
-	secret, err := vaultClient.Logical().Read(path)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return nil, false, err
+	}
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestReadSecret, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Logical().ReadWithData(path, query)
+		return err
+	})
 	if err != nil {
 		if respErr, ok := err.(*vault.ResponseError); ok {
 			if respErr.StatusCode == 404 {
@@ -83,12 +126,20 @@ func ReadSecret(context context.Context, path string) (*vault.Secret, bool, erro
 
 func ReadSecretWithPayload(context context.Context, path string, payload map[string]string) (*vault.Secret, bool, error) {
 	log := log.FromContext(context)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return nil, false, err
+	}
 	payloadi := map[string]interface{}{}
 	for key, value := range payload {
 		payloadi[key] = value
 	}
-	secret, err := vaultClient.Logical().Write(path, payloadi)
+	var secret *vault.Secret
+	err = observeVaultRequest(context, vaultClient, vaultRequestReadSecret, func(vaultClient *vault.Client) error {
+		secret, err = vaultClient.Logical().Write(path, payloadi)
+		return err
+	})
 	if err != nil {
 		if respErr, ok := err.(*vault.ResponseError); ok {
 			if respErr.StatusCode == 404 {
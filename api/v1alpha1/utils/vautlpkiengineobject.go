@@ -62,8 +62,18 @@ func (ve *VaultPKIEngineEndpoint) Generate(context context.Context) (*vault.Secr
 
 func (ve *VaultPKIEngineEndpoint) DeleteIfExists(context context.Context) error {
 	log := log.FromContext(context)
-	vaultClient := context.Value("vaultClient").(*vault.Client)
-	_, err := vaultClient.Logical().Delete(ve.vaultPKIEngineObject.GetDeletePath())
+	vaultClient, err := GetVaultClientFromContext(context)
+	if err != nil {
+		log.Error(err, "unable to retrieve vault client")
+		return err
+	}
+	if PlanOperation(context, VaultDelete, ve.vaultPKIEngineObject.GetDeletePath()) {
+		return nil
+	}
+	err = observeVaultRequest(context, vaultClient, vaultRequestDelete, func(vaultClient *vault.Client) error {
+		_, err := vaultClient.Logical().Delete(ve.vaultPKIEngineObject.GetDeletePath())
+		return err
+	})
 	if err != nil {
 		if respErr, ok := err.(*vault.ResponseError); ok {
 			if respErr.StatusCode == 404 {
@@ -111,6 +121,7 @@ func (ve *VaultPKIEngineEndpoint) CreateOrUpdateConfig(context context.Context,
 	}
 
 	if !ve.vaultObject.IsEquivalentToDesiredState(currentConfigPayload) {
+		ve.recordDrift(configPath, payload, currentConfigPayload)
 		return write(context, ve.vaultPKIEngineObject.GetConfigCrlPath(), payload)
 	}
 
@@ -0,0 +1,178 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"time"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VaultConnectionReachable is the condition type reporting whether the Vault server answered the last health check
+	VaultConnectionReachable = "Reachable"
+	// VaultConnectionSealed is the condition type reporting whether the Vault server was sealed at the last health check
+	VaultConnectionSealed = "Sealed"
+)
+
+const defaultHealthCheckInterval = 5 * time.Minute
+
+// VaultConnectionSettings holds the connection parameters shared by VaultConnection and NamespacedVaultConnection
+type VaultConnectionSettings struct {
+	// +kubebuilder:validation:Optional
+	TLSConfig *vaultutils.TLSConfig `json:"tLSConfig,omitempty"`
+
+	// Address Address of the Vault server expressed as a URL and port, for example: https://127.0.0.1:8200/
+	// +kubebuilder:validation:Required
+	Address string `json:"address,omitempty"`
+
+	// Timeout Timeout variable. The default value is 60s.
+	// +kubebuilder:validation:Optional
+	TimeOut *metav1.Duration `json:"timeOut,omitempty"`
+
+	// MaxRetries Maximum number of retries when certain error codes are encountered. The default is 2, for three total attempts. Set this to 0 or less to disable retrying. Error codes that are retried are 412 (client consistency requirement not satisfied) and all 5xx except for 501 (not implemented).
+	// +kubebuilder:validation:Optional
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// HealthCheckInterval how often the operator checks the reachability, seal state and version of the Vault server. The default is 5m.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5m"
+	HealthCheckInterval *metav1.Duration `json:"healthCheckInterval,omitempty"`
+}
+
+func (s *VaultConnectionSettings) toVaultConnection() *vaultutils.VaultConnection {
+	return &vaultutils.VaultConnection{
+		TLSConfig:  s.TLSConfig,
+		Address:    s.Address,
+		TimeOut:    s.TimeOut,
+		MaxRetries: s.MaxRetries,
+	}
+}
+
+func (s *VaultConnectionSettings) getHealthCheckInterval() time.Duration {
+	if s.HealthCheckInterval == nil || s.HealthCheckInterval.Duration <= 0 {
+		return defaultHealthCheckInterval
+	}
+	return s.HealthCheckInterval.Duration
+}
+
+func (s *VaultConnectionSettings) isValid() error {
+	if s.Address == "" {
+		return errors.New("spec.address must be specified")
+	}
+	return nil
+}
+
+// VaultConnectionSpec defines the desired state of VaultConnection
+type VaultConnectionSpec struct {
+	VaultConnectionSettings `json:",inline"`
+
+	// TLSSecretNamespace is the namespace of the secret referenced by tLSConfig.tlsSecret. Required when tLSConfig.tlsSecret is specified, as VaultConnection is cluster-scoped.
+	// +kubebuilder:validation:Optional
+	TLSSecretNamespace string `json:"tlsSecretNamespace,omitempty"`
+}
+
+// VaultConnectionStatus defines the observed state of VaultConnection and NamespacedVaultConnection
+type VaultConnectionStatus struct {
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// ServerVersion is the version reported by the Vault server at the last health check
+	// +kubebuilder:validation:Optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// ClusterName is the cluster name reported by the Vault server at the last health check
+	// +kubebuilder:validation:Optional
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// LastHealthCheck is the time of the last health check
+	// +kubebuilder:validation:Optional
+	LastHealthCheck *metav1.Time `json:"lastHealthCheck,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Address",type=string,JSONPath=`.spec.address`
+//+kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.serverVersion`
+//+kubebuilder:printcolumn:name="Reachable",type=string,JSONPath=`.status.conditions[?(@.type=="Reachable")].status`
+//+kubebuilder:printcolumn:name="Sealed",type=string,JSONPath=`.status.conditions[?(@.type=="Sealed")].status`
+
+// VaultConnection is the Schema for the vaultconnections API. It is cluster-scoped and can be referenced by name from the connection.connectionRef field of any resource.
+type VaultConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VaultConnectionSpec   `json:"spec,omitempty"`
+	Status VaultConnectionStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// VaultConnectionList contains a list of VaultConnection
+type VaultConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultConnection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VaultConnection{}, &VaultConnectionList{})
+}
+
+var _ vaultutils.ConditionsAware = &VaultConnection{}
+
+func (m *VaultConnection) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *VaultConnection) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *VaultConnection) GetVaultConnection() *vaultutils.VaultConnection {
+	return m.Spec.toVaultConnection()
+}
+
+// GetTLSSecretNamespace returns the namespace in which the tls secret of this connection is looked up
+func (m *VaultConnection) GetTLSSecretNamespace() string {
+	return m.Spec.TLSSecretNamespace
+}
+
+func (m *VaultConnection) GetHealthCheckInterval() time.Duration {
+	return m.Spec.getHealthCheckInterval()
+}
+
+func (m *VaultConnection) GetVaultConnectionStatus() *VaultConnectionStatus {
+	return &m.Status
+}
+
+func (m *VaultConnection) isValid() error {
+	err := m.Spec.isValid()
+	if err != nil {
+		return err
+	}
+	if m.Spec.TLSConfig != nil && m.Spec.TLSConfig.TLSSecret != nil && m.Spec.TLSSecretNamespace == "" {
+		return errors.New("spec.tlsSecretNamespace must be specified when spec.tLSConfig.tlsSecret is specified")
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTemplatizedK8sSecretIsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		output TemplatizedK8sSecret
+		valid  bool
+	}{
+		{
+			name:   "secret",
+			output: TemplatizedK8sSecret{Name: "app", Type: "Opaque", StringData: map[string]string{"key": "value"}},
+			valid:  true,
+		},
+		{
+			name:   "config map",
+			output: TemplatizedK8sSecret{Name: "app", APIVersion: "v1", Kind: "ConfigMap", StringData: map[string]string{"key": "value"}},
+			valid:  true,
+		},
+		{
+			name:   "config map with a template",
+			output: TemplatizedK8sSecret{Name: "app", APIVersion: "v1", Kind: "ConfigMap", Template: "data: {}"},
+			valid:  false,
+		},
+		{
+			name:   "resource",
+			output: TemplatizedK8sSecret{Name: "app", APIVersion: "example.com/v1", Kind: "Example", Template: "spec: {}"},
+			valid:  true,
+		},
+		{
+			name:   "resource not allowed",
+			output: TemplatizedK8sSecret{Name: "app", APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding", Template: "roleRef: {}"},
+			valid:  false,
+		},
+		{
+			name:   "core resource",
+			output: TemplatizedK8sSecret{Name: "app", APIVersion: "v1", Kind: "Service", Template: "spec: {}"},
+			valid:  true,
+		},
+		{
+			name:   "resource without a template",
+			output: TemplatizedK8sSecret{Name: "app", APIVersion: "example.com/v1", Kind: "Example", StringData: map[string]string{"key": "value"}},
+			valid:  false,
+		},
+		{
+			name:   "invalid api version",
+			output: TemplatizedK8sSecret{Name: "app", APIVersion: "example.com/v1/extra", Kind: "Example", Template: "spec: {}"},
+			valid:  false,
+		},
+		{
+			name:   "secret with encoders",
+			output: TemplatizedK8sSecret{Name: "app", Encoders: []OutputEncoder{{Key: "keystore.p12", PKCS12: &KeyStoreEncoder{Certificate: "{{ .cert }}", PrivateKey: "{{ .key }}", Password: "changeit"}}, {Key: ".env", Dotenv: &DotenvEncoder{Data: "{{ .db | toYaml }}"}}}},
+			valid:  true,
+		},
+		{
+			name:   "truststore",
+			output: TemplatizedK8sSecret{Name: "app", Encoders: []OutputEncoder{{Key: "truststore.jks", JKS: &KeyStoreEncoder{CA: "{{ .ca }}", Password: "changeit"}}}},
+			valid:  true,
+		},
+		{
+			name:   "config map with encoders",
+			output: TemplatizedK8sSecret{Name: "app", APIVersion: "v1", Kind: "ConfigMap", Encoders: []OutputEncoder{{Key: ".env", Dotenv: &DotenvEncoder{Data: "{{ .db | toYaml }}"}}}},
+			valid:  false,
+		},
+		{
+			name:   "encoder key used by stringData",
+			output: TemplatizedK8sSecret{Name: "app", StringData: map[string]string{".env": "value"}, Encoders: []OutputEncoder{{Key: ".env", Dotenv: &DotenvEncoder{Data: "{{ .db | toYaml }}"}}}},
+			valid:  false,
+		},
+		{
+			name:   "encoder with two formats",
+			output: TemplatizedK8sSecret{Name: "app", Encoders: []OutputEncoder{{Key: ".env", Dotenv: &DotenvEncoder{Data: "{{ .db | toYaml }}"}, DockerConfigJSON: &DockerConfigJSONEncoder{Registries: []DockerRegistryCredentials{{Server: "quay.io"}}}}}},
+			valid:  false,
+		},
+		{
+			name:   "keystore without private key",
+			output: TemplatizedK8sSecret{Name: "app", Encoders: []OutputEncoder{{Key: "keystore.jks", JKS: &KeyStoreEncoder{Certificate: "{{ .cert }}", Password: "changeit"}}}},
+			valid:  false,
+		},
+	}
+	t.Setenv(OutputAllowedKindsEnv, "Example.example.com,Service")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultSecret := &VaultSecret{Spec: VaultSecretSpec{TemplatizedK8sSecret: tt.output}}
+			valid, err := vaultSecret.IsValid()
+			if valid != tt.valid {
+				t.Errorf("expected valid to be %v, got %v (%v)", tt.valid, valid, err)
+			}
+		})
+	}
+}
+
+func TestRolloutTargetsIsValid(t *testing.T) {
+	output := TemplatizedK8sSecret{Name: "app", Type: "Opaque", StringData: map[string]string{"key": "value"}}
+	tests := []struct {
+		name            string
+		vaultSecretName string
+		targets         []RolloutTarget
+		valid           bool
+	}{
+		{
+			name:            "by name",
+			vaultSecretName: "app",
+			targets:         []RolloutTarget{{Kind: "Deployment", Name: "app"}},
+			valid:           true,
+		},
+		{
+			name:            "by selector",
+			vaultSecretName: "app",
+			targets:         []RolloutTarget{{Kind: "StatefulSet", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}}},
+			valid:           true,
+		},
+		{
+			name:            "by name and selector",
+			vaultSecretName: "app",
+			targets:         []RolloutTarget{{Kind: "DaemonSet", Name: "agent", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "agent"}}}},
+			valid:           false,
+		},
+		{
+			name:            "without name nor selector",
+			vaultSecretName: "app",
+			targets:         []RolloutTarget{{Kind: "Deployment"}},
+			valid:           false,
+		},
+		{
+			name:            "invalid selector",
+			vaultSecretName: "app",
+			targets:         []RolloutTarget{{Kind: "Deployment", Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Unknown"}}}}},
+			valid:           false,
+		},
+		{
+			name:            "name too long for the annotation",
+			vaultSecretName: strings.Repeat("a", 64),
+			targets:         []RolloutTarget{{Kind: "Deployment", Name: "app"}},
+			valid:           false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultSecret := &VaultSecret{
+				ObjectMeta: metav1.ObjectMeta{Name: tt.vaultSecretName},
+				Spec:       VaultSecretSpec{TemplatizedK8sSecret: output, RolloutTargets: tt.targets},
+			}
+			valid, err := vaultSecret.IsValid()
+			if valid != tt.valid {
+				t.Errorf("expected valid to be %v, got %v (%v)", tt.valid, valid, err)
+			}
+		})
+	}
+}
+
+func TestVaultSecretDefinitionVersionIsValid(t *testing.T) {
+	output := TemplatizedK8sSecret{Name: "app", Type: "Opaque", StringData: map[string]string{"key": "value"}}
+	tests := []struct {
+		name       string
+		definition VaultSecretDefinition
+		valid      bool
+	}{
+		{
+			name:       "version with GET",
+			definition: VaultSecretDefinition{Name: "db", Path: "kv/data/db", Version: 3},
+			valid:      true,
+		},
+		{
+			name:       "version with POST",
+			definition: VaultSecretDefinition{Name: "db", Path: "kv/data/db", RequestType: "POST", Version: 3},
+			valid:      false,
+		},
+		{
+			name:       "POST without version",
+			definition: VaultSecretDefinition{Name: "creds", Path: "pki/issue/role", RequestType: "POST"},
+			valid:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultSecret := &VaultSecret{
+				ObjectMeta: metav1.ObjectMeta{Name: "app"},
+				Spec:       VaultSecretSpec{TemplatizedK8sSecret: output, VaultSecretDefinitions: []VaultSecretDefinition{tt.definition}},
+			}
+			valid, err := vaultSecret.IsValid()
+			if valid != tt.valid {
+				t.Errorf("expected valid to be %v, got %v (%v)", tt.valid, valid, err)
+			}
+		})
+	}
+}
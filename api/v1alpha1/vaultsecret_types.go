@@ -17,9 +17,17 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/hashicorp/go-multierror"
 	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -38,12 +46,22 @@ type VaultSecretSpec struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:default=90
 	RefreshThreshold int `json:"refreshThreshold,omitempty"`
+	// LeaseRevocationGracePeriod is the time the leases of the secrets are kept after new credentials were written to the output, before they are revoked, so that the workloads still using the previous credentials have time to pick up the new ones.
+	// The default is 5m, 0s revokes the previous leases as soon as the output is written.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5m"
+	LeaseRevocationGracePeriod *metav1.Duration `json:"leaseRevocationGracePeriod,omitempty"`
 	// VaultSecretDefinitions are the secrets in Vault.
 	// +kubebuilder:validation:Required
 	VaultSecretDefinitions []VaultSecretDefinition `json:"vaultSecretDefinitions,omitempty"`
 	// TemplatizedK8sSecret is the formatted K8s Secret created by templating from the Vault KV secrets.
 	// +kubebuilder:validation:Required
 	TemplatizedK8sSecret TemplatizedK8sSecret `json:"output,omitempty"`
+	// RolloutTargets are the workloads to restart when the data of the output changes, so that they pick up the new values of environment variables.
+	// The workloads are restarted by annotating their pod template with the hash of the data of the output.
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	RolloutTargets []RolloutTarget `json:"rolloutTargets,omitempty"`
 }
 
 // VaultSecretStatus defines the observed state of VaultSecret
@@ -57,11 +75,26 @@ type VaultSecretStatus struct {
 	//LastVaultSecretUpdate the last time when this secret was updated from Vault
 	LastVaultSecretUpdate *metav1.Time `json:"lastVaultSecretUpdate,omitempty"`
 
+	//LastLeaseRenewal the last time when the leases of the secrets were renewed, without updating the secret
+	LastLeaseRenewal *metav1.Time `json:"lastLeaseRenewal,omitempty"`
+
 	//NextVaultSecretUpdate the next time when this secret will be synced with Vault. If nil, it will not be refreshed.
 	NextVaultSecretUpdate *metav1.Time `json:"nextVaultSecretUpdate,omitempty"`
 
 	//VaultSecretDefinitionsStatus information used to determine if the secret should be rereconciled
 	VaultSecretDefinitionsStatus []VaultSecretDefinitionStatus `json:"vaultSecretDefinitionsStatus,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	//TemplateErrors the errors rendering the templates of the output at the last sync, by key
+	// +listType=map
+	// +listMapKey=key
+	TemplateErrors []TemplateError `json:"templateErrors,omitempty"`
+
+	//LastRolloutHash the hash of the data of the output the rollout targets were last rolled out with, the targets are rolled out again only when the hash changes
+	LastRolloutHash string `json:"lastRolloutHash,omitempty"`
+
+	//SupersededLeases the leases of the secrets no longer written to the output, revoked once their grace period has elapsed
+	// +listType=atomic
+	SupersededLeases []SupersededLease `json:"supersededLeases,omitempty"`
 }
 
 var _ vaultutils.ConditionsAware = &VaultSecret{}
@@ -125,6 +158,11 @@ type VaultSecretDefinition struct {
 	// RequestPayload for POST type of requests, this field contains the payload of the request. Not used for GET requests.
 	// +kubebuilder:validation:Optional
 	RequestPayload map[string]string `json:"requestPayload,omitempty"`
+
+	// Version pins the version of a KV v2 secret to read, the latest version is read if not specified. Only used for GET requests.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	Version int `json:"version,omitempty"`
 }
 
 type VaultSecretDefinitionStatus struct {
@@ -140,20 +178,65 @@ type VaultSecretDefinitionStatus struct {
 	// Renewable informs if the lease is renewable for the dynamic secret
 	// +kubebuilder:validation:Optional
 	Renewable bool `json:"renewable,omitempty"`
+	// Version is the version of the KV v2 secret the output was rendered from
+	// +kubebuilder:validation:Optional
+	Version int `json:"version,omitempty"`
+	// RenewalExhausted informs that the lease reached its max TTL and cannot be renewed any further, new credentials are read at the next refresh
+	// +kubebuilder:validation:Optional
+	RenewalExhausted bool `json:"renewalExhausted,omitempty"`
+}
+
+// SupersededLease is the lease of a secret that is no longer written to the output
+type SupersededLease struct {
+	// Name is the name of the vault secret definition the secret was read with
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// LeaseID is the id of the lease
+	// +kubebuilder:validation:Required
+	LeaseID string `json:"leaseID"`
+	// RevokeAfter is the time the lease is revoked after
+	// +kubebuilder:validation:Required
+	RevokeAfter metav1.Time `json:"revokeAfter"`
 }
 
 type TemplatizedK8sSecret struct {
 	// Name is the K8s Secret name to output to.
 	// +kubebuilder:validation:Required
 	Name string `json:"name,omitempty"`
-	// Type is the K8s Secret type to output to.
-	// +kubebuilder:validation:Required
+	// APIVersion is the apiVersion of the resource to output to.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="v1"
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Kind is the kind of the resource to output to. Secret and ConfigMap resources are rendered from StringData, any other kind is rendered from Template.
+	// The operator must be granted the permissions to manage the resources of kinds other than Secret and ConfigMap.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="Secret"
+	Kind string `json:"kind,omitempty"`
+	// Type is the K8s Secret type to output to. Only used when the output is a Secret.
+	// +kubebuilder:validation:Optional
 	Type string `json:"type,omitempty"`
 	// StringData is the K8s Secret stringData and allows specifying non-binary secret data in string form with go templating support
-	// to transform the Vault KV secrets into a formatted K8s Secret.
+	// to transform the Vault KV secrets into a formatted K8s Secret. When the output is a ConfigMap, it is the data of the ConfigMap.
 	// The Sprig template library and Helm functions (like toYaml) are supported.
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
 	StringData map[string]string `json:"stringData,omitempty"`
+	// Template is a go template rendering the yaml manifest of the resource to output to, when the output is neither a Secret nor a ConfigMap. The kind of the resource must be allowed by the VAULT_SECRET_OUTPUT_ALLOWED_KINDS environment variable of the operator.
+	// The name, namespace, labels and annotations of the resource are set from this section, the manifest only needs to define the content of the resource, for example its spec.
+	// The Sprig template library and Helm functions (like toYaml) are supported.
+	// +kubebuilder:validation:Optional
+	Template string `json:"template,omitempty"`
+	// Templates are named templates shared by the templates of StringData and Template, by name. They are called with the template action or the include function.
+	// +kubebuilder:validation:Optional
+	Templates map[string]string `json:"templates,omitempty"`
+	// TemplatesConfigMap is a ConfigMap holding named templates, by name, shared by the templates of StringData and Template. The ConfigMap is read from the namespace of the VaultSecret,
+	// or from the authenticationNamespace of a ClusterVaultSecret. The named templates of Templates take precedence over the ones of the ConfigMap.
+	// +kubebuilder:validation:Optional
+	TemplatesConfigMap *corev1.LocalObjectReference `json:"templatesConfigMap,omitempty"`
+	// Encoders encode files from the vault secrets, such as keystores, that are written as is to the data of the output. Only used when the output is a Secret.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=key
+	Encoders []OutputEncoder `json:"encoders,omitempty"`
 	// Labels are labels to add to the final K8s Secret.
 	// +kubebuilder:validation:Optional
 	Labels map[string]string `json:"labels,omitempty"`
@@ -162,6 +245,123 @@ type TemplatizedK8sSecret struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// OutputEncoder encodes a file in one of the supported formats, from values rendered with go templating. Exactly one format must be specified.
+type OutputEncoder struct {
+	// Key is the key of the output data the file is written to.
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+	// PKCS12 encodes a PKCS#12 keystore.
+	// +kubebuilder:validation:Optional
+	PKCS12 *KeyStoreEncoder `json:"pkcs12,omitempty"`
+	// JKS encodes a Java keystore.
+	// +kubebuilder:validation:Optional
+	JKS *KeyStoreEncoder `json:"jks,omitempty"`
+	// DockerConfigJSON encodes a docker config JSON file, the content of a kubernetes.io/dockerconfigjson Secret.
+	// +kubebuilder:validation:Optional
+	DockerConfigJSON *DockerConfigJSONEncoder `json:"dockerConfigJSON,omitempty"`
+	// Dotenv encodes a dotenv file.
+	// +kubebuilder:validation:Optional
+	Dotenv *DotenvEncoder `json:"dotenv,omitempty"`
+}
+
+// KeyStoreEncoder encodes a keystore holding a private key and its certificate chain, and trusted CA certificates
+type KeyStoreEncoder struct {
+	// Certificate is a go template rendering the PEM encoded certificate, optionally followed by the intermediate certificates of its chain.
+	// +kubebuilder:validation:Optional
+	Certificate string `json:"certificate,omitempty"`
+	// PrivateKey is a go template rendering the PEM encoded private key of the certificate, in PKCS#1, PKCS#8 or SEC 1 format.
+	// +kubebuilder:validation:Optional
+	PrivateKey string `json:"privateKey,omitempty"`
+	// CA is a go template rendering PEM encoded CA certificates. They are added to the certificate chain of a PKCS#12 keystore, and as trusted certificates to a Java keystore.
+	// A Java keystore with CA certificates only, without a certificate and a private key, is a truststore.
+	// +kubebuilder:validation:Optional
+	CA string `json:"ca,omitempty"`
+	// Password is a go template rendering the password protecting the keystore and its private key. The password of a Java keystore must be ASCII.
+	// +kubebuilder:validation:Required
+	Password string `json:"password,omitempty"`
+	// Alias is the alias of the private key entry of a Java keystore, the CA certificates are aliased with this alias followed by -ca-<index>. The entries of a PKCS#12 keystore have no alias.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="certificate"
+	Alias string `json:"alias,omitempty"`
+}
+
+// DockerConfigJSONEncoder encodes the credentials of container registries in the docker config JSON format
+type DockerConfigJSONEncoder struct {
+	// Registries are the credentials of the registries.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Registries []DockerRegistryCredentials `json:"registries,omitempty"`
+}
+
+// DockerRegistryCredentials are the credentials of a container registry
+type DockerRegistryCredentials struct {
+	// Server is the server of the registry, for example quay.io.
+	// +kubebuilder:validation:Required
+	Server string `json:"server,omitempty"`
+	// Username is a go template rendering the username.
+	// +kubebuilder:validation:Required
+	Username string `json:"username,omitempty"`
+	// Password is a go template rendering the password.
+	// +kubebuilder:validation:Required
+	Password string `json:"password,omitempty"`
+}
+
+// DotenvEncoder encodes a flat map as a dotenv file, one KEY=value line by entry, sorted by key
+type DotenvEncoder struct {
+	// Data is a go template rendering a flat map in YAML or JSON, for example {{ .db | toYaml }}. The keys must be valid environment variable names.
+	// +kubebuilder:validation:Required
+	Data string `json:"data,omitempty"`
+}
+
+// TemplateError is the error rendering the template of a key of the output
+type TemplateError struct {
+	// Key is the key of output.stringData, or template when the output is rendered from output.template.
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+	// Message is the parse or execution error of the template.
+	// +kubebuilder:validation:Required
+	Message string `json:"message"`
+}
+
+// GetGroupVersionKind returns the GroupVersionKind of the resource to output to, a Secret if not specified
+func (t *TemplatizedK8sSecret) GetGroupVersionKind() schema.GroupVersionKind {
+	apiVersion, kind := t.APIVersion, t.Kind
+	if apiVersion == "" {
+		apiVersion = "v1"
+	}
+	if kind == "" {
+		kind = "Secret"
+	}
+	return schema.FromAPIVersionAndKind(apiVersion, kind)
+}
+
+// IsSecret returns whether the resource to output to is a Secret
+func (t *TemplatizedK8sSecret) IsSecret() bool {
+	return t.GetGroupVersionKind() == corev1.SchemeGroupVersion.WithKind("Secret")
+}
+
+// IsConfigMap returns whether the resource to output to is a ConfigMap
+func (t *TemplatizedK8sSecret) IsConfigMap() bool {
+	return t.GetGroupVersionKind() == corev1.SchemeGroupVersion.WithKind("ConfigMap")
+}
+
+// OutputAllowedKindsEnv is the environment variable of the operator listing, comma separated, the kinds of resources other than Secrets and ConfigMaps that outputs may be rendered to, as Kind.group, for example DestinationRule.networking.istio.io, or Kind for the core group
+const OutputAllowedKindsEnv = "VAULT_SECRET_OUTPUT_ALLOWED_KINDS"
+
+// IsOutputKindAllowed returns whether the operator may write the resource to output to. Secrets and ConfigMaps are always allowed, the other kinds only when listed in OutputAllowedKindsEnv.
+func (t *TemplatizedK8sSecret) IsOutputKindAllowed() bool {
+	if t.IsSecret() || t.IsConfigMap() {
+		return true
+	}
+	groupKind := t.GetGroupVersionKind().GroupKind()
+	for _, allowed := range strings.Split(os.Getenv(OutputAllowedKindsEnv), ",") {
+		if allowed = strings.TrimSpace(allowed); allowed != "" && schema.ParseGroupKind(allowed) == groupKind {
+			return true
+		}
+	}
+	return false
+}
+
 func (vs *VaultSecret) IsValid() (bool, error) {
 	err := vs.isValid()
 	return err == nil, err
@@ -169,9 +369,135 @@ func (vs *VaultSecret) IsValid() (bool, error) {
 
 func (vs *VaultSecret) isValid() error {
 	result := &multierror.Error{}
+	result = multierror.Append(result, vs.Spec.TemplatizedK8sSecret.isValid())
+	for i := range vs.Spec.VaultSecretDefinitions {
+		result = multierror.Append(result, vs.Spec.VaultSecretDefinitions[i].isValid())
+	}
+	if len(vs.Spec.RolloutTargets) > 0 {
+		if errs := validation.IsQualifiedName(vs.GetRolloutAnnotationName()); len(errs) > 0 {
+			result = multierror.Append(result, fmt.Errorf("the name of the VaultSecret cannot be used to annotate the rollout targets: %s", strings.Join(errs, ", ")))
+		}
+	}
+	for i := range vs.Spec.RolloutTargets {
+		result = multierror.Append(result, vs.Spec.RolloutTargets[i].isValid())
+	}
 	return result.ErrorOrNil()
 }
 
+func (t *TemplatizedK8sSecret) isValid() error {
+	if _, err := schema.ParseGroupVersion(t.APIVersion); err != nil {
+		return fmt.Errorf("invalid output.apiVersion: %w", err)
+	}
+	if t.TemplatesConfigMap != nil && t.TemplatesConfigMap.Name == "" {
+		return errors.New("output.templatesConfigMap.name is required")
+	}
+	if len(t.Encoders) > 0 && !t.IsSecret() {
+		return errors.New("output.encoders can only be used when the output is a Secret")
+	}
+	keys := map[string]bool{}
+	for k := range t.StringData {
+		keys[k] = true
+	}
+	for i := range t.Encoders {
+		if keys[t.Encoders[i].Key] {
+			return fmt.Errorf("the key %s of output.encoders is already used by output.stringData or another encoder", t.Encoders[i].Key)
+		}
+		keys[t.Encoders[i].Key] = true
+		if err := t.Encoders[i].isValid(); err != nil {
+			return err
+		}
+	}
+	if t.IsSecret() || t.IsConfigMap() {
+		if t.Template != "" {
+			return errors.New("output.template can only be used when the output is neither a Secret nor a ConfigMap, use output.stringData instead")
+		}
+		return nil
+	}
+	if !t.IsOutputKindAllowed() {
+		return fmt.Errorf("the output cannot be a %s, the kinds other than Secret and ConfigMap must be allowed by the operator in %s", t.GetGroupVersionKind().GroupKind(), OutputAllowedKindsEnv)
+	}
+	if t.Template == "" {
+		return fmt.Errorf("output.template is required when the output is a %s", t.GetGroupVersionKind().Kind)
+	}
+	if len(t.StringData) > 0 || t.Type != "" {
+		return fmt.Errorf("output.stringData and output.type can only be used when the output is a Secret or a ConfigMap")
+	}
+	return nil
+}
+
+func (e *OutputEncoder) isValid() error {
+	if errs := validation.IsConfigMapKey(e.Key); len(errs) > 0 {
+		return fmt.Errorf("invalid output.encoders key %s: %s", e.Key, strings.Join(errs, ", "))
+	}
+	formats := 0
+	for _, set := range []bool{e.PKCS12 != nil, e.JKS != nil, e.DockerConfigJSON != nil, e.Dotenv != nil} {
+		if set {
+			formats++
+		}
+	}
+	if formats != 1 {
+		return fmt.Errorf("exactly one of pkcs12, jks, dockerConfigJSON and dotenv must be specified in the %s output encoder", e.Key)
+	}
+	switch {
+	case e.PKCS12 != nil:
+		if e.PKCS12.Certificate == "" || e.PKCS12.PrivateKey == "" {
+			return fmt.Errorf("certificate and privateKey are required in the %s pkcs12 output encoder", e.Key)
+		}
+	case e.JKS != nil:
+		if (e.JKS.Certificate == "") != (e.JKS.PrivateKey == "") {
+			return fmt.Errorf("certificate and privateKey must be specified together in the %s jks output encoder", e.Key)
+		}
+		if e.JKS.Certificate == "" && e.JKS.CA == "" {
+			return fmt.Errorf("certificate and privateKey, or ca, are required in the %s jks output encoder", e.Key)
+		}
+	case e.DockerConfigJSON != nil:
+		if len(e.DockerConfigJSON.Registries) == 0 {
+			return fmt.Errorf("registries are required in the %s dockerConfigJSON output encoder", e.Key)
+		}
+		for _, registry := range e.DockerConfigJSON.Registries {
+			if registry.Server == "" {
+				return fmt.Errorf("server is required for the registries of the %s dockerConfigJSON output encoder", e.Key)
+			}
+		}
+	case e.Dotenv != nil:
+		if e.Dotenv.Data == "" {
+			return fmt.Errorf("data is required in the %s dotenv output encoder", e.Key)
+		}
+	}
+	return nil
+}
+
+// RolloutTarget selects workloads in the namespace of the VaultSecret, by name or by label selector
+type RolloutTarget struct {
+	// Kind is the kind of the workloads.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum={"Deployment","StatefulSet","DaemonSet"}
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the workload. Only one of name and selector can be specified.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+	// Selector selects the workloads by their labels. Only one of name and selector can be specified.
+	// +kubebuilder:validation:Optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// GetRolloutAnnotationName returns the annotation set on the pod template of the rollout targets, one per VaultSecret so that a workload can be restarted by several VaultSecrets
+func (vs *VaultSecret) GetRolloutAnnotationName() string {
+	return "vaultsecret.redhatcop.redhat.io/" + vs.Name
+}
+
+func (t *RolloutTarget) isValid() error {
+	if (t.Name == "") == (t.Selector == nil) {
+		return fmt.Errorf("exactly one of name and selector must be specified in the %s rollout target", t.Kind)
+	}
+	if t.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(t.Selector); err != nil {
+			return fmt.Errorf("invalid selector in the %s rollout target: %w", t.Kind, err)
+		}
+	}
+	return nil
+}
+
 var _ vaultutils.VaultSecretObject = &VaultSecretDefinition{}
 
 func (d *VaultSecretDefinition) GetVaultConnection() *vaultutils.VaultConnection {
@@ -189,6 +515,17 @@ func (d *VaultSecretDefinition) GetRequestMethod() string {
 	return d.RequestType
 }
 
+func (d *VaultSecretDefinition) GetVersion() int {
+	return d.Version
+}
+
+func (d *VaultSecretDefinition) isValid() error {
+	if d.Version != 0 && d.RequestType == "POST" {
+		return fmt.Errorf("version can only be used with GET requests in the %s vault secret definition", d.Name)
+	}
+	return nil
+}
+
 func (d *VaultSecretDefinition) GetKubeAuthConfiguration() *vaultutils.KubeAuthConfiguration {
 	return &d.Authentication
 }
@@ -28,6 +28,279 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSEConfig) DeepCopyInto(out *AWSSEConfig) {
+	*out = *in
+	out.LeaseTTL = in.LeaseTTL
+	out.LeaseMaxTTL = in.LeaseMaxTTL
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSEConfig.
+func (in *AWSSEConfig) DeepCopy() *AWSSEConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSEConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSERole) DeepCopyInto(out *AWSSERole) {
+	*out = *in
+	if in.RoleARNs != nil {
+		in, out := &in.RoleARNs, &out.RoleARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyARNs != nil {
+		in, out := &in.PolicyARNs, &out.PolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IAMGroups != nil {
+		in, out := &in.IAMGroups, &out.IAMGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IAMTags != nil {
+		in, out := &in.IAMTags, &out.IAMTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.DefaultSTSTTL = in.DefaultSTSTTL
+	out.MaxSTSTTL = in.MaxSTSTTL
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSERole.
+func (in *AWSSERole) DeepCopy() *AWSSERole {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSERole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretEngineConfig) DeepCopyInto(out *AWSSecretEngineConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretEngineConfig.
+func (in *AWSSecretEngineConfig) DeepCopy() *AWSSecretEngineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretEngineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSecretEngineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretEngineConfigList) DeepCopyInto(out *AWSSecretEngineConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSSecretEngineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretEngineConfigList.
+func (in *AWSSecretEngineConfigList) DeepCopy() *AWSSecretEngineConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretEngineConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSecretEngineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretEngineConfigSpec) DeepCopyInto(out *AWSSecretEngineConfigSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.RootCredentials.DeepCopyInto(&out.RootCredentials)
+	out.AWSSEConfig = in.AWSSEConfig
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretEngineConfigSpec.
+func (in *AWSSecretEngineConfigSpec) DeepCopy() *AWSSecretEngineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretEngineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretEngineConfigStatus) DeepCopyInto(out *AWSSecretEngineConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretEngineConfigStatus.
+func (in *AWSSecretEngineConfigStatus) DeepCopy() *AWSSecretEngineConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretEngineConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretEngineRole) DeepCopyInto(out *AWSSecretEngineRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretEngineRole.
+func (in *AWSSecretEngineRole) DeepCopy() *AWSSecretEngineRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretEngineRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSecretEngineRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretEngineRoleList) DeepCopyInto(out *AWSSecretEngineRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSSecretEngineRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretEngineRoleList.
+func (in *AWSSecretEngineRoleList) DeepCopy() *AWSSecretEngineRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretEngineRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSecretEngineRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretEngineRoleSpec) DeepCopyInto(out *AWSSecretEngineRoleSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.AWSSERole.DeepCopyInto(&out.AWSSERole)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretEngineRoleSpec.
+func (in *AWSSecretEngineRoleSpec) DeepCopy() *AWSSecretEngineRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretEngineRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretEngineRoleStatus) DeepCopyInto(out *AWSSecretEngineRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretEngineRoleStatus.
+func (in *AWSSecretEngineRoleStatus) DeepCopy() *AWSSecretEngineRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretEngineRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthEngineMount) DeepCopyInto(out *AuthEngineMount) {
 	*out = *in
@@ -119,6 +392,11 @@ func (in *AuthEngineMountStatus) DeepCopyInto(out *AuthEngineMountStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthEngineMountStatus.
@@ -286,6 +564,11 @@ func (in *AzureAuthEngineConfigStatus) DeepCopyInto(out *AzureAuthEngineConfigSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAuthEngineConfigStatus.
@@ -389,6 +672,11 @@ func (in *AzureAuthEngineRoleStatus) DeepCopyInto(out *AzureAuthEngineRoleStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAuthEngineRoleStatus.
@@ -598,6 +886,11 @@ func (in *AzureSecretEngineConfigStatus) DeepCopyInto(out *AzureSecretEngineConf
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSecretEngineConfigStatus.
@@ -701,6 +994,11 @@ func (in *AzureSecretEngineRoleStatus) DeepCopyInto(out *AzureSecretEngineRoleSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSecretEngineRoleStatus.
@@ -819,6 +1117,11 @@ func (in *CertAuthEngineConfigStatus) DeepCopyInto(out *CertAuthEngineConfigStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertAuthEngineConfigStatus.
@@ -987,6 +1290,11 @@ func (in *CertAuthEngineRoleStatus) DeepCopyInto(out *CertAuthEngineRoleStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertAuthEngineRoleStatus.
@@ -1000,47 +1308,196 @@ func (in *CertAuthEngineRoleStatus) DeepCopy() *CertAuthEngineRoleStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSEConfig) DeepCopyInto(out *DBSEConfig) {
+func (in *ClusterVaultSecret) DeepCopyInto(out *ClusterVaultSecret) {
 	*out = *in
-	if in.AllowedRoles != nil {
-		in, out := &in.AllowedRoles, &out.AllowedRoles
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.RootRotationStatements != nil {
-		in, out := &in.RootRotationStatements, &out.RootRotationStatements
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.DatabaseSpecificConfig != nil {
-		in, out := &in.DatabaseSpecificConfig, &out.DatabaseSpecificConfig
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.RootPasswordRotation != nil {
-		in, out := &in.RootPasswordRotation, &out.RootPasswordRotation
-		*out = new(RootPasswordRotation)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSEConfig.
-func (in *DBSEConfig) DeepCopy() *DBSEConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVaultSecret.
+func (in *ClusterVaultSecret) DeepCopy() *ClusterVaultSecret {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSEConfig)
+	out := new(ClusterVaultSecret)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSERole) DeepCopyInto(out *DBSERole) {
-	*out = *in
-	out.DefaultTTL = in.DefaultTTL
-	out.MaxTTL = in.MaxTTL
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVaultSecret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVaultSecretList) DeepCopyInto(out *ClusterVaultSecretList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterVaultSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVaultSecretList.
+func (in *ClusterVaultSecretList) DeepCopy() *ClusterVaultSecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVaultSecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVaultSecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVaultSecretSpec) DeepCopyInto(out *ClusterVaultSecretSpec) {
+	*out = *in
+	if in.RefreshPeriod != nil {
+		in, out := &in.RefreshPeriod, &out.RefreshPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LeaseRevocationGracePeriod != nil {
+		in, out := &in.LeaseRevocationGracePeriod, &out.LeaseRevocationGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.VaultSecretDefinitions != nil {
+		in, out := &in.VaultSecretDefinitions, &out.VaultSecretDefinitions
+		*out = make([]VaultSecretDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.TemplatizedK8sSecret.DeepCopyInto(&out.TemplatizedK8sSecret)
+	in.TargetNamespaces.DeepCopyInto(&out.TargetNamespaces)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVaultSecretSpec.
+func (in *ClusterVaultSecretSpec) DeepCopy() *ClusterVaultSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVaultSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVaultSecretStatus) DeepCopyInto(out *ClusterVaultSecretStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastVaultSecretUpdate != nil {
+		in, out := &in.LastVaultSecretUpdate, &out.LastVaultSecretUpdate
+		*out = (*in).DeepCopy()
+	}
+	if in.LastLeaseRenewal != nil {
+		in, out := &in.LastLeaseRenewal, &out.LastLeaseRenewal
+		*out = (*in).DeepCopy()
+	}
+	if in.NextVaultSecretUpdate != nil {
+		in, out := &in.NextVaultSecretUpdate, &out.NextVaultSecretUpdate
+		*out = (*in).DeepCopy()
+	}
+	if in.VaultSecretDefinitionsStatus != nil {
+		in, out := &in.VaultSecretDefinitionsStatus, &out.VaultSecretDefinitionsStatus
+		*out = make([]VaultSecretDefinitionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetNamespaces != nil {
+		in, out := &in.TargetNamespaces, &out.TargetNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateErrors != nil {
+		in, out := &in.TemplateErrors, &out.TemplateErrors
+		*out = make([]TemplateError, len(*in))
+		copy(*out, *in)
+	}
+	if in.SupersededLeases != nil {
+		in, out := &in.SupersededLeases, &out.SupersededLeases
+		*out = make([]SupersededLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVaultSecretStatus.
+func (in *ClusterVaultSecretStatus) DeepCopy() *ClusterVaultSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVaultSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSEConfig) DeepCopyInto(out *DBSEConfig) {
+	*out = *in
+	if in.AllowedRoles != nil {
+		in, out := &in.AllowedRoles, &out.AllowedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RootRotationStatements != nil {
+		in, out := &in.RootRotationStatements, &out.RootRotationStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DatabaseSpecificConfig != nil {
+		in, out := &in.DatabaseSpecificConfig, &out.DatabaseSpecificConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RootPasswordRotation != nil {
+		in, out := &in.RootPasswordRotation, &out.RootPasswordRotation
+		*out = new(RootPasswordRotation)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSEConfig.
+func (in *DBSEConfig) DeepCopy() *DBSEConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSEConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSERole) DeepCopyInto(out *DBSERole) {
+	*out = *in
+	out.DefaultTTL = in.DefaultTTL
+	out.MaxTTL = in.MaxTTL
 	if in.CreationStatements != nil {
 		in, out := &in.CreationStatements, &out.CreationStatements
 		*out = make([]string, len(*in))
@@ -1196,6 +1653,11 @@ func (in *DatabaseSecretEngineConfigStatus) DeepCopyInto(out *DatabaseSecretEngi
 		}
 	}
 	in.LastRootPasswordRotation.DeepCopyInto(&out.LastRootPasswordRotation)
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSecretEngineConfigStatus.
@@ -1299,6 +1761,11 @@ func (in *DatabaseSecretEngineRoleStatus) DeepCopyInto(out *DatabaseSecretEngine
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSecretEngineRoleStatus.
@@ -1402,6 +1869,11 @@ func (in *DatabaseSecretEngineStaticRoleStatus) DeepCopyInto(out *DatabaseSecret
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSecretEngineStaticRoleStatus.
@@ -1415,111 +1887,84 @@ func (in *DatabaseSecretEngineStaticRoleStatus) DeepCopy() *DatabaseSecretEngine
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPAuthEngineConfig) DeepCopyInto(out *GCPAuthEngineConfig) {
+func (in *DockerConfigJSONEncoder) DeepCopyInto(out *DockerConfigJSONEncoder) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]DockerRegistryCredentials, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineConfig.
-func (in *GCPAuthEngineConfig) DeepCopy() *GCPAuthEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfigJSONEncoder.
+func (in *DockerConfigJSONEncoder) DeepCopy() *DockerConfigJSONEncoder {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPAuthEngineConfig)
+	out := new(DockerConfigJSONEncoder)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GCPAuthEngineConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPAuthEngineConfigList) DeepCopyInto(out *GCPAuthEngineConfigList) {
+func (in *DockerRegistryCredentials) DeepCopyInto(out *DockerRegistryCredentials) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]GCPAuthEngineConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineConfigList.
-func (in *GCPAuthEngineConfigList) DeepCopy() *GCPAuthEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerRegistryCredentials.
+func (in *DockerRegistryCredentials) DeepCopy() *DockerRegistryCredentials {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPAuthEngineConfigList)
+	out := new(DockerRegistryCredentials)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GCPAuthEngineConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPAuthEngineConfigSpec) DeepCopyInto(out *GCPAuthEngineConfigSpec) {
+func (in *DotenvEncoder) DeepCopyInto(out *DotenvEncoder) {
 	*out = *in
-	if in.Connection != nil {
-		in, out := &in.Connection, &out.Connection
-		*out = new(utils.VaultConnection)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.GCPConfig.DeepCopyInto(&out.GCPConfig)
-	in.GCPCredentials.DeepCopyInto(&out.GCPCredentials)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineConfigSpec.
-func (in *GCPAuthEngineConfigSpec) DeepCopy() *GCPAuthEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DotenvEncoder.
+func (in *DotenvEncoder) DeepCopy() *DotenvEncoder {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPAuthEngineConfigSpec)
+	out := new(DotenvEncoder)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPAuthEngineConfigStatus) DeepCopyInto(out *GCPAuthEngineConfigStatus) {
+func (in *Entity) DeepCopyInto(out *Entity) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineConfigStatus.
-func (in *GCPAuthEngineConfigStatus) DeepCopy() *GCPAuthEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Entity.
+func (in *Entity) DeepCopy() *Entity {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPAuthEngineConfigStatus)
+	out := new(Entity)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Entity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPAuthEngineRole) DeepCopyInto(out *GCPAuthEngineRole) {
+func (in *EntityAlias) DeepCopyInto(out *EntityAlias) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1527,18 +1972,18 @@ func (in *GCPAuthEngineRole) DeepCopyInto(out *GCPAuthEngineRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineRole.
-func (in *GCPAuthEngineRole) DeepCopy() *GCPAuthEngineRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityAlias.
+func (in *EntityAlias) DeepCopy() *EntityAlias {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPAuthEngineRole)
+	out := new(EntityAlias)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GCPAuthEngineRole) DeepCopyObject() runtime.Object {
+func (in *EntityAlias) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1546,31 +1991,53 @@ func (in *GCPAuthEngineRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPAuthEngineRoleList) DeepCopyInto(out *GCPAuthEngineRoleList) {
+func (in *EntityAliasConfig) DeepCopyInto(out *EntityAliasConfig) {
+	*out = *in
+	if in.CustomMetadata != nil {
+		in, out := &in.CustomMetadata, &out.CustomMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityAliasConfig.
+func (in *EntityAliasConfig) DeepCopy() *EntityAliasConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EntityAliasConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntityAliasList) DeepCopyInto(out *EntityAliasList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GCPAuthEngineRole, len(*in))
+		*out = make([]EntityAlias, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineRoleList.
-func (in *GCPAuthEngineRoleList) DeepCopy() *GCPAuthEngineRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityAliasList.
+func (in *EntityAliasList) DeepCopy() *EntityAliasList {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPAuthEngineRoleList)
+	out := new(EntityAliasList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GCPAuthEngineRoleList) DeepCopyObject() runtime.Object {
+func (in *EntityAliasList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1578,7 +2045,7 @@ func (in *GCPAuthEngineRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPAuthEngineRoleSpec) DeepCopyInto(out *GCPAuthEngineRoleSpec) {
+func (in *EntityAliasSpec) DeepCopyInto(out *EntityAliasSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -1586,21 +2053,21 @@ func (in *GCPAuthEngineRoleSpec) DeepCopyInto(out *GCPAuthEngineRoleSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.GCPRole.DeepCopyInto(&out.GCPRole)
+	in.EntityAliasConfig.DeepCopyInto(&out.EntityAliasConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineRoleSpec.
-func (in *GCPAuthEngineRoleSpec) DeepCopy() *GCPAuthEngineRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityAliasSpec.
+func (in *EntityAliasSpec) DeepCopy() *EntityAliasSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPAuthEngineRoleSpec)
+	out := new(EntityAliasSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPAuthEngineRoleStatus) DeepCopyInto(out *GCPAuthEngineRoleStatus) {
+func (in *EntityAliasStatus) DeepCopyInto(out *EntityAliasStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1609,166 +2076,76 @@ func (in *GCPAuthEngineRoleStatus) DeepCopyInto(out *GCPAuthEngineRoleStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineRoleStatus.
-func (in *GCPAuthEngineRoleStatus) DeepCopy() *GCPAuthEngineRoleStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(GCPAuthEngineRoleStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPConfig) DeepCopyInto(out *GCPConfig) {
-	*out = *in
-	if in.CustomEndpoint != nil {
-		in, out := &in.CustomEndpoint, &out.CustomEndpoint
-		*out = new(apiextensionsv1.JSON)
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPConfig.
-func (in *GCPConfig) DeepCopy() *GCPConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityAliasStatus.
+func (in *EntityAliasStatus) DeepCopy() *EntityAliasStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPConfig)
+	out := new(EntityAliasStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPRole) DeepCopyInto(out *GCPRole) {
+func (in *EntityConfig) DeepCopyInto(out *EntityConfig) {
 	*out = *in
-	if in.BoundServiceAccounts != nil {
-		in, out := &in.BoundServiceAccounts, &out.BoundServiceAccounts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.BoundProjects != nil {
-		in, out := &in.BoundProjects, &out.BoundProjects
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TokenPolicies != nil {
-		in, out := &in.TokenPolicies, &out.TokenPolicies
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 	if in.Policies != nil {
 		in, out := &in.Policies, &out.Policies
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.TokenBoundCIDRs != nil {
-		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.BoundZones != nil {
-		in, out := &in.BoundZones, &out.BoundZones
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.BoundRegions != nil {
-		in, out := &in.BoundRegions, &out.BoundRegions
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.BoundInstanceGroups != nil {
-		in, out := &in.BoundInstanceGroups, &out.BoundInstanceGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.BoundLabels != nil {
-		in, out := &in.BoundLabels, &out.BoundLabels
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPRole.
-func (in *GCPRole) DeepCopy() *GCPRole {
-	if in == nil {
-		return nil
-	}
-	out := new(GCPRole)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GHConfig) DeepCopyInto(out *GHConfig) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GHConfig.
-func (in *GHConfig) DeepCopy() *GHConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(GHConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubSecretEngineConfig) DeepCopyInto(out *GitHubSecretEngineConfig) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineConfig.
-func (in *GitHubSecretEngineConfig) DeepCopy() *GitHubSecretEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityConfig.
+func (in *EntityConfig) DeepCopy() *EntityConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(GitHubSecretEngineConfig)
+	out := new(EntityConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GitHubSecretEngineConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubSecretEngineConfigList) DeepCopyInto(out *GitHubSecretEngineConfigList) {
+func (in *EntityList) DeepCopyInto(out *EntityList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GitHubSecretEngineConfig, len(*in))
+		*out = make([]Entity, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineConfigList.
-func (in *GitHubSecretEngineConfigList) DeepCopy() *GitHubSecretEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityList.
+func (in *EntityList) DeepCopy() *EntityList {
 	if in == nil {
 		return nil
 	}
-	out := new(GitHubSecretEngineConfigList)
+	out := new(EntityList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GitHubSecretEngineConfigList) DeepCopyObject() runtime.Object {
+func (in *EntityList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1776,7 +2153,7 @@ func (in *GitHubSecretEngineConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubSecretEngineConfigSpec) DeepCopyInto(out *GitHubSecretEngineConfigSpec) {
+func (in *EntitySpec) DeepCopyInto(out *EntitySpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -1784,22 +2161,21 @@ func (in *GitHubSecretEngineConfigSpec) DeepCopyInto(out *GitHubSecretEngineConf
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	out.GHConfig = in.GHConfig
-	in.SSHKeyReference.DeepCopyInto(&out.SSHKeyReference)
+	in.EntityConfig.DeepCopyInto(&out.EntityConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineConfigSpec.
-func (in *GitHubSecretEngineConfigSpec) DeepCopy() *GitHubSecretEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitySpec.
+func (in *EntitySpec) DeepCopy() *EntitySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GitHubSecretEngineConfigSpec)
+	out := new(EntitySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubSecretEngineConfigStatus) DeepCopyInto(out *GitHubSecretEngineConfigStatus) {
+func (in *EntityStatus) DeepCopyInto(out *EntityStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1808,20 +2184,25 @@ func (in *GitHubSecretEngineConfigStatus) DeepCopyInto(out *GitHubSecretEngineCo
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineConfigStatus.
-func (in *GitHubSecretEngineConfigStatus) DeepCopy() *GitHubSecretEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityStatus.
+func (in *EntityStatus) DeepCopy() *EntityStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GitHubSecretEngineConfigStatus)
+	out := new(EntityStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubSecretEngineRole) DeepCopyInto(out *GitHubSecretEngineRole) {
+func (in *GCPAuthEngineConfig) DeepCopyInto(out *GCPAuthEngineConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1829,18 +2210,18 @@ func (in *GitHubSecretEngineRole) DeepCopyInto(out *GitHubSecretEngineRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineRole.
-func (in *GitHubSecretEngineRole) DeepCopy() *GitHubSecretEngineRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineConfig.
+func (in *GCPAuthEngineConfig) DeepCopy() *GCPAuthEngineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(GitHubSecretEngineRole)
+	out := new(GCPAuthEngineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GitHubSecretEngineRole) DeepCopyObject() runtime.Object {
+func (in *GCPAuthEngineConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1848,31 +2229,31 @@ func (in *GitHubSecretEngineRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubSecretEngineRoleList) DeepCopyInto(out *GitHubSecretEngineRoleList) {
+func (in *GCPAuthEngineConfigList) DeepCopyInto(out *GCPAuthEngineConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GitHubSecretEngineRole, len(*in))
+		*out = make([]GCPAuthEngineConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineRoleList.
-func (in *GitHubSecretEngineRoleList) DeepCopy() *GitHubSecretEngineRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineConfigList.
+func (in *GCPAuthEngineConfigList) DeepCopy() *GCPAuthEngineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(GitHubSecretEngineRoleList)
+	out := new(GCPAuthEngineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GitHubSecretEngineRoleList) DeepCopyObject() runtime.Object {
+func (in *GCPAuthEngineConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1880,7 +2261,7 @@ func (in *GitHubSecretEngineRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubSecretEngineRoleSpec) DeepCopyInto(out *GitHubSecretEngineRoleSpec) {
+func (in *GCPAuthEngineConfigSpec) DeepCopyInto(out *GCPAuthEngineConfigSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -1888,21 +2269,22 @@ func (in *GitHubSecretEngineRoleSpec) DeepCopyInto(out *GitHubSecretEngineRoleSp
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.PermissionSet.DeepCopyInto(&out.PermissionSet)
+	in.GCPConfig.DeepCopyInto(&out.GCPConfig)
+	in.GCPCredentials.DeepCopyInto(&out.GCPCredentials)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineRoleSpec.
-func (in *GitHubSecretEngineRoleSpec) DeepCopy() *GitHubSecretEngineRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineConfigSpec.
+func (in *GCPAuthEngineConfigSpec) DeepCopy() *GCPAuthEngineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GitHubSecretEngineRoleSpec)
+	out := new(GCPAuthEngineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubSecretEngineRoleStatus) DeepCopyInto(out *GitHubSecretEngineRoleStatus) {
+func (in *GCPAuthEngineConfigStatus) DeepCopyInto(out *GCPAuthEngineConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1911,47 +2293,25 @@ func (in *GitHubSecretEngineRoleStatus) DeepCopyInto(out *GitHubSecretEngineRole
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineRoleStatus.
-func (in *GitHubSecretEngineRoleStatus) DeepCopy() *GitHubSecretEngineRoleStatus {
-	if in == nil {
-		return nil
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(GitHubSecretEngineRoleStatus)
-	in.DeepCopyInto(out)
-	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Group) DeepCopyInto(out *Group) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Group.
-func (in *Group) DeepCopy() *Group {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineConfigStatus.
+func (in *GCPAuthEngineConfigStatus) DeepCopy() *GCPAuthEngineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Group)
+	out := new(GCPAuthEngineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Group) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupAlias) DeepCopyInto(out *GroupAlias) {
+func (in *GCPAuthEngineRole) DeepCopyInto(out *GCPAuthEngineRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1959,18 +2319,18 @@ func (in *GroupAlias) DeepCopyInto(out *GroupAlias) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAlias.
-func (in *GroupAlias) DeepCopy() *GroupAlias {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineRole.
+func (in *GCPAuthEngineRole) DeepCopy() *GCPAuthEngineRole {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupAlias)
+	out := new(GCPAuthEngineRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupAlias) DeepCopyObject() runtime.Object {
+func (in *GCPAuthEngineRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1978,46 +2338,31 @@ func (in *GroupAlias) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupAliasConfig) DeepCopyInto(out *GroupAliasConfig) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAliasConfig.
-func (in *GroupAliasConfig) DeepCopy() *GroupAliasConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(GroupAliasConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupAliasList) DeepCopyInto(out *GroupAliasList) {
+func (in *GCPAuthEngineRoleList) DeepCopyInto(out *GCPAuthEngineRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GroupAlias, len(*in))
+		*out = make([]GCPAuthEngineRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAliasList.
-func (in *GroupAliasList) DeepCopy() *GroupAliasList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineRoleList.
+func (in *GCPAuthEngineRoleList) DeepCopy() *GCPAuthEngineRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupAliasList)
+	out := new(GCPAuthEngineRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupAliasList) DeepCopyObject() runtime.Object {
+func (in *GCPAuthEngineRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2025,7 +2370,7 @@ func (in *GroupAliasList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupAliasSpec) DeepCopyInto(out *GroupAliasSpec) {
+func (in *GCPAuthEngineRoleSpec) DeepCopyInto(out *GCPAuthEngineRoleSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -2033,21 +2378,21 @@ func (in *GroupAliasSpec) DeepCopyInto(out *GroupAliasSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	out.GroupAliasConfig = in.GroupAliasConfig
+	in.GCPRole.DeepCopyInto(&out.GCPRole)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAliasSpec.
-func (in *GroupAliasSpec) DeepCopy() *GroupAliasSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineRoleSpec.
+func (in *GCPAuthEngineRoleSpec) DeepCopy() *GCPAuthEngineRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupAliasSpec)
+	out := new(GCPAuthEngineRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupAliasStatus) DeepCopyInto(out *GroupAliasStatus) {
+func (in *GCPAuthEngineRoleStatus) DeepCopyInto(out *GCPAuthEngineRoleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2056,81 +2401,171 @@ func (in *GroupAliasStatus) DeepCopyInto(out *GroupAliasStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAliasStatus.
-func (in *GroupAliasStatus) DeepCopy() *GroupAliasStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthEngineRoleStatus.
+func (in *GCPAuthEngineRoleStatus) DeepCopy() *GCPAuthEngineRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupAliasStatus)
+	out := new(GCPAuthEngineRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupConfig) DeepCopyInto(out *GroupConfig) {
+func (in *GCPConfig) DeepCopyInto(out *GCPConfig) {
 	*out = *in
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.CustomEndpoint != nil {
+		in, out := &in.CustomEndpoint, &out.CustomEndpoint
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPConfig.
+func (in *GCPConfig) DeepCopy() *GCPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPRole) DeepCopyInto(out *GCPRole) {
+	*out = *in
+	if in.BoundServiceAccounts != nil {
+		in, out := &in.BoundServiceAccounts, &out.BoundServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundProjects != nil {
+		in, out := &in.BoundProjects, &out.BoundProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenPolicies != nil {
+		in, out := &in.TokenPolicies, &out.TokenPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	if in.Policies != nil {
 		in, out := &in.Policies, &out.Policies
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.MemberGroupIDs != nil {
-		in, out := &in.MemberGroupIDs, &out.MemberGroupIDs
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.MemberEntityIDs != nil {
-		in, out := &in.MemberEntityIDs, &out.MemberEntityIDs
+	if in.BoundZones != nil {
+		in, out := &in.BoundZones, &out.BoundZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundRegions != nil {
+		in, out := &in.BoundRegions, &out.BoundRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundInstanceGroups != nil {
+		in, out := &in.BoundInstanceGroups, &out.BoundInstanceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundLabels != nil {
+		in, out := &in.BoundLabels, &out.BoundLabels
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupConfig.
-func (in *GroupConfig) DeepCopy() *GroupConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPRole.
+func (in *GCPRole) DeepCopy() *GCPRole {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupConfig)
+	out := new(GCPRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupList) DeepCopyInto(out *GroupList) {
+func (in *GHConfig) DeepCopyInto(out *GHConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GHConfig.
+func (in *GHConfig) DeepCopy() *GHConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GHConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubSecretEngineConfig) DeepCopyInto(out *GitHubSecretEngineConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineConfig.
+func (in *GitHubSecretEngineConfig) DeepCopy() *GitHubSecretEngineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubSecretEngineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitHubSecretEngineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubSecretEngineConfigList) DeepCopyInto(out *GitHubSecretEngineConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Group, len(*in))
+		*out = make([]GitHubSecretEngineConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupList.
-func (in *GroupList) DeepCopy() *GroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineConfigList.
+func (in *GitHubSecretEngineConfigList) DeepCopy() *GitHubSecretEngineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupList)
+	out := new(GitHubSecretEngineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupList) DeepCopyObject() runtime.Object {
+func (in *GitHubSecretEngineConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2138,7 +2573,7 @@ func (in *GroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
+func (in *GitHubSecretEngineConfigSpec) DeepCopyInto(out *GitHubSecretEngineConfigSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -2146,21 +2581,22 @@ func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.GroupConfig.DeepCopyInto(&out.GroupConfig)
+	out.GHConfig = in.GHConfig
+	in.SSHKeyReference.DeepCopyInto(&out.SSHKeyReference)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupSpec.
-func (in *GroupSpec) DeepCopy() *GroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineConfigSpec.
+func (in *GitHubSecretEngineConfigSpec) DeepCopy() *GitHubSecretEngineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupSpec)
+	out := new(GitHubSecretEngineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
+func (in *GitHubSecretEngineConfigStatus) DeepCopyInto(out *GitHubSecretEngineConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2169,20 +2605,25 @@ func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
-func (in *GroupStatus) DeepCopy() *GroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineConfigStatus.
+func (in *GitHubSecretEngineConfigStatus) DeepCopy() *GitHubSecretEngineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupStatus)
+	out := new(GitHubSecretEngineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCAuthEngineConfig) DeepCopyInto(out *JWTOIDCAuthEngineConfig) {
+func (in *GitHubSecretEngineRole) DeepCopyInto(out *GitHubSecretEngineRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2190,18 +2631,18 @@ func (in *JWTOIDCAuthEngineConfig) DeepCopyInto(out *JWTOIDCAuthEngineConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineConfig.
-func (in *JWTOIDCAuthEngineConfig) DeepCopy() *JWTOIDCAuthEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineRole.
+func (in *GitHubSecretEngineRole) DeepCopy() *GitHubSecretEngineRole {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCAuthEngineConfig)
+	out := new(GitHubSecretEngineRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JWTOIDCAuthEngineConfig) DeepCopyObject() runtime.Object {
+func (in *GitHubSecretEngineRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2209,31 +2650,31 @@ func (in *JWTOIDCAuthEngineConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCAuthEngineConfigList) DeepCopyInto(out *JWTOIDCAuthEngineConfigList) {
+func (in *GitHubSecretEngineRoleList) DeepCopyInto(out *GitHubSecretEngineRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]JWTOIDCAuthEngineConfig, len(*in))
+		*out = make([]GitHubSecretEngineRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineConfigList.
-func (in *JWTOIDCAuthEngineConfigList) DeepCopy() *JWTOIDCAuthEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineRoleList.
+func (in *GitHubSecretEngineRoleList) DeepCopy() *GitHubSecretEngineRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCAuthEngineConfigList)
+	out := new(GitHubSecretEngineRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JWTOIDCAuthEngineConfigList) DeepCopyObject() runtime.Object {
+func (in *GitHubSecretEngineRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2241,7 +2682,7 @@ func (in *JWTOIDCAuthEngineConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCAuthEngineConfigSpec) DeepCopyInto(out *JWTOIDCAuthEngineConfigSpec) {
+func (in *GitHubSecretEngineRoleSpec) DeepCopyInto(out *GitHubSecretEngineRoleSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -2249,26 +2690,21 @@ func (in *JWTOIDCAuthEngineConfigSpec) DeepCopyInto(out *JWTOIDCAuthEngineConfig
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.JWTOIDCConfig.DeepCopyInto(&out.JWTOIDCConfig)
-	if in.OIDCCredentials != nil {
-		in, out := &in.OIDCCredentials, &out.OIDCCredentials
-		*out = new(utils.RootCredentialConfig)
-		(*in).DeepCopyInto(*out)
-	}
+	in.PermissionSet.DeepCopyInto(&out.PermissionSet)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineConfigSpec.
-func (in *JWTOIDCAuthEngineConfigSpec) DeepCopy() *JWTOIDCAuthEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineRoleSpec.
+func (in *GitHubSecretEngineRoleSpec) DeepCopy() *GitHubSecretEngineRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCAuthEngineConfigSpec)
+	out := new(GitHubSecretEngineRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCAuthEngineConfigStatus) DeepCopyInto(out *JWTOIDCAuthEngineConfigStatus) {
+func (in *GitHubSecretEngineRoleStatus) DeepCopyInto(out *GitHubSecretEngineRoleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2277,20 +2713,25 @@ func (in *JWTOIDCAuthEngineConfigStatus) DeepCopyInto(out *JWTOIDCAuthEngineConf
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineConfigStatus.
-func (in *JWTOIDCAuthEngineConfigStatus) DeepCopy() *JWTOIDCAuthEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretEngineRoleStatus.
+func (in *GitHubSecretEngineRoleStatus) DeepCopy() *GitHubSecretEngineRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCAuthEngineConfigStatus)
+	out := new(GitHubSecretEngineRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCAuthEngineRole) DeepCopyInto(out *JWTOIDCAuthEngineRole) {
+func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2298,18 +2739,18 @@ func (in *JWTOIDCAuthEngineRole) DeepCopyInto(out *JWTOIDCAuthEngineRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineRole.
-func (in *JWTOIDCAuthEngineRole) DeepCopy() *JWTOIDCAuthEngineRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Group.
+func (in *Group) DeepCopy() *Group {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCAuthEngineRole)
+	out := new(Group)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JWTOIDCAuthEngineRole) DeepCopyObject() runtime.Object {
+func (in *Group) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2317,31 +2758,26 @@ func (in *JWTOIDCAuthEngineRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCAuthEngineRoleList) DeepCopyInto(out *JWTOIDCAuthEngineRoleList) {
+func (in *GroupAlias) DeepCopyInto(out *GroupAlias) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]JWTOIDCAuthEngineRole, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineRoleList.
-func (in *JWTOIDCAuthEngineRoleList) DeepCopy() *JWTOIDCAuthEngineRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAlias.
+func (in *GroupAlias) DeepCopy() *GroupAlias {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCAuthEngineRoleList)
+	out := new(GroupAlias)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JWTOIDCAuthEngineRoleList) DeepCopyObject() runtime.Object {
+func (in *GroupAlias) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2349,209 +2785,226 @@ func (in *JWTOIDCAuthEngineRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCAuthEngineRoleSpec) DeepCopyInto(out *JWTOIDCAuthEngineRoleSpec) {
+func (in *GroupAliasConfig) DeepCopyInto(out *GroupAliasConfig) {
 	*out = *in
-	if in.Connection != nil {
-		in, out := &in.Connection, &out.Connection
-		*out = new(utils.VaultConnection)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.JWTOIDCRole.DeepCopyInto(&out.JWTOIDCRole)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineRoleSpec.
-func (in *JWTOIDCAuthEngineRoleSpec) DeepCopy() *JWTOIDCAuthEngineRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAliasConfig.
+func (in *GroupAliasConfig) DeepCopy() *GroupAliasConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCAuthEngineRoleSpec)
+	out := new(GroupAliasConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCAuthEngineRoleStatus) DeepCopyInto(out *JWTOIDCAuthEngineRoleStatus) {
+func (in *GroupAliasList) DeepCopyInto(out *GroupAliasList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupAlias, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineRoleStatus.
-func (in *JWTOIDCAuthEngineRoleStatus) DeepCopy() *JWTOIDCAuthEngineRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAliasList.
+func (in *GroupAliasList) DeepCopy() *GroupAliasList {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCAuthEngineRoleStatus)
+	out := new(GroupAliasList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCConfig) DeepCopyInto(out *JWTOIDCConfig) {
-	*out = *in
-	if in.OIDCResponseTypes != nil {
-		in, out := &in.OIDCResponseTypes, &out.OIDCResponseTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.JWTValidationPubKeys != nil {
-		in, out := &in.JWTValidationPubKeys, &out.JWTValidationPubKeys
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.JWTSupportedAlgs != nil {
-		in, out := &in.JWTSupportedAlgs, &out.JWTSupportedAlgs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupAliasList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ProviderConfig != nil {
-		in, out := &in.ProviderConfig, &out.ProviderConfig
-		*out = new(apiextensionsv1.JSON)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupAliasSpec) DeepCopyInto(out *GroupAliasSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
 		(*in).DeepCopyInto(*out)
 	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	out.GroupAliasConfig = in.GroupAliasConfig
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCConfig.
-func (in *JWTOIDCConfig) DeepCopy() *JWTOIDCConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAliasSpec.
+func (in *GroupAliasSpec) DeepCopy() *GroupAliasSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCConfig)
+	out := new(GroupAliasSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTOIDCRole) DeepCopyInto(out *JWTOIDCRole) {
+func (in *GroupAliasStatus) DeepCopyInto(out *GroupAliasStatus) {
 	*out = *in
-	if in.BoundAudiences != nil {
-		in, out := &in.BoundAudiences, &out.BoundAudiences
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.BoundClaims != nil {
-		in, out := &in.BoundClaims, &out.BoundClaims
-		*out = new(apiextensionsv1.JSON)
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ClaimMappings != nil {
-		in, out := &in.ClaimMappings, &out.ClaimMappings
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAliasStatus.
+func (in *GroupAliasStatus) DeepCopy() *GroupAliasStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupAliasStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupConfig) DeepCopyInto(out *GroupConfig) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.OIDCScopes != nil {
-		in, out := &in.OIDCScopes, &out.OIDCScopes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AllowedRedirectURIs != nil {
-		in, out := &in.AllowedRedirectURIs, &out.AllowedRedirectURIs
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.TokenPolicies != nil {
-		in, out := &in.TokenPolicies, &out.TokenPolicies
+	if in.MemberGroupIDs != nil {
+		in, out := &in.MemberGroupIDs, &out.MemberGroupIDs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.TokenBoundCIDRs != nil {
-		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+	if in.MemberEntityIDs != nil {
+		in, out := &in.MemberEntityIDs, &out.MemberEntityIDs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCRole.
-func (in *JWTOIDCRole) DeepCopy() *JWTOIDCRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupConfig.
+func (in *GroupConfig) DeepCopy() *GroupConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTOIDCRole)
+	out := new(GroupConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KAECConfig) DeepCopyInto(out *KAECConfig) {
+func (in *GroupList) DeepCopyInto(out *GroupList) {
 	*out = *in
-	if in.PEMKeys != nil {
-		in, out := &in.PEMKeys, &out.PEMKeys
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Group, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KAECConfig.
-func (in *KAECConfig) DeepCopy() *KAECConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupList.
+func (in *GroupList) DeepCopy() *GroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(KAECConfig)
+	out := new(GroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeSEConfig) DeepCopyInto(out *KubeSEConfig) {
+func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.GroupConfig.DeepCopyInto(&out.GroupConfig)
+	if in.retrievedMemberEntityIDs != nil {
+		in, out := &in.retrievedMemberEntityIDs, &out.retrievedMemberEntityIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSEConfig.
-func (in *KubeSEConfig) DeepCopy() *KubeSEConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupSpec.
+func (in *GroupSpec) DeepCopy() *GroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeSEConfig)
+	out := new(GroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeSERole) DeepCopyInto(out *KubeSERole) {
+func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 	*out = *in
-	if in.AllowedKubernetesNamespaces != nil {
-		in, out := &in.AllowedKubernetesNamespaces, &out.AllowedKubernetesNamespaces
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	out.DefaultTTL = in.DefaultTTL
-	out.MaxTTL = in.MaxTTL
-	if in.ExtraAnnotations != nil {
-		in, out := &in.ExtraAnnotations, &out.ExtraAnnotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ExtraLabels != nil {
-		in, out := &in.ExtraLabels, &out.ExtraLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSERole.
-func (in *KubeSERole) DeepCopy() *KubeSERole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
+func (in *GroupStatus) DeepCopy() *GroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeSERole)
+	out := new(GroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuthEngineConfig) DeepCopyInto(out *KubernetesAuthEngineConfig) {
+func (in *JWTOIDCAuthEngineConfig) DeepCopyInto(out *JWTOIDCAuthEngineConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2559,18 +3012,18 @@ func (in *KubernetesAuthEngineConfig) DeepCopyInto(out *KubernetesAuthEngineConf
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineConfig.
-func (in *KubernetesAuthEngineConfig) DeepCopy() *KubernetesAuthEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineConfig.
+func (in *JWTOIDCAuthEngineConfig) DeepCopy() *JWTOIDCAuthEngineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuthEngineConfig)
+	out := new(JWTOIDCAuthEngineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesAuthEngineConfig) DeepCopyObject() runtime.Object {
+func (in *JWTOIDCAuthEngineConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2578,31 +3031,31 @@ func (in *KubernetesAuthEngineConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuthEngineConfigList) DeepCopyInto(out *KubernetesAuthEngineConfigList) {
+func (in *JWTOIDCAuthEngineConfigList) DeepCopyInto(out *JWTOIDCAuthEngineConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KubernetesAuthEngineConfig, len(*in))
+		*out = make([]JWTOIDCAuthEngineConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineConfigList.
-func (in *KubernetesAuthEngineConfigList) DeepCopy() *KubernetesAuthEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineConfigList.
+func (in *JWTOIDCAuthEngineConfigList) DeepCopy() *JWTOIDCAuthEngineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuthEngineConfigList)
+	out := new(JWTOIDCAuthEngineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesAuthEngineConfigList) DeepCopyObject() runtime.Object {
+func (in *JWTOIDCAuthEngineConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2610,7 +3063,7 @@ func (in *KubernetesAuthEngineConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuthEngineConfigSpec) DeepCopyInto(out *KubernetesAuthEngineConfigSpec) {
+func (in *JWTOIDCAuthEngineConfigSpec) DeepCopyInto(out *JWTOIDCAuthEngineConfigSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -2618,26 +3071,26 @@ func (in *KubernetesAuthEngineConfigSpec) DeepCopyInto(out *KubernetesAuthEngine
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.KAECConfig.DeepCopyInto(&out.KAECConfig)
-	if in.TokenReviewerServiceAccount != nil {
-		in, out := &in.TokenReviewerServiceAccount, &out.TokenReviewerServiceAccount
-		*out = new(corev1.LocalObjectReference)
-		**out = **in
+	in.JWTOIDCConfig.DeepCopyInto(&out.JWTOIDCConfig)
+	if in.OIDCCredentials != nil {
+		in, out := &in.OIDCCredentials, &out.OIDCCredentials
+		*out = new(utils.RootCredentialConfig)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineConfigSpec.
-func (in *KubernetesAuthEngineConfigSpec) DeepCopy() *KubernetesAuthEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineConfigSpec.
+func (in *JWTOIDCAuthEngineConfigSpec) DeepCopy() *JWTOIDCAuthEngineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuthEngineConfigSpec)
+	out := new(JWTOIDCAuthEngineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuthEngineConfigStatus) DeepCopyInto(out *KubernetesAuthEngineConfigStatus) {
+func (in *JWTOIDCAuthEngineConfigStatus) DeepCopyInto(out *JWTOIDCAuthEngineConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2646,20 +3099,25 @@ func (in *KubernetesAuthEngineConfigStatus) DeepCopyInto(out *KubernetesAuthEngi
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineConfigStatus.
-func (in *KubernetesAuthEngineConfigStatus) DeepCopy() *KubernetesAuthEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineConfigStatus.
+func (in *JWTOIDCAuthEngineConfigStatus) DeepCopy() *JWTOIDCAuthEngineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuthEngineConfigStatus)
+	out := new(JWTOIDCAuthEngineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuthEngineRole) DeepCopyInto(out *KubernetesAuthEngineRole) {
+func (in *JWTOIDCAuthEngineRole) DeepCopyInto(out *JWTOIDCAuthEngineRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2667,18 +3125,18 @@ func (in *KubernetesAuthEngineRole) DeepCopyInto(out *KubernetesAuthEngineRole)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineRole.
-func (in *KubernetesAuthEngineRole) DeepCopy() *KubernetesAuthEngineRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineRole.
+func (in *JWTOIDCAuthEngineRole) DeepCopy() *JWTOIDCAuthEngineRole {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuthEngineRole)
+	out := new(JWTOIDCAuthEngineRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesAuthEngineRole) DeepCopyObject() runtime.Object {
+func (in *JWTOIDCAuthEngineRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2686,31 +3144,31 @@ func (in *KubernetesAuthEngineRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuthEngineRoleList) DeepCopyInto(out *KubernetesAuthEngineRoleList) {
+func (in *JWTOIDCAuthEngineRoleList) DeepCopyInto(out *JWTOIDCAuthEngineRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KubernetesAuthEngineRole, len(*in))
+		*out = make([]JWTOIDCAuthEngineRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineRoleList.
-func (in *KubernetesAuthEngineRoleList) DeepCopy() *KubernetesAuthEngineRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineRoleList.
+func (in *JWTOIDCAuthEngineRoleList) DeepCopy() *JWTOIDCAuthEngineRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuthEngineRoleList)
+	out := new(JWTOIDCAuthEngineRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesAuthEngineRoleList) DeepCopyObject() runtime.Object {
+func (in *JWTOIDCAuthEngineRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2718,7 +3176,7 @@ func (in *KubernetesAuthEngineRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuthEngineRoleSpec) DeepCopyInto(out *KubernetesAuthEngineRoleSpec) {
+func (in *JWTOIDCAuthEngineRoleSpec) DeepCopyInto(out *JWTOIDCAuthEngineRoleSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -2726,22 +3184,21 @@ func (in *KubernetesAuthEngineRoleSpec) DeepCopyInto(out *KubernetesAuthEngineRo
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.VRole.DeepCopyInto(&out.VRole)
-	in.TargetNamespaces.DeepCopyInto(&out.TargetNamespaces)
+	in.JWTOIDCRole.DeepCopyInto(&out.JWTOIDCRole)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineRoleSpec.
-func (in *KubernetesAuthEngineRoleSpec) DeepCopy() *KubernetesAuthEngineRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineRoleSpec.
+func (in *JWTOIDCAuthEngineRoleSpec) DeepCopy() *JWTOIDCAuthEngineRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuthEngineRoleSpec)
+	out := new(JWTOIDCAuthEngineRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuthEngineRoleStatus) DeepCopyInto(out *KubernetesAuthEngineRoleStatus) {
+func (in *JWTOIDCAuthEngineRoleStatus) DeepCopyInto(out *JWTOIDCAuthEngineRoleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2750,124 +3207,198 @@ func (in *KubernetesAuthEngineRoleStatus) DeepCopyInto(out *KubernetesAuthEngine
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineRoleStatus.
-func (in *KubernetesAuthEngineRoleStatus) DeepCopy() *KubernetesAuthEngineRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCAuthEngineRoleStatus.
+func (in *JWTOIDCAuthEngineRoleStatus) DeepCopy() *JWTOIDCAuthEngineRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuthEngineRoleStatus)
+	out := new(JWTOIDCAuthEngineRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretEngineConfig) DeepCopyInto(out *KubernetesSecretEngineConfig) {
+func (in *JWTOIDCConfig) DeepCopyInto(out *JWTOIDCConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.OIDCResponseTypes != nil {
+		in, out := &in.OIDCResponseTypes, &out.OIDCResponseTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JWTValidationPubKeys != nil {
+		in, out := &in.JWTValidationPubKeys, &out.JWTValidationPubKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JWTSupportedAlgs != nil {
+		in, out := &in.JWTSupportedAlgs, &out.JWTSupportedAlgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineConfig.
-func (in *KubernetesSecretEngineConfig) DeepCopy() *KubernetesSecretEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCConfig.
+func (in *JWTOIDCConfig) DeepCopy() *JWTOIDCConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretEngineConfig)
+	out := new(JWTOIDCConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesSecretEngineConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTOIDCRole) DeepCopyInto(out *JWTOIDCRole) {
+	*out = *in
+	if in.BoundAudiences != nil {
+		in, out := &in.BoundAudiences, &out.BoundAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	if in.BoundClaims != nil {
+		in, out := &in.BoundClaims, &out.BoundClaims
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClaimMappings != nil {
+		in, out := &in.ClaimMappings, &out.ClaimMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.OIDCScopes != nil {
+		in, out := &in.OIDCScopes, &out.OIDCScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedRedirectURIs != nil {
+		in, out := &in.AllowedRedirectURIs, &out.AllowedRedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenPolicies != nil {
+		in, out := &in.TokenPolicies, &out.TokenPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTOIDCRole.
+func (in *JWTOIDCRole) DeepCopy() *JWTOIDCRole {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTOIDCRole)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretEngineConfigList) DeepCopyInto(out *KubernetesSecretEngineConfigList) {
+func (in *KAECConfig) DeepCopyInto(out *KAECConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]KubernetesSecretEngineConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.PEMKeys != nil {
+		in, out := &in.PEMKeys, &out.PEMKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineConfigList.
-func (in *KubernetesSecretEngineConfigList) DeepCopy() *KubernetesSecretEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KAECConfig.
+func (in *KAECConfig) DeepCopy() *KAECConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretEngineConfigList)
+	out := new(KAECConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesSecretEngineConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyStoreEncoder) DeepCopyInto(out *KeyStoreEncoder) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyStoreEncoder.
+func (in *KeyStoreEncoder) DeepCopy() *KeyStoreEncoder {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(KeyStoreEncoder)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretEngineConfigSpec) DeepCopyInto(out *KubernetesSecretEngineConfigSpec) {
+func (in *KubeSEConfig) DeepCopyInto(out *KubeSEConfig) {
 	*out = *in
-	if in.Connection != nil {
-		in, out := &in.Connection, &out.Connection
-		*out = new(utils.VaultConnection)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.JWTReference.DeepCopyInto(&out.JWTReference)
-	out.KubeSEConfig = in.KubeSEConfig
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineConfigSpec.
-func (in *KubernetesSecretEngineConfigSpec) DeepCopy() *KubernetesSecretEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSEConfig.
+func (in *KubeSEConfig) DeepCopy() *KubeSEConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretEngineConfigSpec)
+	out := new(KubeSEConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretEngineConfigStatus) DeepCopyInto(out *KubernetesSecretEngineConfigStatus) {
+func (in *KubeSERole) DeepCopyInto(out *KubeSERole) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.AllowedKubernetesNamespaces != nil {
+		in, out := &in.AllowedKubernetesNamespaces, &out.AllowedKubernetesNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.DefaultTTL = in.DefaultTTL
+	out.MaxTTL = in.MaxTTL
+	if in.ExtraAnnotations != nil {
+		in, out := &in.ExtraAnnotations, &out.ExtraAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraLabels != nil {
+		in, out := &in.ExtraLabels, &out.ExtraLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineConfigStatus.
-func (in *KubernetesSecretEngineConfigStatus) DeepCopy() *KubernetesSecretEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSERole.
+func (in *KubeSERole) DeepCopy() *KubeSERole {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretEngineConfigStatus)
+	out := new(KubeSERole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretEngineRole) DeepCopyInto(out *KubernetesSecretEngineRole) {
+func (in *KubernetesAuthEngineConfig) DeepCopyInto(out *KubernetesAuthEngineConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2875,18 +3406,18 @@ func (in *KubernetesSecretEngineRole) DeepCopyInto(out *KubernetesSecretEngineRo
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineRole.
-func (in *KubernetesSecretEngineRole) DeepCopy() *KubernetesSecretEngineRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineConfig.
+func (in *KubernetesAuthEngineConfig) DeepCopy() *KubernetesAuthEngineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretEngineRole)
+	out := new(KubernetesAuthEngineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesSecretEngineRole) DeepCopyObject() runtime.Object {
+func (in *KubernetesAuthEngineConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2894,31 +3425,31 @@ func (in *KubernetesSecretEngineRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretEngineRoleList) DeepCopyInto(out *KubernetesSecretEngineRoleList) {
+func (in *KubernetesAuthEngineConfigList) DeepCopyInto(out *KubernetesAuthEngineConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KubernetesSecretEngineRole, len(*in))
+		*out = make([]KubernetesAuthEngineConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineRoleList.
-func (in *KubernetesSecretEngineRoleList) DeepCopy() *KubernetesSecretEngineRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineConfigList.
+func (in *KubernetesAuthEngineConfigList) DeepCopy() *KubernetesAuthEngineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretEngineRoleList)
+	out := new(KubernetesAuthEngineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesSecretEngineRoleList) DeepCopyObject() runtime.Object {
+func (in *KubernetesAuthEngineConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2926,7 +3457,7 @@ func (in *KubernetesSecretEngineRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretEngineRoleSpec) DeepCopyInto(out *KubernetesSecretEngineRoleSpec) {
+func (in *KubernetesAuthEngineConfigSpec) DeepCopyInto(out *KubernetesAuthEngineConfigSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -2934,22 +3465,26 @@ func (in *KubernetesSecretEngineRoleSpec) DeepCopyInto(out *KubernetesSecretEngi
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.TargetNamespaces.DeepCopyInto(&out.TargetNamespaces)
-	in.KubeSERole.DeepCopyInto(&out.KubeSERole)
+	in.KAECConfig.DeepCopyInto(&out.KAECConfig)
+	if in.TokenReviewerServiceAccount != nil {
+		in, out := &in.TokenReviewerServiceAccount, &out.TokenReviewerServiceAccount
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineRoleSpec.
-func (in *KubernetesSecretEngineRoleSpec) DeepCopy() *KubernetesSecretEngineRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineConfigSpec.
+func (in *KubernetesAuthEngineConfigSpec) DeepCopy() *KubernetesAuthEngineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretEngineRoleSpec)
+	out := new(KubernetesAuthEngineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretEngineRoleStatus) DeepCopyInto(out *KubernetesSecretEngineRoleStatus) {
+func (in *KubernetesAuthEngineConfigStatus) DeepCopyInto(out *KubernetesAuthEngineConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2958,20 +3493,25 @@ func (in *KubernetesSecretEngineRoleStatus) DeepCopyInto(out *KubernetesSecretEn
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineRoleStatus.
-func (in *KubernetesSecretEngineRoleStatus) DeepCopy() *KubernetesSecretEngineRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineConfigStatus.
+func (in *KubernetesAuthEngineConfigStatus) DeepCopy() *KubernetesAuthEngineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretEngineRoleStatus)
+	out := new(KubernetesAuthEngineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPAuthEngineConfig) DeepCopyInto(out *LDAPAuthEngineConfig) {
+func (in *KubernetesAuthEngineRole) DeepCopyInto(out *KubernetesAuthEngineRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2979,18 +3519,18 @@ func (in *LDAPAuthEngineConfig) DeepCopyInto(out *LDAPAuthEngineConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineConfig.
-func (in *LDAPAuthEngineConfig) DeepCopy() *LDAPAuthEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineRole.
+func (in *KubernetesAuthEngineRole) DeepCopy() *KubernetesAuthEngineRole {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPAuthEngineConfig)
+	out := new(KubernetesAuthEngineRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LDAPAuthEngineConfig) DeepCopyObject() runtime.Object {
+func (in *KubernetesAuthEngineRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2998,31 +3538,31 @@ func (in *LDAPAuthEngineConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPAuthEngineConfigList) DeepCopyInto(out *LDAPAuthEngineConfigList) {
+func (in *KubernetesAuthEngineRoleList) DeepCopyInto(out *KubernetesAuthEngineRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LDAPAuthEngineConfig, len(*in))
+		*out = make([]KubernetesAuthEngineRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineConfigList.
-func (in *LDAPAuthEngineConfigList) DeepCopy() *LDAPAuthEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineRoleList.
+func (in *KubernetesAuthEngineRoleList) DeepCopy() *KubernetesAuthEngineRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPAuthEngineConfigList)
+	out := new(KubernetesAuthEngineRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LDAPAuthEngineConfigList) DeepCopyObject() runtime.Object {
+func (in *KubernetesAuthEngineRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3030,7 +3570,7 @@ func (in *LDAPAuthEngineConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPAuthEngineConfigSpec) DeepCopyInto(out *LDAPAuthEngineConfigSpec) {
+func (in *KubernetesAuthEngineRoleSpec) DeepCopyInto(out *KubernetesAuthEngineRoleSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -3038,23 +3578,22 @@ func (in *LDAPAuthEngineConfigSpec) DeepCopyInto(out *LDAPAuthEngineConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	out.LDAPConfig = in.LDAPConfig
-	in.BindCredentials.DeepCopyInto(&out.BindCredentials)
-	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+	in.VRole.DeepCopyInto(&out.VRole)
+	in.TargetNamespaces.DeepCopyInto(&out.TargetNamespaces)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineConfigSpec.
-func (in *LDAPAuthEngineConfigSpec) DeepCopy() *LDAPAuthEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineRoleSpec.
+func (in *KubernetesAuthEngineRoleSpec) DeepCopy() *KubernetesAuthEngineRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPAuthEngineConfigSpec)
+	out := new(KubernetesAuthEngineRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPAuthEngineConfigStatus) DeepCopyInto(out *LDAPAuthEngineConfigStatus) {
+func (in *KubernetesAuthEngineRoleStatus) DeepCopyInto(out *KubernetesAuthEngineRoleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -3063,20 +3602,25 @@ func (in *LDAPAuthEngineConfigStatus) DeepCopyInto(out *LDAPAuthEngineConfigStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineConfigStatus.
-func (in *LDAPAuthEngineConfigStatus) DeepCopy() *LDAPAuthEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthEngineRoleStatus.
+func (in *KubernetesAuthEngineRoleStatus) DeepCopy() *KubernetesAuthEngineRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPAuthEngineConfigStatus)
+	out := new(KubernetesAuthEngineRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPAuthEngineGroup) DeepCopyInto(out *LDAPAuthEngineGroup) {
+func (in *KubernetesSecretEngineConfig) DeepCopyInto(out *KubernetesSecretEngineConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3084,18 +3628,18 @@ func (in *LDAPAuthEngineGroup) DeepCopyInto(out *LDAPAuthEngineGroup) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineGroup.
-func (in *LDAPAuthEngineGroup) DeepCopy() *LDAPAuthEngineGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineConfig.
+func (in *KubernetesSecretEngineConfig) DeepCopy() *KubernetesSecretEngineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPAuthEngineGroup)
+	out := new(KubernetesSecretEngineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LDAPAuthEngineGroup) DeepCopyObject() runtime.Object {
+func (in *KubernetesSecretEngineConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3103,31 +3647,31 @@ func (in *LDAPAuthEngineGroup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPAuthEngineGroupList) DeepCopyInto(out *LDAPAuthEngineGroupList) {
+func (in *KubernetesSecretEngineConfigList) DeepCopyInto(out *KubernetesSecretEngineConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LDAPAuthEngineGroup, len(*in))
+		*out = make([]KubernetesSecretEngineConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineGroupList.
-func (in *LDAPAuthEngineGroupList) DeepCopy() *LDAPAuthEngineGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineConfigList.
+func (in *KubernetesSecretEngineConfigList) DeepCopy() *KubernetesSecretEngineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPAuthEngineGroupList)
+	out := new(KubernetesSecretEngineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LDAPAuthEngineGroupList) DeepCopyObject() runtime.Object {
+func (in *KubernetesSecretEngineConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3135,7 +3679,7 @@ func (in *LDAPAuthEngineGroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPAuthEngineGroupSpec) DeepCopyInto(out *LDAPAuthEngineGroupSpec) {
+func (in *KubernetesSecretEngineConfigSpec) DeepCopyInto(out *KubernetesSecretEngineConfigSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -3143,20 +3687,22 @@ func (in *LDAPAuthEngineGroupSpec) DeepCopyInto(out *LDAPAuthEngineGroupSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.JWTReference.DeepCopyInto(&out.JWTReference)
+	out.KubeSEConfig = in.KubeSEConfig
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineGroupSpec.
-func (in *LDAPAuthEngineGroupSpec) DeepCopy() *LDAPAuthEngineGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineConfigSpec.
+func (in *KubernetesSecretEngineConfigSpec) DeepCopy() *KubernetesSecretEngineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPAuthEngineGroupSpec)
+	out := new(KubernetesSecretEngineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPAuthEngineGroupStatus) DeepCopyInto(out *LDAPAuthEngineGroupStatus) {
+func (in *KubernetesSecretEngineConfigStatus) DeepCopyInto(out *KubernetesSecretEngineConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -3165,250 +3711,244 @@ func (in *LDAPAuthEngineGroupStatus) DeepCopyInto(out *LDAPAuthEngineGroupStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineGroupStatus.
-func (in *LDAPAuthEngineGroupStatus) DeepCopy() *LDAPAuthEngineGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineConfigStatus.
+func (in *KubernetesSecretEngineConfigStatus) DeepCopy() *KubernetesSecretEngineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPAuthEngineGroupStatus)
+	out := new(KubernetesSecretEngineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPConfig) DeepCopyInto(out *LDAPConfig) {
+func (in *KubernetesSecretEngineRole) DeepCopyInto(out *KubernetesSecretEngineRole) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPConfig.
-func (in *LDAPConfig) DeepCopy() *LDAPConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineRole.
+func (in *KubernetesSecretEngineRole) DeepCopy() *KubernetesSecretEngineRole {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPConfig)
+	out := new(KubernetesSecretEngineRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesSecretEngineRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Mount) DeepCopyInto(out *Mount) {
+func (in *KubernetesSecretEngineRoleList) DeepCopyInto(out *KubernetesSecretEngineRoleList) {
 	*out = *in
-	in.Config.DeepCopyInto(&out.Config)
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesSecretEngineRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mount.
-func (in *Mount) DeepCopy() *Mount {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineRoleList.
+func (in *KubernetesSecretEngineRoleList) DeepCopy() *KubernetesSecretEngineRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(Mount)
+	out := new(KubernetesSecretEngineRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesSecretEngineRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MountConfig) DeepCopyInto(out *MountConfig) {
+func (in *KubernetesSecretEngineRoleSpec) DeepCopyInto(out *KubernetesSecretEngineRoleSpec) {
 	*out = *in
-	if in.AuditNonHMACRequestKeys != nil {
-		in, out := &in.AuditNonHMACRequestKeys, &out.AuditNonHMACRequestKeys
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AuditNonHMACResponseKeys != nil {
-		in, out := &in.AuditNonHMACResponseKeys, &out.AuditNonHMACResponseKeys
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.PassthroughRequestHeaders != nil {
-		in, out := &in.PassthroughRequestHeaders, &out.PassthroughRequestHeaders
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AllowedResponseHeaders != nil {
-		in, out := &in.AllowedResponseHeaders, &out.AllowedResponseHeaders
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
 	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.TargetNamespaces.DeepCopyInto(&out.TargetNamespaces)
+	in.KubeSERole.DeepCopyInto(&out.KubeSERole)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountConfig.
-func (in *MountConfig) DeepCopy() *MountConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineRoleSpec.
+func (in *KubernetesSecretEngineRoleSpec) DeepCopy() *KubernetesSecretEngineRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MountConfig)
+	out := new(KubernetesSecretEngineRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKICommon) DeepCopyInto(out *PKICommon) {
+func (in *KubernetesSecretEngineRoleStatus) DeepCopyInto(out *KubernetesSecretEngineRoleStatus) {
 	*out = *in
-	out.TTL = in.TTL
-	if in.PermittedDnsDomains != nil {
-		in, out := &in.PermittedDnsDomains, &out.PermittedDnsDomains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKICommon.
-func (in *PKICommon) DeepCopy() *PKICommon {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretEngineRoleStatus.
+func (in *KubernetesSecretEngineRoleStatus) DeepCopy() *KubernetesSecretEngineRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PKICommon)
+	out := new(KubernetesSecretEngineRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKIConfig) DeepCopyInto(out *PKIConfig) {
+func (in *LDAPAuthEngineConfig) DeepCopyInto(out *LDAPAuthEngineConfig) {
 	*out = *in
-	in.PKIConfigUrls.DeepCopyInto(&out.PKIConfigUrls)
-	out.PKIConfigCRL = in.PKIConfigCRL
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIConfig.
-func (in *PKIConfig) DeepCopy() *PKIConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineConfig.
+func (in *LDAPAuthEngineConfig) DeepCopy() *LDAPAuthEngineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(PKIConfig)
+	out := new(LDAPAuthEngineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPAuthEngineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKIConfigCRL) DeepCopyInto(out *PKIConfigCRL) {
+func (in *LDAPAuthEngineConfigList) DeepCopyInto(out *LDAPAuthEngineConfigList) {
 	*out = *in
-	out.CRLExpiry = in.CRLExpiry
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LDAPAuthEngineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIConfigCRL.
-func (in *PKIConfigCRL) DeepCopy() *PKIConfigCRL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineConfigList.
+func (in *LDAPAuthEngineConfigList) DeepCopy() *LDAPAuthEngineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(PKIConfigCRL)
+	out := new(LDAPAuthEngineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPAuthEngineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKIConfigUrls) DeepCopyInto(out *PKIConfigUrls) {
+func (in *LDAPAuthEngineConfigSpec) DeepCopyInto(out *LDAPAuthEngineConfigSpec) {
 	*out = *in
-	if in.IssuingCertificates != nil {
-		in, out := &in.IssuingCertificates, &out.IssuingCertificates
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.CRLDistributionPoints != nil {
-		in, out := &in.CRLDistributionPoints, &out.CRLDistributionPoints
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.OcspServers != nil {
-		in, out := &in.OcspServers, &out.OcspServers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
 	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	out.LDAPConfig = in.LDAPConfig
+	in.BindCredentials.DeepCopyInto(&out.BindCredentials)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIConfigUrls.
-func (in *PKIConfigUrls) DeepCopy() *PKIConfigUrls {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineConfigSpec.
+func (in *LDAPAuthEngineConfigSpec) DeepCopy() *LDAPAuthEngineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PKIConfigUrls)
+	out := new(LDAPAuthEngineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKIIntermediate) DeepCopyInto(out *PKIIntermediate) {
+func (in *LDAPAuthEngineConfigStatus) DeepCopyInto(out *LDAPAuthEngineConfigStatus) {
 	*out = *in
-	if in.ExternalSignSecret != nil {
-		in, out := &in.ExternalSignSecret, &out.ExternalSignSecret
-		*out = new(corev1.LocalObjectReference)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.InternalSign != nil {
-		in, out := &in.InternalSign, &out.InternalSign
-		*out = new(corev1.LocalObjectReference)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIIntermediate.
-func (in *PKIIntermediate) DeepCopy() *PKIIntermediate {
-	if in == nil {
-		return nil
-	}
-	out := new(PKIIntermediate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKIRole) DeepCopyInto(out *PKIRole) {
-	*out = *in
-	out.TTL = in.TTL
-	out.MaxTTL = in.MaxTTL
-	if in.AllowedDomains != nil {
-		in, out := &in.AllowedDomains, &out.AllowedDomains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AllowedURISans != nil {
-		in, out := &in.AllowedURISans, &out.AllowedURISans
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.KeyUsage != nil {
-		in, out := &in.KeyUsage, &out.KeyUsage
-		*out = make([]KeyUsage, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExtKeyUsage != nil {
-		in, out := &in.ExtKeyUsage, &out.ExtKeyUsage
-		*out = make([]ExtKeyUsage, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExtKeyUsageOids != nil {
-		in, out := &in.ExtKeyUsageOids, &out.ExtKeyUsageOids
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.PolicyIdentifiers != nil {
-		in, out := &in.PolicyIdentifiers, &out.PolicyIdentifiers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
 	}
-	out.NotBeforeDuration = in.NotBeforeDuration
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIRole.
-func (in *PKIRole) DeepCopy() *PKIRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineConfigStatus.
+func (in *LDAPAuthEngineConfigStatus) DeepCopy() *LDAPAuthEngineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PKIRole)
+	out := new(LDAPAuthEngineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKISecretEngineConfig) DeepCopyInto(out *PKISecretEngineConfig) {
+func (in *LDAPAuthEngineGroup) DeepCopyInto(out *LDAPAuthEngineGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3416,18 +3956,18 @@ func (in *PKISecretEngineConfig) DeepCopyInto(out *PKISecretEngineConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineConfig.
-func (in *PKISecretEngineConfig) DeepCopy() *PKISecretEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineGroup.
+func (in *LDAPAuthEngineGroup) DeepCopy() *LDAPAuthEngineGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(PKISecretEngineConfig)
+	out := new(LDAPAuthEngineGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PKISecretEngineConfig) DeepCopyObject() runtime.Object {
+func (in *LDAPAuthEngineGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3435,31 +3975,31 @@ func (in *PKISecretEngineConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKISecretEngineConfigList) DeepCopyInto(out *PKISecretEngineConfigList) {
+func (in *LDAPAuthEngineGroupList) DeepCopyInto(out *LDAPAuthEngineGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PKISecretEngineConfig, len(*in))
+		*out = make([]LDAPAuthEngineGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineConfigList.
-func (in *PKISecretEngineConfigList) DeepCopy() *PKISecretEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineGroupList.
+func (in *LDAPAuthEngineGroupList) DeepCopy() *LDAPAuthEngineGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(PKISecretEngineConfigList)
+	out := new(LDAPAuthEngineGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PKISecretEngineConfigList) DeepCopyObject() runtime.Object {
+func (in *LDAPAuthEngineGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3467,7 +4007,7 @@ func (in *PKISecretEngineConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKISecretEngineConfigSpec) DeepCopyInto(out *PKISecretEngineConfigSpec) {
+func (in *LDAPAuthEngineGroupSpec) DeepCopyInto(out *LDAPAuthEngineGroupSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -3475,24 +4015,20 @@ func (in *PKISecretEngineConfigSpec) DeepCopyInto(out *PKISecretEngineConfigSpec
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	out.PKIType = in.PKIType
-	in.PKICommon.DeepCopyInto(&out.PKICommon)
-	in.PKIConfig.DeepCopyInto(&out.PKIConfig)
-	in.PKIIntermediate.DeepCopyInto(&out.PKIIntermediate)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineConfigSpec.
-func (in *PKISecretEngineConfigSpec) DeepCopy() *PKISecretEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineGroupSpec.
+func (in *LDAPAuthEngineGroupSpec) DeepCopy() *LDAPAuthEngineGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PKISecretEngineConfigSpec)
+	out := new(LDAPAuthEngineGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKISecretEngineConfigStatus) DeepCopyInto(out *PKISecretEngineConfigStatus) {
+func (in *LDAPAuthEngineGroupStatus) DeepCopyInto(out *LDAPAuthEngineGroupStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -3501,20 +4037,112 @@ func (in *PKISecretEngineConfigStatus) DeepCopyInto(out *PKISecretEngineConfigSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineConfigStatus.
-func (in *PKISecretEngineConfigStatus) DeepCopy() *PKISecretEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthEngineGroupStatus.
+func (in *LDAPAuthEngineGroupStatus) DeepCopy() *LDAPAuthEngineGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PKISecretEngineConfigStatus)
+	out := new(LDAPAuthEngineGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKISecretEngineRole) DeepCopyInto(out *PKISecretEngineRole) {
+func (in *LDAPConfig) DeepCopyInto(out *LDAPConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPConfig.
+func (in *LDAPConfig) DeepCopy() *LDAPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPSEConfig) DeepCopyInto(out *LDAPSEConfig) {
+	*out = *in
+	out.ConnectionTimeout = in.ConnectionTimeout
+	out.RequestTimeout = in.RequestTimeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSEConfig.
+func (in *LDAPSEConfig) DeepCopy() *LDAPSEConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPSEConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPSEDynamicRole) DeepCopyInto(out *LDAPSEDynamicRole) {
+	*out = *in
+	out.DefaultTTL = in.DefaultTTL
+	out.MaxTTL = in.MaxTTL
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSEDynamicRole.
+func (in *LDAPSEDynamicRole) DeepCopy() *LDAPSEDynamicRole {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPSEDynamicRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPSELibrary) DeepCopyInto(out *LDAPSELibrary) {
+	*out = *in
+	if in.ServiceAccountNames != nil {
+		in, out := &in.ServiceAccountNames, &out.ServiceAccountNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.TTL = in.TTL
+	out.MaxTTL = in.MaxTTL
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSELibrary.
+func (in *LDAPSELibrary) DeepCopy() *LDAPSELibrary {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPSELibrary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPSEStaticRole) DeepCopyInto(out *LDAPSEStaticRole) {
+	*out = *in
+	out.RotationPeriod = in.RotationPeriod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSEStaticRole.
+func (in *LDAPSEStaticRole) DeepCopy() *LDAPSEStaticRole {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPSEStaticRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPSecretEngineConfig) DeepCopyInto(out *LDAPSecretEngineConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3522,18 +4150,18 @@ func (in *PKISecretEngineRole) DeepCopyInto(out *PKISecretEngineRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineRole.
-func (in *PKISecretEngineRole) DeepCopy() *PKISecretEngineRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineConfig.
+func (in *LDAPSecretEngineConfig) DeepCopy() *LDAPSecretEngineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(PKISecretEngineRole)
+	out := new(LDAPSecretEngineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PKISecretEngineRole) DeepCopyObject() runtime.Object {
+func (in *LDAPSecretEngineConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3541,31 +4169,31 @@ func (in *PKISecretEngineRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKISecretEngineRoleList) DeepCopyInto(out *PKISecretEngineRoleList) {
+func (in *LDAPSecretEngineConfigList) DeepCopyInto(out *LDAPSecretEngineConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PKISecretEngineRole, len(*in))
+		*out = make([]LDAPSecretEngineConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineRoleList.
-func (in *PKISecretEngineRoleList) DeepCopy() *PKISecretEngineRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineConfigList.
+func (in *LDAPSecretEngineConfigList) DeepCopy() *LDAPSecretEngineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(PKISecretEngineRoleList)
+	out := new(LDAPSecretEngineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PKISecretEngineRoleList) DeepCopyObject() runtime.Object {
+func (in *LDAPSecretEngineConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3573,7 +4201,7 @@ func (in *PKISecretEngineRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKISecretEngineRoleSpec) DeepCopyInto(out *PKISecretEngineRoleSpec) {
+func (in *LDAPSecretEngineConfigSpec) DeepCopyInto(out *LDAPSecretEngineConfigSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -3581,21 +4209,23 @@ func (in *PKISecretEngineRoleSpec) DeepCopyInto(out *PKISecretEngineRoleSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.PKIRole.DeepCopyInto(&out.PKIRole)
+	out.LDAPSEConfig = in.LDAPSEConfig
+	in.BindCredentials.DeepCopyInto(&out.BindCredentials)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineRoleSpec.
-func (in *PKISecretEngineRoleSpec) DeepCopy() *PKISecretEngineRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineConfigSpec.
+func (in *LDAPSecretEngineConfigSpec) DeepCopy() *LDAPSecretEngineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PKISecretEngineRoleSpec)
+	out := new(LDAPSecretEngineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKISecretEngineRoleStatus) DeepCopyInto(out *PKISecretEngineRoleStatus) {
+func (in *LDAPSecretEngineConfigStatus) DeepCopyInto(out *LDAPSecretEngineConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -3604,69 +4234,76 @@ func (in *PKISecretEngineRoleStatus) DeepCopyInto(out *PKISecretEngineRoleStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineRoleStatus.
-func (in *PKISecretEngineRoleStatus) DeepCopy() *PKISecretEngineRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineConfigStatus.
+func (in *LDAPSecretEngineConfigStatus) DeepCopy() *LDAPSecretEngineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PKISecretEngineRoleStatus)
+	out := new(LDAPSecretEngineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKIType) DeepCopyInto(out *PKIType) {
+func (in *LDAPSecretEngineDynamicRole) DeepCopyInto(out *LDAPSecretEngineDynamicRole) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIType.
-func (in *PKIType) DeepCopy() *PKIType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineDynamicRole.
+func (in *LDAPSecretEngineDynamicRole) DeepCopy() *LDAPSecretEngineDynamicRole {
 	if in == nil {
 		return nil
 	}
-	out := new(PKIType)
+	out := new(LDAPSecretEngineDynamicRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordCredentialConfig) DeepCopyInto(out *PasswordCredentialConfig) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordCredentialConfig.
-func (in *PasswordCredentialConfig) DeepCopy() *PasswordCredentialConfig {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPSecretEngineDynamicRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(PasswordCredentialConfig)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordPolicy) DeepCopyInto(out *PasswordPolicy) {
+func (in *LDAPSecretEngineDynamicRoleList) DeepCopyInto(out *LDAPSecretEngineDynamicRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LDAPSecretEngineDynamicRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicy.
-func (in *PasswordPolicy) DeepCopy() *PasswordPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineDynamicRoleList.
+func (in *LDAPSecretEngineDynamicRoleList) DeepCopy() *LDAPSecretEngineDynamicRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordPolicy)
+	out := new(LDAPSecretEngineDynamicRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PasswordPolicy) DeepCopyObject() runtime.Object {
+func (in *LDAPSecretEngineDynamicRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3674,51 +4311,75 @@ func (in *PasswordPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordPolicyFormat) DeepCopyInto(out *PasswordPolicyFormat) {
+func (in *LDAPSecretEngineDynamicRoleSpec) DeepCopyInto(out *LDAPSecretEngineDynamicRoleSpec) {
 	*out = *in
-	if in.Rules != nil {
-		in, out := &in.Rules, &out.Rules
-		*out = make([]PasswordPolicyRule, len(*in))
-		copy(*out, *in)
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
 	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	out.LDAPSEDynamicRole = in.LDAPSEDynamicRole
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyFormat.
-func (in *PasswordPolicyFormat) DeepCopy() *PasswordPolicyFormat {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineDynamicRoleSpec.
+func (in *LDAPSecretEngineDynamicRoleSpec) DeepCopy() *LDAPSecretEngineDynamicRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordPolicyFormat)
+	out := new(LDAPSecretEngineDynamicRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordPolicyList) DeepCopyInto(out *PasswordPolicyList) {
+func (in *LDAPSecretEngineDynamicRoleStatus) DeepCopyInto(out *LDAPSecretEngineDynamicRoleStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PasswordPolicy, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyList.
-func (in *PasswordPolicyList) DeepCopy() *PasswordPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineDynamicRoleStatus.
+func (in *LDAPSecretEngineDynamicRoleStatus) DeepCopy() *LDAPSecretEngineDynamicRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordPolicyList)
+	out := new(LDAPSecretEngineDynamicRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPSecretEngineLibrary) DeepCopyInto(out *LDAPSecretEngineLibrary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineLibrary.
+func (in *LDAPSecretEngineLibrary) DeepCopy() *LDAPSecretEngineLibrary {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPSecretEngineLibrary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PasswordPolicyList) DeepCopyObject() runtime.Object {
+func (in *LDAPSecretEngineLibrary) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3726,22 +4387,39 @@ func (in *PasswordPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordPolicyRule) DeepCopyInto(out *PasswordPolicyRule) {
+func (in *LDAPSecretEngineLibraryList) DeepCopyInto(out *LDAPSecretEngineLibraryList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LDAPSecretEngineLibrary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyRule.
-func (in *PasswordPolicyRule) DeepCopy() *PasswordPolicyRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineLibraryList.
+func (in *LDAPSecretEngineLibraryList) DeepCopy() *LDAPSecretEngineLibraryList {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordPolicyRule)
+	out := new(LDAPSecretEngineLibraryList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPSecretEngineLibraryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordPolicySpec) DeepCopyInto(out *PasswordPolicySpec) {
+func (in *LDAPSecretEngineLibrarySpec) DeepCopyInto(out *LDAPSecretEngineLibrarySpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -3749,20 +4427,21 @@ func (in *PasswordPolicySpec) DeepCopyInto(out *PasswordPolicySpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.LDAPSELibrary.DeepCopyInto(&out.LDAPSELibrary)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicySpec.
-func (in *PasswordPolicySpec) DeepCopy() *PasswordPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineLibrarySpec.
+func (in *LDAPSecretEngineLibrarySpec) DeepCopy() *LDAPSecretEngineLibrarySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordPolicySpec)
+	out := new(LDAPSecretEngineLibrarySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordPolicyStatus) DeepCopyInto(out *PasswordPolicyStatus) {
+func (in *LDAPSecretEngineLibraryStatus) DeepCopyInto(out *LDAPSecretEngineLibraryStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -3771,52 +4450,25 @@ func (in *PasswordPolicyStatus) DeepCopyInto(out *PasswordPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyStatus.
-func (in *PasswordPolicyStatus) DeepCopy() *PasswordPolicyStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(PasswordPolicyStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PermissionSet) DeepCopyInto(out *PermissionSet) {
-	*out = *in
-	if in.Repositories != nil {
-		in, out := &in.Repositories, &out.Repositories
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.RepositoriesIDs != nil {
-		in, out := &in.RepositoriesIDs, &out.RepositoriesIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Permissions != nil {
-		in, out := &in.Permissions, &out.Permissions
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSet.
-func (in *PermissionSet) DeepCopy() *PermissionSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineLibraryStatus.
+func (in *LDAPSecretEngineLibraryStatus) DeepCopy() *LDAPSecretEngineLibraryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PermissionSet)
+	out := new(LDAPSecretEngineLibraryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Policy) DeepCopyInto(out *Policy) {
+func (in *LDAPSecretEngineStaticRole) DeepCopyInto(out *LDAPSecretEngineStaticRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3824,18 +4476,18 @@ func (in *Policy) DeepCopyInto(out *Policy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
-func (in *Policy) DeepCopy() *Policy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineStaticRole.
+func (in *LDAPSecretEngineStaticRole) DeepCopy() *LDAPSecretEngineStaticRole {
 	if in == nil {
 		return nil
 	}
-	out := new(Policy)
+	out := new(LDAPSecretEngineStaticRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Policy) DeepCopyObject() runtime.Object {
+func (in *LDAPSecretEngineStaticRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3843,31 +4495,31 @@ func (in *Policy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+func (in *LDAPSecretEngineStaticRoleList) DeepCopyInto(out *LDAPSecretEngineStaticRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Policy, len(*in))
+		*out = make([]LDAPSecretEngineStaticRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
-func (in *PolicyList) DeepCopy() *PolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineStaticRoleList.
+func (in *LDAPSecretEngineStaticRoleList) DeepCopy() *LDAPSecretEngineStaticRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyList)
+	out := new(LDAPSecretEngineStaticRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PolicyList) DeepCopyObject() runtime.Object {
+func (in *LDAPSecretEngineStaticRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3875,7 +4527,7 @@ func (in *PolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+func (in *LDAPSecretEngineStaticRoleSpec) DeepCopyInto(out *LDAPSecretEngineStaticRoleSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -3883,20 +4535,21 @@ func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
+	out.LDAPSEStaticRole = in.LDAPSEStaticRole
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
-func (in *PolicySpec) DeepCopy() *PolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineStaticRoleSpec.
+func (in *LDAPSecretEngineStaticRoleSpec) DeepCopy() *LDAPSecretEngineStaticRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicySpec)
+	out := new(LDAPSecretEngineStaticRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+func (in *LDAPSecretEngineStaticRoleStatus) DeepCopyInto(out *LDAPSecretEngineStaticRoleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -3905,108 +4558,83 @@ func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
-func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecretEngineStaticRoleStatus.
+func (in *LDAPSecretEngineStaticRoleStatus) DeepCopy() *LDAPSecretEngineStaticRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyStatus)
+	out := new(LDAPSecretEngineStaticRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuayBaseRole) DeepCopyInto(out *QuayBaseRole) {
+func (in *Mount) DeepCopyInto(out *Mount) {
 	*out = *in
-	if in.CreateRepositories != nil {
-		in, out := &in.CreateRepositories, &out.CreateRepositories
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Teams != nil {
-		in, out := &in.Teams, &out.Teams
-		*out = new(map[string]TeamRole)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(map[string]TeamRole, len(*in))
-			for key, val := range *in {
-				(*out)[key] = val
-			}
-		}
-	}
-	if in.Repositories != nil {
-		in, out := &in.Repositories, &out.Repositories
-		*out = new(map[string]Permission)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(map[string]Permission, len(*in))
-			for key, val := range *in {
-				(*out)[key] = val
-			}
+	in.Config.DeepCopyInto(&out.Config)
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
-	if in.DefaultPermission != nil {
-		in, out := &in.DefaultPermission, &out.DefaultPermission
-		*out = new(Permission)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayBaseRole.
-func (in *QuayBaseRole) DeepCopy() *QuayBaseRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mount.
+func (in *Mount) DeepCopy() *Mount {
 	if in == nil {
 		return nil
 	}
-	out := new(QuayBaseRole)
+	out := new(Mount)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuayConfig) DeepCopyInto(out *QuayConfig) {
+func (in *MountConfig) DeepCopyInto(out *MountConfig) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayConfig.
-func (in *QuayConfig) DeepCopy() *QuayConfig {
-	if in == nil {
-		return nil
+	if in.AuditNonHMACRequestKeys != nil {
+		in, out := &in.AuditNonHMACRequestKeys, &out.AuditNonHMACRequestKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(QuayConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuayRole) DeepCopyInto(out *QuayRole) {
-	*out = *in
-	in.QuayBaseRole.DeepCopyInto(&out.QuayBaseRole)
-	if in.TTL != nil {
-		in, out := &in.TTL, &out.TTL
-		*out = new(v1.Duration)
-		**out = **in
+	if in.AuditNonHMACResponseKeys != nil {
+		in, out := &in.AuditNonHMACResponseKeys, &out.AuditNonHMACResponseKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.MaxTTL != nil {
-		in, out := &in.MaxTTL, &out.MaxTTL
-		*out = new(v1.Duration)
-		**out = **in
+	if in.PassthroughRequestHeaders != nil {
+		in, out := &in.PassthroughRequestHeaders, &out.PassthroughRequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedResponseHeaders != nil {
+		in, out := &in.AllowedResponseHeaders, &out.AllowedResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRole.
-func (in *QuayRole) DeepCopy() *QuayRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountConfig.
+func (in *MountConfig) DeepCopy() *MountConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(QuayRole)
+	out := new(MountConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineConfig) DeepCopyInto(out *QuaySecretEngineConfig) {
+func (in *NamespacedVaultConnection) DeepCopyInto(out *NamespacedVaultConnection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -4014,18 +4642,18 @@ func (in *QuaySecretEngineConfig) DeepCopyInto(out *QuaySecretEngineConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineConfig.
-func (in *QuaySecretEngineConfig) DeepCopy() *QuaySecretEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedVaultConnection.
+func (in *NamespacedVaultConnection) DeepCopy() *NamespacedVaultConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineConfig)
+	out := new(NamespacedVaultConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuaySecretEngineConfig) DeepCopyObject() runtime.Object {
+func (in *NamespacedVaultConnection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4033,31 +4661,31 @@ func (in *QuaySecretEngineConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineConfigList) DeepCopyInto(out *QuaySecretEngineConfigList) {
+func (in *NamespacedVaultConnectionList) DeepCopyInto(out *NamespacedVaultConnectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]QuaySecretEngineConfig, len(*in))
+		*out = make([]NamespacedVaultConnection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineConfigList.
-func (in *QuaySecretEngineConfigList) DeepCopy() *QuaySecretEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedVaultConnectionList.
+func (in *NamespacedVaultConnectionList) DeepCopy() *NamespacedVaultConnectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineConfigList)
+	out := new(NamespacedVaultConnectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuaySecretEngineConfigList) DeepCopyObject() runtime.Object {
+func (in *NamespacedVaultConnectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4065,52 +4693,1677 @@ func (in *QuaySecretEngineConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineConfigSpec) DeepCopyInto(out *QuaySecretEngineConfigSpec) {
+func (in *NamespacedVaultConnectionSpec) DeepCopyInto(out *NamespacedVaultConnectionSpec) {
 	*out = *in
-	if in.Connection != nil {
-		in, out := &in.Connection, &out.Connection
-		*out = new(utils.VaultConnection)
+	in.VaultConnectionSettings.DeepCopyInto(&out.VaultConnectionSettings)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedVaultConnectionSpec.
+func (in *NamespacedVaultConnectionSpec) DeepCopy() *NamespacedVaultConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedVaultConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputEncoder) DeepCopyInto(out *OutputEncoder) {
+	*out = *in
+	if in.PKCS12 != nil {
+		in, out := &in.PKCS12, &out.PKCS12
+		*out = new(KeyStoreEncoder)
+		**out = **in
+	}
+	if in.JKS != nil {
+		in, out := &in.JKS, &out.JKS
+		*out = new(KeyStoreEncoder)
+		**out = **in
+	}
+	if in.DockerConfigJSON != nil {
+		in, out := &in.DockerConfigJSON, &out.DockerConfigJSON
+		*out = new(DockerConfigJSONEncoder)
 		(*in).DeepCopyInto(*out)
 	}
-	in.Authentication.DeepCopyInto(&out.Authentication)
-	out.QuayConfig = in.QuayConfig
-	in.RootCredentials.DeepCopyInto(&out.RootCredentials)
+	if in.Dotenv != nil {
+		in, out := &in.Dotenv, &out.Dotenv
+		*out = new(DotenvEncoder)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputEncoder.
+func (in *OutputEncoder) DeepCopy() *OutputEncoder {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputEncoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKICommon) DeepCopyInto(out *PKICommon) {
+	*out = *in
+	out.TTL = in.TTL
+	if in.PermittedDnsDomains != nil {
+		in, out := &in.PermittedDnsDomains, &out.PermittedDnsDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKICommon.
+func (in *PKICommon) DeepCopy() *PKICommon {
+	if in == nil {
+		return nil
+	}
+	out := new(PKICommon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKIConfig) DeepCopyInto(out *PKIConfig) {
+	*out = *in
+	in.PKIConfigUrls.DeepCopyInto(&out.PKIConfigUrls)
+	out.PKIConfigCRL = in.PKIConfigCRL
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIConfig.
+func (in *PKIConfig) DeepCopy() *PKIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PKIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKIConfigCRL) DeepCopyInto(out *PKIConfigCRL) {
+	*out = *in
+	out.CRLExpiry = in.CRLExpiry
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIConfigCRL.
+func (in *PKIConfigCRL) DeepCopy() *PKIConfigCRL {
+	if in == nil {
+		return nil
+	}
+	out := new(PKIConfigCRL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKIConfigUrls) DeepCopyInto(out *PKIConfigUrls) {
+	*out = *in
+	if in.IssuingCertificates != nil {
+		in, out := &in.IssuingCertificates, &out.IssuingCertificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CRLDistributionPoints != nil {
+		in, out := &in.CRLDistributionPoints, &out.CRLDistributionPoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OcspServers != nil {
+		in, out := &in.OcspServers, &out.OcspServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIConfigUrls.
+func (in *PKIConfigUrls) DeepCopy() *PKIConfigUrls {
+	if in == nil {
+		return nil
+	}
+	out := new(PKIConfigUrls)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKIIntermediate) DeepCopyInto(out *PKIIntermediate) {
+	*out = *in
+	if in.ExternalSignSecret != nil {
+		in, out := &in.ExternalSignSecret, &out.ExternalSignSecret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.InternalSign != nil {
+		in, out := &in.InternalSign, &out.InternalSign
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIIntermediate.
+func (in *PKIIntermediate) DeepCopy() *PKIIntermediate {
+	if in == nil {
+		return nil
+	}
+	out := new(PKIIntermediate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKIRole) DeepCopyInto(out *PKIRole) {
+	*out = *in
+	out.TTL = in.TTL
+	out.MaxTTL = in.MaxTTL
+	if in.AllowedDomains != nil {
+		in, out := &in.AllowedDomains, &out.AllowedDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedURISans != nil {
+		in, out := &in.AllowedURISans, &out.AllowedURISans
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyUsage != nil {
+		in, out := &in.KeyUsage, &out.KeyUsage
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtKeyUsage != nil {
+		in, out := &in.ExtKeyUsage, &out.ExtKeyUsage
+		*out = make([]ExtKeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtKeyUsageOids != nil {
+		in, out := &in.ExtKeyUsageOids, &out.ExtKeyUsageOids
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyIdentifiers != nil {
+		in, out := &in.PolicyIdentifiers, &out.PolicyIdentifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.NotBeforeDuration = in.NotBeforeDuration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIRole.
+func (in *PKIRole) DeepCopy() *PKIRole {
+	if in == nil {
+		return nil
+	}
+	out := new(PKIRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKISecretEngineConfig) DeepCopyInto(out *PKISecretEngineConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineConfig.
+func (in *PKISecretEngineConfig) DeepCopy() *PKISecretEngineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PKISecretEngineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PKISecretEngineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKISecretEngineConfigList) DeepCopyInto(out *PKISecretEngineConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PKISecretEngineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineConfigList.
+func (in *PKISecretEngineConfigList) DeepCopy() *PKISecretEngineConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(PKISecretEngineConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PKISecretEngineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKISecretEngineConfigSpec) DeepCopyInto(out *PKISecretEngineConfigSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	out.PKIType = in.PKIType
+	in.PKICommon.DeepCopyInto(&out.PKICommon)
+	in.PKIConfig.DeepCopyInto(&out.PKIConfig)
+	in.PKIIntermediate.DeepCopyInto(&out.PKIIntermediate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineConfigSpec.
+func (in *PKISecretEngineConfigSpec) DeepCopy() *PKISecretEngineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PKISecretEngineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKISecretEngineConfigStatus) DeepCopyInto(out *PKISecretEngineConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineConfigStatus.
+func (in *PKISecretEngineConfigStatus) DeepCopy() *PKISecretEngineConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PKISecretEngineConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKISecretEngineRole) DeepCopyInto(out *PKISecretEngineRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineRole.
+func (in *PKISecretEngineRole) DeepCopy() *PKISecretEngineRole {
+	if in == nil {
+		return nil
+	}
+	out := new(PKISecretEngineRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PKISecretEngineRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKISecretEngineRoleList) DeepCopyInto(out *PKISecretEngineRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PKISecretEngineRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineRoleList.
+func (in *PKISecretEngineRoleList) DeepCopy() *PKISecretEngineRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(PKISecretEngineRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PKISecretEngineRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKISecretEngineRoleSpec) DeepCopyInto(out *PKISecretEngineRoleSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.PKIRole.DeepCopyInto(&out.PKIRole)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineRoleSpec.
+func (in *PKISecretEngineRoleSpec) DeepCopy() *PKISecretEngineRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PKISecretEngineRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKISecretEngineRoleStatus) DeepCopyInto(out *PKISecretEngineRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKISecretEngineRoleStatus.
+func (in *PKISecretEngineRoleStatus) DeepCopy() *PKISecretEngineRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PKISecretEngineRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKIType) DeepCopyInto(out *PKIType) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKIType.
+func (in *PKIType) DeepCopy() *PKIType {
+	if in == nil {
+		return nil
+	}
+	out := new(PKIType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordCredentialConfig) DeepCopyInto(out *PasswordCredentialConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordCredentialConfig.
+func (in *PasswordCredentialConfig) DeepCopy() *PasswordCredentialConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordCredentialConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicy) DeepCopyInto(out *PasswordPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicy.
+func (in *PasswordPolicy) DeepCopy() *PasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PasswordPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyFormat) DeepCopyInto(out *PasswordPolicyFormat) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PasswordPolicyRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyFormat.
+func (in *PasswordPolicyFormat) DeepCopy() *PasswordPolicyFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyList) DeepCopyInto(out *PasswordPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PasswordPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyList.
+func (in *PasswordPolicyList) DeepCopy() *PasswordPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PasswordPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyRule) DeepCopyInto(out *PasswordPolicyRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyRule.
+func (in *PasswordPolicyRule) DeepCopy() *PasswordPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicySpec) DeepCopyInto(out *PasswordPolicySpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicySpec.
+func (in *PasswordPolicySpec) DeepCopy() *PasswordPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyStatus) DeepCopyInto(out *PasswordPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyStatus.
+func (in *PasswordPolicyStatus) DeepCopy() *PasswordPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSet) DeepCopyInto(out *PermissionSet) {
+	*out = *in
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RepositoriesIDs != nil {
+		in, out := &in.RepositoriesIDs, &out.RepositoriesIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSet.
+func (in *PermissionSet) DeepCopy() *PermissionSet {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
+func (in *PolicyList) DeepCopy() *PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
+func (in *PolicySpec) DeepCopy() *PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayBaseRole) DeepCopyInto(out *QuayBaseRole) {
+	*out = *in
+	if in.CreateRepositories != nil {
+		in, out := &in.CreateRepositories, &out.CreateRepositories
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = new(map[string]TeamRole)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]TeamRole, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = new(map[string]Permission)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]Permission, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	if in.DefaultPermission != nil {
+		in, out := &in.DefaultPermission, &out.DefaultPermission
+		*out = new(Permission)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayBaseRole.
+func (in *QuayBaseRole) DeepCopy() *QuayBaseRole {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayBaseRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayConfig) DeepCopyInto(out *QuayConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayConfig.
+func (in *QuayConfig) DeepCopy() *QuayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRole) DeepCopyInto(out *QuayRole) {
+	*out = *in
+	in.QuayBaseRole.DeepCopyInto(&out.QuayBaseRole)
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRole.
+func (in *QuayRole) DeepCopy() *QuayRole {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineConfig) DeepCopyInto(out *QuaySecretEngineConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineConfig.
+func (in *QuaySecretEngineConfig) DeepCopy() *QuaySecretEngineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuaySecretEngineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineConfigList) DeepCopyInto(out *QuaySecretEngineConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuaySecretEngineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineConfigList.
+func (in *QuaySecretEngineConfigList) DeepCopy() *QuaySecretEngineConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuaySecretEngineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineConfigSpec) DeepCopyInto(out *QuaySecretEngineConfigSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	out.QuayConfig = in.QuayConfig
+	in.RootCredentials.DeepCopyInto(&out.RootCredentials)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineConfigSpec.
+func (in *QuaySecretEngineConfigSpec) DeepCopy() *QuaySecretEngineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineConfigStatus) DeepCopyInto(out *QuaySecretEngineConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineConfigStatus.
+func (in *QuaySecretEngineConfigStatus) DeepCopy() *QuaySecretEngineConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineRole) DeepCopyInto(out *QuaySecretEngineRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineRole.
+func (in *QuaySecretEngineRole) DeepCopy() *QuaySecretEngineRole {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuaySecretEngineRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineRoleList) DeepCopyInto(out *QuaySecretEngineRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuaySecretEngineRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineRoleList.
+func (in *QuaySecretEngineRoleList) DeepCopy() *QuaySecretEngineRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuaySecretEngineRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineRoleSpec) DeepCopyInto(out *QuaySecretEngineRoleSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.QuayRole.DeepCopyInto(&out.QuayRole)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineRoleSpec.
+func (in *QuaySecretEngineRoleSpec) DeepCopy() *QuaySecretEngineRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineRoleStatus) DeepCopyInto(out *QuaySecretEngineRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineRoleStatus.
+func (in *QuaySecretEngineRoleStatus) DeepCopy() *QuaySecretEngineRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineStaticRole) DeepCopyInto(out *QuaySecretEngineStaticRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineStaticRole.
+func (in *QuaySecretEngineStaticRole) DeepCopy() *QuaySecretEngineStaticRole {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineStaticRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuaySecretEngineStaticRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineStaticRoleList) DeepCopyInto(out *QuaySecretEngineStaticRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuaySecretEngineStaticRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineStaticRoleList.
+func (in *QuaySecretEngineStaticRoleList) DeepCopy() *QuaySecretEngineStaticRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineStaticRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuaySecretEngineStaticRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineStaticRoleSpec) DeepCopyInto(out *QuaySecretEngineStaticRoleSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.QuayBaseRole.DeepCopyInto(&out.QuayBaseRole)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineStaticRoleSpec.
+func (in *QuaySecretEngineStaticRoleSpec) DeepCopy() *QuaySecretEngineStaticRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineStaticRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuaySecretEngineStaticRoleStatus) DeepCopyInto(out *QuaySecretEngineStaticRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineStaticRoleStatus.
+func (in *QuaySecretEngineStaticRoleStatus) DeepCopy() *QuaySecretEngineStaticRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuaySecretEngineStaticRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RMQSEConfig) DeepCopyInto(out *RMQSEConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RMQSEConfig.
+func (in *RMQSEConfig) DeepCopy() *RMQSEConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RMQSEConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RMQSERole) DeepCopyInto(out *RMQSERole) {
+	*out = *in
+	if in.Vhosts != nil {
+		in, out := &in.Vhosts, &out.Vhosts
+		*out = make([]Vhost, len(*in))
+		copy(*out, *in)
+	}
+	if in.VhostTopics != nil {
+		in, out := &in.VhostTopics, &out.VhostTopics
+		*out = make([]VhostTopic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RMQSERole.
+func (in *RMQSERole) DeepCopy() *RMQSERole {
+	if in == nil {
+		return nil
+	}
+	out := new(RMQSERole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RSAPrivateKeyCredentialConfig) DeepCopyInto(out *RSAPrivateKeyCredentialConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAPrivateKeyCredentialConfig.
+func (in *RSAPrivateKeyCredentialConfig) DeepCopy() *RSAPrivateKeyCredentialConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RSAPrivateKeyCredentialConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQSecretEngineConfig) DeepCopyInto(out *RabbitMQSecretEngineConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineConfig.
+func (in *RabbitMQSecretEngineConfig) DeepCopy() *RabbitMQSecretEngineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQSecretEngineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RabbitMQSecretEngineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQSecretEngineConfigList) DeepCopyInto(out *RabbitMQSecretEngineConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RabbitMQSecretEngineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineConfigList.
+func (in *RabbitMQSecretEngineConfigList) DeepCopy() *RabbitMQSecretEngineConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQSecretEngineConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RabbitMQSecretEngineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQSecretEngineConfigSpec) DeepCopyInto(out *RabbitMQSecretEngineConfigSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	out.RMQSEConfig = in.RMQSEConfig
+	in.RootCredentials.DeepCopyInto(&out.RootCredentials)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineConfigSpec.
+func (in *RabbitMQSecretEngineConfigSpec) DeepCopy() *RabbitMQSecretEngineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQSecretEngineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQSecretEngineConfigStatus) DeepCopyInto(out *RabbitMQSecretEngineConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineConfigStatus.
+func (in *RabbitMQSecretEngineConfigStatus) DeepCopy() *RabbitMQSecretEngineConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQSecretEngineConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQSecretEngineRole) DeepCopyInto(out *RabbitMQSecretEngineRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineRole.
+func (in *RabbitMQSecretEngineRole) DeepCopy() *RabbitMQSecretEngineRole {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQSecretEngineRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RabbitMQSecretEngineRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQSecretEngineRoleList) DeepCopyInto(out *RabbitMQSecretEngineRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RabbitMQSecretEngineRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineRoleList.
+func (in *RabbitMQSecretEngineRoleList) DeepCopy() *RabbitMQSecretEngineRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQSecretEngineRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RabbitMQSecretEngineRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQSecretEngineRoleSpec) DeepCopyInto(out *RabbitMQSecretEngineRoleSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.RMQSERole.DeepCopyInto(&out.RMQSERole)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineRoleSpec.
+func (in *RabbitMQSecretEngineRoleSpec) DeepCopy() *RabbitMQSecretEngineRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQSecretEngineRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQSecretEngineRoleStatus) DeepCopyInto(out *RabbitMQSecretEngineRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineRoleStatus.
+func (in *RabbitMQSecretEngineRoleStatus) DeepCopy() *RabbitMQSecretEngineRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQSecretEngineRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomSecret) DeepCopyInto(out *RandomSecret) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomSecret.
+func (in *RandomSecret) DeepCopy() *RandomSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(RandomSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RandomSecret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomSecretList) DeepCopyInto(out *RandomSecretList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RandomSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomSecretList.
+func (in *RandomSecretList) DeepCopy() *RandomSecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(RandomSecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RandomSecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomSecretSpec) DeepCopyInto(out *RandomSecretSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(utils.VaultConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	out.SecretFormat = in.SecretFormat
+	if in.RefreshPeriod != nil {
+		in, out := &in.RefreshPeriod, &out.RefreshPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomSecretSpec.
+func (in *RandomSecretSpec) DeepCopy() *RandomSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RandomSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomSecretStatus) DeepCopyInto(out *RandomSecretStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastVaultSecretUpdate != nil {
+		in, out := &in.LastVaultSecretUpdate, &out.LastVaultSecretUpdate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomSecretStatus.
+func (in *RandomSecretStatus) DeepCopy() *RandomSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RandomSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutTarget) DeepCopyInto(out *RolloutTarget) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTarget.
+func (in *RolloutTarget) DeepCopy() *RolloutTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RootPasswordRotation) DeepCopyInto(out *RootPasswordRotation) {
+	*out = *in
+	out.RotationPeriod = in.RotationPeriod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootPasswordRotation.
+func (in *RootPasswordRotation) DeepCopy() *RootPasswordRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(RootPasswordRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCAConfig) DeepCopyInto(out *SSHCAConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCAConfig.
+func (in *SSHCAConfig) DeepCopy() *SSHCAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKeyConfig) DeepCopyInto(out *SSHKeyConfig) {
+	*out = *in
+	if in.VaultSecret != nil {
+		in, out := &in.VaultSecret, &out.VaultSecret
+		*out = new(utils.VaultSecretReference)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineConfigSpec.
-func (in *QuaySecretEngineConfigSpec) DeepCopy() *QuaySecretEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyConfig.
+func (in *SSHKeyConfig) DeepCopy() *SSHKeyConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineConfigSpec)
+	out := new(SSHKeyConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineConfigStatus) DeepCopyInto(out *QuaySecretEngineConfigStatus) {
+func (in *SSHRole) DeepCopyInto(out *SSHRole) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.AllowedUsers != nil {
+		in, out := &in.AllowedUsers, &out.AllowedUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedDomains != nil {
+		in, out := &in.AllowedDomains, &out.AllowedDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRList != nil {
+		in, out := &in.CIDRList, &out.CIDRList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeCIDRList != nil {
+		in, out := &in.ExcludeCIDRList, &out.ExcludeCIDRList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.TTL = in.TTL
+	out.MaxTTL = in.MaxTTL
+	if in.AllowedCriticalOptions != nil {
+		in, out := &in.AllowedCriticalOptions, &out.AllowedCriticalOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedExtensions != nil {
+		in, out := &in.AllowedExtensions, &out.AllowedExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultCriticalOptions != nil {
+		in, out := &in.DefaultCriticalOptions, &out.DefaultCriticalOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DefaultExtensions != nil {
+		in, out := &in.DefaultExtensions, &out.DefaultExtensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
+	out.NotBeforeDuration = in.NotBeforeDuration
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineConfigStatus.
-func (in *QuaySecretEngineConfigStatus) DeepCopy() *QuaySecretEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHRole.
+func (in *SSHRole) DeepCopy() *SSHRole {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineConfigStatus)
+	out := new(SSHRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineRole) DeepCopyInto(out *QuaySecretEngineRole) {
+func (in *SSHSecretEngineConfig) DeepCopyInto(out *SSHSecretEngineConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -4118,18 +6371,18 @@ func (in *QuaySecretEngineRole) DeepCopyInto(out *QuaySecretEngineRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineRole.
-func (in *QuaySecretEngineRole) DeepCopy() *QuaySecretEngineRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSecretEngineConfig.
+func (in *SSHSecretEngineConfig) DeepCopy() *SSHSecretEngineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineRole)
+	out := new(SSHSecretEngineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuaySecretEngineRole) DeepCopyObject() runtime.Object {
+func (in *SSHSecretEngineConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4137,31 +6390,31 @@ func (in *QuaySecretEngineRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineRoleList) DeepCopyInto(out *QuaySecretEngineRoleList) {
+func (in *SSHSecretEngineConfigList) DeepCopyInto(out *SSHSecretEngineConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]QuaySecretEngineRole, len(*in))
+		*out = make([]SSHSecretEngineConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineRoleList.
-func (in *QuaySecretEngineRoleList) DeepCopy() *QuaySecretEngineRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSecretEngineConfigList.
+func (in *SSHSecretEngineConfigList) DeepCopy() *SSHSecretEngineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineRoleList)
+	out := new(SSHSecretEngineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuaySecretEngineRoleList) DeepCopyObject() runtime.Object {
+func (in *SSHSecretEngineConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4169,7 +6422,7 @@ func (in *QuaySecretEngineRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineRoleSpec) DeepCopyInto(out *QuaySecretEngineRoleSpec) {
+func (in *SSHSecretEngineConfigSpec) DeepCopyInto(out *SSHSecretEngineConfigSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -4177,21 +6430,31 @@ func (in *QuaySecretEngineRoleSpec) DeepCopyInto(out *QuaySecretEngineRoleSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.QuayRole.DeepCopyInto(&out.QuayRole)
+	out.SSHCAConfig = in.SSHCAConfig
+	if in.CASecret != nil {
+		in, out := &in.CASecret, &out.CASecret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.PublicKeyConfigMap != nil {
+		in, out := &in.PublicKeyConfigMap, &out.PublicKeyConfigMap
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineRoleSpec.
-func (in *QuaySecretEngineRoleSpec) DeepCopy() *QuaySecretEngineRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSecretEngineConfigSpec.
+func (in *SSHSecretEngineConfigSpec) DeepCopy() *SSHSecretEngineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineRoleSpec)
+	out := new(SSHSecretEngineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineRoleStatus) DeepCopyInto(out *QuaySecretEngineRoleStatus) {
+func (in *SSHSecretEngineConfigStatus) DeepCopyInto(out *SSHSecretEngineConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -4200,20 +6463,25 @@ func (in *QuaySecretEngineRoleStatus) DeepCopyInto(out *QuaySecretEngineRoleStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineRoleStatus.
-func (in *QuaySecretEngineRoleStatus) DeepCopy() *QuaySecretEngineRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSecretEngineConfigStatus.
+func (in *SSHSecretEngineConfigStatus) DeepCopy() *SSHSecretEngineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineRoleStatus)
+	out := new(SSHSecretEngineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineStaticRole) DeepCopyInto(out *QuaySecretEngineStaticRole) {
+func (in *SSHSecretEngineRole) DeepCopyInto(out *SSHSecretEngineRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -4221,18 +6489,18 @@ func (in *QuaySecretEngineStaticRole) DeepCopyInto(out *QuaySecretEngineStaticRo
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineStaticRole.
-func (in *QuaySecretEngineStaticRole) DeepCopy() *QuaySecretEngineStaticRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSecretEngineRole.
+func (in *SSHSecretEngineRole) DeepCopy() *SSHSecretEngineRole {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineStaticRole)
+	out := new(SSHSecretEngineRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuaySecretEngineStaticRole) DeepCopyObject() runtime.Object {
+func (in *SSHSecretEngineRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4240,31 +6508,31 @@ func (in *QuaySecretEngineStaticRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineStaticRoleList) DeepCopyInto(out *QuaySecretEngineStaticRoleList) {
+func (in *SSHSecretEngineRoleList) DeepCopyInto(out *SSHSecretEngineRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]QuaySecretEngineStaticRole, len(*in))
+		*out = make([]SSHSecretEngineRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineStaticRoleList.
-func (in *QuaySecretEngineStaticRoleList) DeepCopy() *QuaySecretEngineStaticRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSecretEngineRoleList.
+func (in *SSHSecretEngineRoleList) DeepCopy() *SSHSecretEngineRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineStaticRoleList)
+	out := new(SSHSecretEngineRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuaySecretEngineStaticRoleList) DeepCopyObject() runtime.Object {
+func (in *SSHSecretEngineRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4272,7 +6540,7 @@ func (in *QuaySecretEngineStaticRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineStaticRoleSpec) DeepCopyInto(out *QuaySecretEngineStaticRoleSpec) {
+func (in *SSHSecretEngineRoleSpec) DeepCopyInto(out *SSHSecretEngineRoleSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -4280,21 +6548,21 @@ func (in *QuaySecretEngineStaticRoleSpec) DeepCopyInto(out *QuaySecretEngineStat
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.QuayBaseRole.DeepCopyInto(&out.QuayBaseRole)
+	in.SSHRole.DeepCopyInto(&out.SSHRole)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineStaticRoleSpec.
-func (in *QuaySecretEngineStaticRoleSpec) DeepCopy() *QuaySecretEngineStaticRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSecretEngineRoleSpec.
+func (in *SSHSecretEngineRoleSpec) DeepCopy() *SSHSecretEngineRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QuaySecretEngineStaticRoleSpec)
+	out := new(SSHSecretEngineRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuaySecretEngineStaticRoleStatus) DeepCopyInto(out *QuaySecretEngineStaticRoleStatus) {
+func (in *SSHSecretEngineRoleStatus) DeepCopyInto(out *SSHSecretEngineRoleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -4303,77 +6571,25 @@ func (in *QuaySecretEngineStaticRoleStatus) DeepCopyInto(out *QuaySecretEngineSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuaySecretEngineStaticRoleStatus.
-func (in *QuaySecretEngineStaticRoleStatus) DeepCopy() *QuaySecretEngineStaticRoleStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(QuaySecretEngineStaticRoleStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RMQSEConfig) DeepCopyInto(out *RMQSEConfig) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RMQSEConfig.
-func (in *RMQSEConfig) DeepCopy() *RMQSEConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(RMQSEConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RMQSERole) DeepCopyInto(out *RMQSERole) {
-	*out = *in
-	if in.Vhosts != nil {
-		in, out := &in.Vhosts, &out.Vhosts
-		*out = make([]Vhost, len(*in))
-		copy(*out, *in)
-	}
-	if in.VhostTopics != nil {
-		in, out := &in.VhostTopics, &out.VhostTopics
-		*out = make([]VhostTopic, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RMQSERole.
-func (in *RMQSERole) DeepCopy() *RMQSERole {
-	if in == nil {
-		return nil
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(RMQSERole)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RSAPrivateKeyCredentialConfig) DeepCopyInto(out *RSAPrivateKeyCredentialConfig) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAPrivateKeyCredentialConfig.
-func (in *RSAPrivateKeyCredentialConfig) DeepCopy() *RSAPrivateKeyCredentialConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSecretEngineRoleStatus.
+func (in *SSHSecretEngineRoleStatus) DeepCopy() *SSHSecretEngineRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RSAPrivateKeyCredentialConfig)
+	out := new(SSHSecretEngineRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitMQSecretEngineConfig) DeepCopyInto(out *RabbitMQSecretEngineConfig) {
+func (in *SecretEngineMount) DeepCopyInto(out *SecretEngineMount) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -4381,18 +6597,18 @@ func (in *RabbitMQSecretEngineConfig) DeepCopyInto(out *RabbitMQSecretEngineConf
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineConfig.
-func (in *RabbitMQSecretEngineConfig) DeepCopy() *RabbitMQSecretEngineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMount.
+func (in *SecretEngineMount) DeepCopy() *SecretEngineMount {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitMQSecretEngineConfig)
+	out := new(SecretEngineMount)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RabbitMQSecretEngineConfig) DeepCopyObject() runtime.Object {
+func (in *SecretEngineMount) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4400,31 +6616,31 @@ func (in *RabbitMQSecretEngineConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitMQSecretEngineConfigList) DeepCopyInto(out *RabbitMQSecretEngineConfigList) {
+func (in *SecretEngineMountList) DeepCopyInto(out *SecretEngineMountList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RabbitMQSecretEngineConfig, len(*in))
+		*out = make([]SecretEngineMount, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineConfigList.
-func (in *RabbitMQSecretEngineConfigList) DeepCopy() *RabbitMQSecretEngineConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMountList.
+func (in *SecretEngineMountList) DeepCopy() *SecretEngineMountList {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitMQSecretEngineConfigList)
+	out := new(SecretEngineMountList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RabbitMQSecretEngineConfigList) DeepCopyObject() runtime.Object {
+func (in *SecretEngineMountList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4432,7 +6648,7 @@ func (in *RabbitMQSecretEngineConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitMQSecretEngineConfigSpec) DeepCopyInto(out *RabbitMQSecretEngineConfigSpec) {
+func (in *SecretEngineMountSpec) DeepCopyInto(out *SecretEngineMountSpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -4440,22 +6656,21 @@ func (in *RabbitMQSecretEngineConfigSpec) DeepCopyInto(out *RabbitMQSecretEngine
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	out.RMQSEConfig = in.RMQSEConfig
-	in.RootCredentials.DeepCopyInto(&out.RootCredentials)
+	in.Mount.DeepCopyInto(&out.Mount)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineConfigSpec.
-func (in *RabbitMQSecretEngineConfigSpec) DeepCopy() *RabbitMQSecretEngineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMountSpec.
+func (in *SecretEngineMountSpec) DeepCopy() *SecretEngineMountSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitMQSecretEngineConfigSpec)
+	out := new(SecretEngineMountSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitMQSecretEngineConfigStatus) DeepCopyInto(out *RabbitMQSecretEngineConfigStatus) {
+func (in *SecretEngineMountStatus) DeepCopyInto(out *SecretEngineMountStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -4464,123 +6679,143 @@ func (in *RabbitMQSecretEngineConfigStatus) DeepCopyInto(out *RabbitMQSecretEngi
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineConfigStatus.
-func (in *RabbitMQSecretEngineConfigStatus) DeepCopy() *RabbitMQSecretEngineConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMountStatus.
+func (in *SecretEngineMountStatus) DeepCopy() *SecretEngineMountStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitMQSecretEngineConfigStatus)
+	out := new(SecretEngineMountStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitMQSecretEngineRole) DeepCopyInto(out *RabbitMQSecretEngineRole) {
+func (in *SupersededLease) DeepCopyInto(out *SupersededLease) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.RevokeAfter.DeepCopyInto(&out.RevokeAfter)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineRole.
-func (in *RabbitMQSecretEngineRole) DeepCopy() *RabbitMQSecretEngineRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupersededLease.
+func (in *SupersededLease) DeepCopy() *SupersededLease {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitMQSecretEngineRole)
+	out := new(SupersededLease)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RabbitMQSecretEngineRole) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateError) DeepCopyInto(out *TemplateError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateError.
+func (in *TemplateError) DeepCopy() *TemplateError {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(TemplateError)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitMQSecretEngineRoleList) DeepCopyInto(out *RabbitMQSecretEngineRoleList) {
+func (in *TemplatizedK8sSecret) DeepCopyInto(out *TemplatizedK8sSecret) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]RabbitMQSecretEngineRole, len(*in))
+	if in.StringData != nil {
+		in, out := &in.StringData, &out.StringData
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TemplatesConfigMap != nil {
+		in, out := &in.TemplatesConfigMap, &out.TemplatesConfigMap
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Encoders != nil {
+		in, out := &in.Encoders, &out.Encoders
+		*out = make([]OutputEncoder, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineRoleList.
-func (in *RabbitMQSecretEngineRoleList) DeepCopy() *RabbitMQSecretEngineRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatizedK8sSecret.
+func (in *TemplatizedK8sSecret) DeepCopy() *TemplatizedK8sSecret {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitMQSecretEngineRoleList)
+	out := new(TemplatizedK8sSecret)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RabbitMQSecretEngineRoleList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitMQSecretEngineRoleSpec) DeepCopyInto(out *RabbitMQSecretEngineRoleSpec) {
+func (in *Topic) DeepCopyInto(out *Topic) {
 	*out = *in
-	if in.Connection != nil {
-		in, out := &in.Connection, &out.Connection
-		*out = new(utils.VaultConnection)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.RMQSERole.DeepCopyInto(&out.RMQSERole)
+	out.Permissions = in.Permissions
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineRoleSpec.
-func (in *RabbitMQSecretEngineRoleSpec) DeepCopy() *RabbitMQSecretEngineRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topic.
+func (in *Topic) DeepCopy() *Topic {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitMQSecretEngineRoleSpec)
+	out := new(Topic)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitMQSecretEngineRoleStatus) DeepCopyInto(out *RabbitMQSecretEngineRoleStatus) {
+func (in *TransitKey) DeepCopyInto(out *TransitKey) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.AutoRotatePeriod = in.AutoRotatePeriod
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQSecretEngineRoleStatus.
-func (in *RabbitMQSecretEngineRoleStatus) DeepCopy() *RabbitMQSecretEngineRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitKey.
+func (in *TransitKey) DeepCopy() *TransitKey {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitMQSecretEngineRoleStatus)
+	out := new(TransitKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RandomSecret) DeepCopyInto(out *RandomSecret) {
+func (in *TransitSecretEngineKey) DeepCopyInto(out *TransitSecretEngineKey) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -4588,18 +6823,18 @@ func (in *RandomSecret) DeepCopyInto(out *RandomSecret) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomSecret.
-func (in *RandomSecret) DeepCopy() *RandomSecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitSecretEngineKey.
+func (in *TransitSecretEngineKey) DeepCopy() *TransitSecretEngineKey {
 	if in == nil {
 		return nil
 	}
-	out := new(RandomSecret)
+	out := new(TransitSecretEngineKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RandomSecret) DeepCopyObject() runtime.Object {
+func (in *TransitSecretEngineKey) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4607,31 +6842,31 @@ func (in *RandomSecret) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RandomSecretList) DeepCopyInto(out *RandomSecretList) {
+func (in *TransitSecretEngineKeyList) DeepCopyInto(out *TransitSecretEngineKeyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RandomSecret, len(*in))
+		*out = make([]TransitSecretEngineKey, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomSecretList.
-func (in *RandomSecretList) DeepCopy() *RandomSecretList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitSecretEngineKeyList.
+func (in *TransitSecretEngineKeyList) DeepCopy() *TransitSecretEngineKeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RandomSecretList)
+	out := new(TransitSecretEngineKeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RandomSecretList) DeepCopyObject() runtime.Object {
+func (in *TransitSecretEngineKeyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4639,7 +6874,7 @@ func (in *RandomSecretList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RandomSecretSpec) DeepCopyInto(out *RandomSecretSpec) {
+func (in *TransitSecretEngineKeySpec) DeepCopyInto(out *TransitSecretEngineKeySpec) {
 	*out = *in
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -4647,26 +6882,21 @@ func (in *RandomSecretSpec) DeepCopyInto(out *RandomSecretSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
-	out.SecretFormat = in.SecretFormat
-	if in.RefreshPeriod != nil {
-		in, out := &in.RefreshPeriod, &out.RefreshPeriod
-		*out = new(v1.Duration)
-		**out = **in
-	}
+	out.TransitKey = in.TransitKey
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomSecretSpec.
-func (in *RandomSecretSpec) DeepCopy() *RandomSecretSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitSecretEngineKeySpec.
+func (in *TransitSecretEngineKeySpec) DeepCopy() *TransitSecretEngineKeySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RandomSecretSpec)
+	out := new(TransitSecretEngineKeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RandomSecretStatus) DeepCopyInto(out *RandomSecretStatus) {
+func (in *TransitSecretEngineKeyStatus) DeepCopyInto(out *TransitSecretEngineKeyStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -4675,65 +6905,69 @@ func (in *RandomSecretStatus) DeepCopyInto(out *RandomSecretStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.LastVaultSecretUpdate != nil {
-		in, out := &in.LastVaultSecretUpdate, &out.LastVaultSecretUpdate
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(utils.DriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomSecretStatus.
-func (in *RandomSecretStatus) DeepCopy() *RandomSecretStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitSecretEngineKeyStatus.
+func (in *TransitSecretEngineKeyStatus) DeepCopy() *TransitSecretEngineKeyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RandomSecretStatus)
+	out := new(TransitSecretEngineKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RootPasswordRotation) DeepCopyInto(out *RootPasswordRotation) {
+func (in *VRole) DeepCopyInto(out *VRole) {
 	*out = *in
-	out.RotationPeriod = in.RotationPeriod
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootPasswordRotation.
-func (in *RootPasswordRotation) DeepCopy() *RootPasswordRotation {
-	if in == nil {
-		return nil
+	if in.TargetServiceAccounts != nil {
+		in, out := &in.TargetServiceAccounts, &out.TargetServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(RootPasswordRotation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKeyConfig) DeepCopyInto(out *SSHKeyConfig) {
-	*out = *in
-	if in.VaultSecret != nil {
-		in, out := &in.VaultSecret, &out.VaultSecret
-		*out = new(utils.VaultSecretReference)
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
 		**out = **in
 	}
-	if in.Secret != nil {
-		in, out := &in.Secret, &out.Secret
-		*out = new(corev1.LocalObjectReference)
-		**out = **in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.namespaces != nil {
+		in, out := &in.namespaces, &out.namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyConfig.
-func (in *SSHKeyConfig) DeepCopy() *SSHKeyConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRole.
+func (in *VRole) DeepCopy() *VRole {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKeyConfig)
+	out := new(VRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretEngineMount) DeepCopyInto(out *SecretEngineMount) {
+func (in *VaultConnection) DeepCopyInto(out *VaultConnection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -4741,18 +6975,18 @@ func (in *SecretEngineMount) DeepCopyInto(out *SecretEngineMount) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMount.
-func (in *SecretEngineMount) DeepCopy() *SecretEngineMount {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultConnection.
+func (in *VaultConnection) DeepCopy() *VaultConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretEngineMount)
+	out := new(VaultConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretEngineMount) DeepCopyObject() runtime.Object {
+func (in *VaultConnection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4760,31 +6994,31 @@ func (in *SecretEngineMount) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretEngineMountList) DeepCopyInto(out *SecretEngineMountList) {
+func (in *VaultConnectionList) DeepCopyInto(out *VaultConnectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SecretEngineMount, len(*in))
+		*out = make([]VaultConnection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMountList.
-func (in *SecretEngineMountList) DeepCopy() *SecretEngineMountList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultConnectionList.
+func (in *VaultConnectionList) DeepCopy() *VaultConnectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretEngineMountList)
+	out := new(VaultConnectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretEngineMountList) DeepCopyObject() runtime.Object {
+func (in *VaultConnectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4792,137 +7026,78 @@ func (in *SecretEngineMountList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretEngineMountSpec) DeepCopyInto(out *SecretEngineMountSpec) {
+func (in *VaultConnectionSettings) DeepCopyInto(out *VaultConnectionSettings) {
 	*out = *in
-	if in.Connection != nil {
-		in, out := &in.Connection, &out.Connection
-		*out = new(utils.VaultConnection)
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(utils.TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	in.Authentication.DeepCopyInto(&out.Authentication)
-	in.Mount.DeepCopyInto(&out.Mount)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMountSpec.
-func (in *SecretEngineMountSpec) DeepCopy() *SecretEngineMountSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(SecretEngineMountSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretEngineMountStatus) DeepCopyInto(out *SecretEngineMountStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMountStatus.
-func (in *SecretEngineMountStatus) DeepCopy() *SecretEngineMountStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(SecretEngineMountStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TemplatizedK8sSecret) DeepCopyInto(out *TemplatizedK8sSecret) {
-	*out = *in
-	if in.StringData != nil {
-		in, out := &in.StringData, &out.StringData
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.TimeOut != nil {
+		in, out := &in.TimeOut, &out.TimeOut
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
 	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.HealthCheckInterval != nil {
+		in, out := &in.HealthCheckInterval, &out.HealthCheckInterval
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatizedK8sSecret.
-func (in *TemplatizedK8sSecret) DeepCopy() *TemplatizedK8sSecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultConnectionSettings.
+func (in *VaultConnectionSettings) DeepCopy() *VaultConnectionSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(TemplatizedK8sSecret)
+	out := new(VaultConnectionSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Topic) DeepCopyInto(out *Topic) {
+func (in *VaultConnectionSpec) DeepCopyInto(out *VaultConnectionSpec) {
 	*out = *in
-	out.Permissions = in.Permissions
+	in.VaultConnectionSettings.DeepCopyInto(&out.VaultConnectionSettings)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topic.
-func (in *Topic) DeepCopy() *Topic {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultConnectionSpec.
+func (in *VaultConnectionSpec) DeepCopy() *VaultConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Topic)
+	out := new(VaultConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VRole) DeepCopyInto(out *VRole) {
+func (in *VaultConnectionStatus) DeepCopyInto(out *VaultConnectionStatus) {
 	*out = *in
-	if in.TargetServiceAccounts != nil {
-		in, out := &in.TargetServiceAccounts, &out.TargetServiceAccounts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Audience != nil {
-		in, out := &in.Audience, &out.Audience
-		*out = new(string)
-		**out = **in
-	}
-	if in.Policies != nil {
-		in, out := &in.Policies, &out.Policies
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TokenBoundCIDRs != nil {
-		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.namespaces != nil {
-		in, out := &in.namespaces, &out.namespaces
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.LastHealthCheck != nil {
+		in, out := &in.LastHealthCheck, &out.LastHealthCheck
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRole.
-func (in *VRole) DeepCopy() *VRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultConnectionStatus.
+func (in *VaultConnectionStatus) DeepCopy() *VaultConnectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VRole)
+	out := new(VaultConnectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5052,6 +7227,11 @@ func (in *VaultSecretSpec) DeepCopyInto(out *VaultSecretSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.LeaseRevocationGracePeriod != nil {
+		in, out := &in.LeaseRevocationGracePeriod, &out.LeaseRevocationGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.VaultSecretDefinitions != nil {
 		in, out := &in.VaultSecretDefinitions, &out.VaultSecretDefinitions
 		*out = make([]VaultSecretDefinition, len(*in))
@@ -5060,6 +7240,13 @@ func (in *VaultSecretSpec) DeepCopyInto(out *VaultSecretSpec) {
 		}
 	}
 	in.TemplatizedK8sSecret.DeepCopyInto(&out.TemplatizedK8sSecret)
+	if in.RolloutTargets != nil {
+		in, out := &in.RolloutTargets, &out.RolloutTargets
+		*out = make([]RolloutTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretSpec.
@@ -5086,6 +7273,10 @@ func (in *VaultSecretStatus) DeepCopyInto(out *VaultSecretStatus) {
 		in, out := &in.LastVaultSecretUpdate, &out.LastVaultSecretUpdate
 		*out = (*in).DeepCopy()
 	}
+	if in.LastLeaseRenewal != nil {
+		in, out := &in.LastLeaseRenewal, &out.LastLeaseRenewal
+		*out = (*in).DeepCopy()
+	}
 	if in.NextVaultSecretUpdate != nil {
 		in, out := &in.NextVaultSecretUpdate, &out.NextVaultSecretUpdate
 		*out = (*in).DeepCopy()
@@ -5095,6 +7286,18 @@ func (in *VaultSecretStatus) DeepCopyInto(out *VaultSecretStatus) {
 		*out = make([]VaultSecretDefinitionStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.TemplateErrors != nil {
+		in, out := &in.TemplateErrors, &out.TemplateErrors
+		*out = make([]TemplateError, len(*in))
+		copy(*out, *in)
+	}
+	if in.SupersededLeases != nil {
+		in, out := &in.SupersededLeases, &out.SupersededLeases
+		*out = make([]SupersededLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretStatus.
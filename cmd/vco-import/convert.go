@@ -0,0 +1,143 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The vault client decodes numbers as json.Number and lists as []interface{}, these helpers convert the values read from Vault to the types of the resource specs
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func toInt(value interface{}) int {
+	switch v := value.(type) {
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			f, _ := v.Float64()
+			return int(f)
+		}
+		return int(i)
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+	return 0
+}
+
+func toBool(value interface{}) bool {
+	b, _ := value.(bool)
+	return b
+}
+
+func toStringSlice(value interface{}) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			result = append(result, toString(item))
+		}
+		return result
+	case []string:
+		return v
+	case string:
+		if v == "" {
+			return nil
+		}
+		return strings.Split(v, ",")
+	}
+	return nil
+}
+
+func toStringMap(value interface{}) map[string]string {
+	m, ok := value.(map[string]interface{})
+	if !ok || len(m) == 0 {
+		return nil
+	}
+	result := map[string]string{}
+	for key, item := range m {
+		result[key] = toString(item)
+	}
+	return result
+}
+
+// toTTL renders a number of seconds as a duration string, zero means that the system default applies
+func toTTL(value interface{}) string {
+	seconds := toInt(value)
+	if seconds == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%ds", seconds)
+}
+
+// parseTTL parses a ttl expressed either as a number of seconds or as a duration string
+func parseTTL(ttl string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(ttl); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(ttl)
+}
+
+func toDuration(value interface{}) metav1.Duration {
+	return metav1.Duration{Duration: time.Duration(toInt(value)) * time.Second}
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// resourceName turns Vault paths and names into a valid kubernetes resource name
+func resourceName(parts ...string) string {
+	name := strings.ToLower(strings.Join(parts, "-"))
+	name = invalidNameCharacters.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 253 {
+		name = strings.Trim(name[:253], "-")
+	}
+	return name
+}
+
+// splitMountPath splits a mount path into the path and name fields of the mount resources, so that {path}/{name} is the mount path
+func splitMountPath(mountPath string) (string, string) {
+	mountPath = strings.Trim(mountPath, "/")
+	index := strings.LastIndex(mountPath, "/")
+	if index < 0 {
+		return "", mountPath
+	}
+	return mountPath[:index], mountPath[index+1:]
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+)
+
+func (i *Importer) importGroups(ctx context.Context) ([]importedObject, error) {
+	names, err := i.list("identity/group/name")
+	if err != nil {
+		return nil, err
+	}
+	imported := []importedObject{}
+	for _, name := range names {
+		payload, err := i.read("identity/group/name/" + name)
+		if err != nil {
+			return nil, err
+		}
+		if payload == nil {
+			continue
+		}
+		metadata := toStringMap(payload["metadata"])
+		// the marker of a previous owner is replaced by the marker of the imported resource
+		delete(metadata, vaultutils.OwnershipMarkerKey)
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+		group := &redhatcopv1alpha1.Group{
+			TypeMeta:   typeMeta("Group"),
+			ObjectMeta: i.objectMeta("Group", name),
+			Spec: redhatcopv1alpha1.GroupSpec{
+				Authentication: i.authentication,
+				GroupConfig: redhatcopv1alpha1.GroupConfig{
+					Type:     toString(payload["type"]),
+					Metadata: metadata,
+					Policies: toStringSlice(payload["policies"]),
+				},
+				Name:             name,
+				ManagementPolicy: i.managementPolicy,
+			},
+		}
+		if group.Spec.Type == "internal" {
+			group.Spec.MemberGroupIDs = toStringSlice(payload["member_group_ids"])
+			group.Spec.MemberEntityIDs = toStringSlice(payload["member_entity_ids"])
+		}
+		imported = append(imported, importedObject{object: group, path: group.GetPath(), payload: payload})
+	}
+	return imported, nil
+}
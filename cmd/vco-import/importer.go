@@ -0,0 +1,235 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// Importer reads the configuration of a Vault server and builds the resources of this operator that describe it
+type Importer struct {
+	vaultClient      *vault.Client
+	namespace        string
+	authentication   vaultutils.KubeAuthConfiguration
+	managementPolicy vaultutils.ManagementPolicy
+	warnings         []string
+	names            map[string]bool
+}
+
+// importedObject is a resource built from Vault, together with the payload it was built from
+type importedObject struct {
+	object  client.Object
+	path    string
+	payload map[string]interface{}
+}
+
+func NewImporter(vaultClient *vault.Client, namespace string, authentication vaultutils.KubeAuthConfiguration, managementPolicy vaultutils.ManagementPolicy) *Importer {
+	return &Importer{
+		vaultClient:      vaultClient,
+		namespace:        namespace,
+		authentication:   authentication,
+		managementPolicy: managementPolicy,
+		names:            map[string]bool{},
+	}
+}
+
+// Warnings returns the problems found while importing, the generated resources are usable but might not reproduce exactly the state of Vault
+func (i *Importer) Warnings() []string {
+	return i.warnings
+}
+
+func (i *Importer) warnf(format string, args ...interface{}) {
+	i.warnings = append(i.warnings, fmt.Sprintf(format, args...))
+}
+
+// Import returns the resources describing the policies, mounts, roles and groups found in Vault
+func (i *Importer) Import(ctx context.Context) ([]client.Object, error) {
+	ctx = vaultutils.WithVaultClient(ctx, i.vaultClient)
+	// the imported resources are verified without a cluster, the kubernetes client sees no resources so that, for example, group members are kept as entity ids
+	scheme := runtime.NewScheme()
+	if err := redhatcopv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	ctx = vaultutils.WithKubeClient(ctx, fake.NewClientBuilder().WithScheme(scheme).Build())
+	imported := []importedObject{}
+	for _, importFunc := range []func(context.Context) ([]importedObject, error){
+		i.importPolicies,
+		i.importSecretEngineMounts,
+		i.importAuthEngineMounts,
+		i.importGroups,
+	} {
+		objects, err := importFunc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		imported = append(imported, objects...)
+	}
+	result := []client.Object{}
+	for _, object := range imported {
+		i.verify(ctx, object)
+		result = append(result, object.object)
+	}
+	return result, nil
+}
+
+// objectMeta returns the metadata of a new resource, the name is made unique among the resources of the same kind
+func (i *Importer) objectMeta(kind string, parts ...string) metav1.ObjectMeta {
+	name := resourceName(parts...)
+	unique := name
+	for index := 2; i.names[kind+"/"+unique]; index++ {
+		unique = fmt.Sprintf("%s-%d", name, index)
+	}
+	i.names[kind+"/"+unique] = true
+	return metav1.ObjectMeta{
+		Name:      unique,
+		Namespace: i.namespace,
+	}
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		APIVersion: redhatcopv1alpha1.GroupVersion.String(),
+		Kind:       kind,
+	}
+}
+
+func (i *Importer) read(path string) (map[string]interface{}, error) {
+	secret, err := i.vaultClient.Logical().Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", path, err)
+	}
+	if secret == nil {
+		return nil, nil
+	}
+	return secret.Data, nil
+}
+
+// list returns the sorted keys found at path, or nothing if there are none
+func (i *Importer) list(path string) ([]string, error) {
+	secret, err := i.vaultClient.Logical().List(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list %s: %w", path, err)
+	}
+	if secret == nil {
+		return nil, nil
+	}
+	keys := toStringSlice(secret.Data["keys"])
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// verify checks that the resource is equivalent to the state of Vault it was built from. Differences in the representation of the values, such as numbers read as json.Number, are ignored, only the fields the first reconcile cycle would actually change are reported.
+func (i *Importer) verify(ctx context.Context, imported importedObject) {
+	kind := imported.object.GetObjectKind().GroupVersionKind().Kind
+	vaultObject := imported.object.(vaultutils.VaultObject)
+	err := vaultObject.PrepareInternalValues(ctx, imported.object)
+	if err != nil {
+		i.warnf("%s %s: unable to verify the generated resource: %v", kind, imported.object.GetName(), err)
+		return
+	}
+	actual := map[string]interface{}{}
+	for key, value := range imported.payload {
+		actual[key] = value
+	}
+	// the marker is recorded by the first reconcile cycle, it is not a difference with the imported state
+	if _, ok := imported.object.(vaultutils.OwnershipMarkerAware); ok {
+		metadata := map[string]interface{}{}
+		if m, ok := actual["metadata"].(map[string]interface{}); ok {
+			for key, value := range m {
+				metadata[key] = value
+			}
+		}
+		metadata[vaultutils.OwnershipMarkerKey] = vaultutils.GetOwnershipMarker(imported.object)
+		actual["metadata"] = metadata
+	}
+	if vaultObject.IsEquivalentToDesiredState(copyPayload(actual)) {
+		return
+	}
+	desired := vaultObject.GetPayload()
+	if engineObject, ok := imported.object.(vaultutils.VaultEngineObject); ok {
+		desired = engineObject.GetTunePayload()
+	}
+	changed := []string{}
+	for _, field := range vaultutils.ComputeDrift(imported.path, comparablePayload(desired), actual) {
+		switch {
+		// keys managed by Vault only, such as ids and timestamps
+		case field.Change == vaultutils.DriftAdded:
+		case field.Change == vaultutils.DriftRemoved && isEmptyValue(field.Desired):
+		case field.Change == vaultutils.DriftChanged && isEmptyValue(field.Desired) && isEmptyValue(field.Actual):
+		default:
+			changed = append(changed, field.Key)
+		}
+	}
+	if len(changed) > 0 {
+		i.warnf("%s %s: the first reconcile cycle will update %s at %s", kind, imported.object.GetName(), strings.Join(changed, ", "), imported.path)
+	}
+}
+
+func copyPayload(payload map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range payload {
+		result[key] = value
+	}
+	return result
+}
+
+// comparablePayload converts the durations of a desired payload to the seconds returned by Vault, and drops the empty ttls, which leave Vault unchanged
+func comparablePayload(payload map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range payload {
+		switch v := value.(type) {
+		case metav1.Duration:
+			result[key] = int64(v.Duration.Seconds())
+		case string:
+			if !strings.HasSuffix(key, "ttl") {
+				result[key] = v
+				continue
+			}
+			if v == "" {
+				continue
+			}
+			duration, err := parseTTL(v)
+			if err != nil {
+				result[key] = v
+				continue
+			}
+			result[key] = int64(duration.Seconds())
+		default:
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func isEmptyValue(value string) bool {
+	switch value {
+	case "", "null", `""`, "[]", "{}", "0", "false":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// vaultResponses are the responses of the fake Vault server, keyed by path, lists are keyed by path?list=true
+var vaultResponses = map[string]string{
+	"sys/policies/acl?list=true": `{"keys": ["default", "reader", "root"]}`,
+	"sys/policies/acl/reader":    `{"name": "reader", "policy": "path \"secret/*\" {\n  capabilities = [\"read\"]\n}"}`,
+	"sys/mounts": `{
+		"cubbyhole/": {"type": "cubbyhole", "config": {}},
+		"team-a/database/": {"type": "database", "description": "team a databases", "local": false, "seal_wrap": false, "external_entropy_access": false, "options": null,
+			"config": {"default_lease_ttl": 3600, "max_lease_ttl": 0, "force_no_cache": false}},
+		"team-a/pki/": {"type": "pki", "description": "team a certificates", "local": false, "seal_wrap": false, "external_entropy_access": false, "options": null,
+			"config": {"default_lease_ttl": 0, "max_lease_ttl": 0, "force_no_cache": false}}
+	}`,
+	"sys/mounts/team-a/pki/tune": `{"default_lease_ttl": 2764800, "max_lease_ttl": 2764800, "force_no_cache": false, "description": "team a certificates"}`,
+	"sys/mounts/team-a/database/tune": `{"default_lease_ttl": 3600, "max_lease_ttl": 2764800, "force_no_cache": false, "description": "team a databases"}`,
+	"team-a/database/roles?list=true": `{"keys": ["readonly"]}`,
+	"team-a/database/roles/readonly": `{"db_name": "postgresql", "default_ttl": 3600, "max_ttl": 86400, "creation_statements": ["CREATE ROLE \"{{name}}\""],
+		"revocation_statements": [], "rollback_statements": [], "renew_statements": [], "credential_type": "password"}`,
+	"sys/auth": `{
+		"token/": {"type": "token", "config": {}},
+		"kubernetes/": {"type": "kubernetes", "description": "", "local": false, "seal_wrap": false, "config": {"default_lease_ttl": 0, "max_lease_ttl": 0, "token_type": "default-service"}},
+		"clusters/cluster1/": {"type": "kubernetes", "description": "cluster1", "local": false, "seal_wrap": false, "config": {"default_lease_ttl": 0, "max_lease_ttl": 0, "token_type": "default-service"}},
+		"clusters/oidc/": {"type": "oidc", "description": "", "local": false, "seal_wrap": false, "config": {"default_lease_ttl": 0, "max_lease_ttl": 0, "token_type": "default-service"}}
+	}`,
+	"sys/auth/clusters/oidc/tune":           `{"default_lease_ttl": 2764800, "max_lease_ttl": 2764800, "description": "", "token_type": "default-service", "force_no_cache": false}`,
+	"sys/auth/clusters/cluster1/tune":       `{"default_lease_ttl": 2764800, "max_lease_ttl": 2764800, "description": "cluster1", "token_type": "default-service", "force_no_cache": false}`,
+	"auth/kubernetes/role?list=true":        `{"keys": []}`,
+	"auth/clusters/cluster1/role?list=true": `{"keys": ["app"]}`,
+	"auth/clusters/cluster1/role/app": `{"bound_service_account_names": ["app"], "bound_service_account_namespaces": ["team-a"], "alias_name_source": "serviceaccount_uid",
+		"token_ttl": 600, "token_max_ttl": 0, "token_policies": ["reader"], "policies": ["reader"], "token_bound_cidrs": [], "token_explicit_max_ttl": 0,
+		"token_no_default_policy": false, "token_num_uses": 0, "token_period": 0, "token_type": "default", "ttl": 600}`,
+	"identity/group/name?list=true": `{"keys": ["admins"]}`,
+	"identity/group/name/admins": `{"id": "7f4e6b5c", "name": "admins", "type": "internal", "metadata": {"team": "platform"}, "policies": ["reader"],
+		"member_group_ids": null, "member_entity_ids": ["2a3b4c5d"], "creation_time": "2024-01-01T00:00:00Z"}`,
+}
+
+func newFakeVault(t *testing.T) *vault.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/v1/")
+		if r.URL.Query().Get("list") == "true" {
+			key += "?list=true"
+		}
+		data, ok := vaultResponses[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": ` + data + `}`))
+	}))
+	t.Cleanup(server.Close)
+	config := vault.DefaultConfig()
+	config.Address = server.URL
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	vaultClient.SetToken("test")
+	return vaultClient
+}
+
+func TestImport(t *testing.T) {
+	authentication := vaultutils.KubeAuthConfiguration{Path: "kubernetes", Role: "vault-admin"}
+	importer := NewImporter(newFakeVault(t), "vault-admin", authentication, vaultutils.ManagementPolicyAdopt)
+	objects, err := importer.Import(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	imported := map[string]client.Object{}
+	for _, object := range objects {
+		imported[object.GetObjectKind().GroupVersionKind().Kind+"/"+object.GetName()] = object
+		if object.GetNamespace() != "vault-admin" {
+			t.Errorf("expected %s to be in the vault-admin namespace", object.GetName())
+		}
+	}
+	expected := []string{
+		"Policy/reader",
+		"SecretEngineMount/team-a-database",
+		"DatabaseSecretEngineRole/team-a-database-readonly",
+		"SecretEngineMount/team-a-pki",
+		"AuthEngineMount/clusters-cluster1",
+		"KubernetesAuthEngineRole/clusters-cluster1-app",
+		"AuthEngineMount/clusters-oidc",
+		"Group/admins",
+	}
+	if len(imported) != len(expected) {
+		t.Errorf("expected %d resources, got %d", len(expected), len(imported))
+	}
+	for _, key := range expected {
+		if _, ok := imported[key]; !ok {
+			t.Errorf("expected %s to be imported", key)
+		}
+	}
+
+	// the generated resources manage the paths they were imported from
+	paths := map[string]string{
+		"Policy/reader":                                     "sys/policies/acl/reader",
+		"SecretEngineMount/team-a-database":                 "sys/mounts/team-a/database",
+		"DatabaseSecretEngineRole/team-a-database-readonly": "team-a/database/roles/readonly",
+		"AuthEngineMount/clusters-cluster1":                 "sys/auth/clusters/cluster1",
+		"KubernetesAuthEngineRole/clusters-cluster1-app":    "auth/clusters/cluster1/role/app",
+		"Group/admins":                                      "identity/group/name/admins",
+	}
+	for key, path := range paths {
+		if object, ok := imported[key]; ok && object.(vaultutils.VaultObject).GetPath() != path {
+			t.Errorf("expected %s to manage %s, got %s", key, path, object.(vaultutils.VaultObject).GetPath())
+		}
+	}
+
+	if role, ok := imported["DatabaseSecretEngineRole/team-a-database-readonly"].(*redhatcopv1alpha1.DatabaseSecretEngineRole); ok {
+		if role.Spec.DefaultTTL.Duration != time.Hour || role.Spec.DBName != "postgresql" {
+			t.Errorf("unexpected database role spec %+v", role.Spec.DBSERole)
+		}
+	}
+	if role, ok := imported["KubernetesAuthEngineRole/clusters-cluster1-app"].(*redhatcopv1alpha1.KubernetesAuthEngineRole); ok {
+		if !reflect.DeepEqual(role.Spec.TargetNamespaces.TargetNamespaces, []string{"team-a"}) || role.Spec.TokenTTL != 600 {
+			t.Errorf("unexpected kubernetes role spec %+v", role.Spec)
+		}
+	}
+	if group, ok := imported["Group/admins"].(*redhatcopv1alpha1.Group); ok {
+		if !reflect.DeepEqual(group.Spec.MemberEntityIDs, []string{"2a3b4c5d"}) || group.Spec.Metadata["team"] != "platform" {
+			t.Errorf("unexpected group spec %+v", group.Spec)
+		}
+	}
+
+	// the mount at auth/kubernetes has no spec.path and the mounts whose roles are not imported are reported, every other resource matches the state of Vault
+	expectedWarnings := []string{
+		"DatabaseSecretEngineStaticRole: the roles of the database engine mounted at team-a/database are not imported, describe them by hand",
+		"PKISecretEngineRole: the roles of the pki engine mounted at team-a/pki are not imported, describe them by hand",
+		"JWTOIDCAuthEngineRole: the roles of the oidc engine mounted at auth/clusters/oidc are not imported, describe them by hand",
+	}
+	warnings := importer.Warnings()
+	if len(warnings) != len(expectedWarnings)+1 || !strings.Contains(strings.Join(warnings, "\n"), "auth/kubernetes cannot be described") {
+		t.Errorf("unexpected warnings %v", warnings)
+	}
+	for _, expected := range expectedWarnings {
+		found := false
+		for _, warning := range warnings {
+			found = found || warning == expected
+		}
+		if !found {
+			t.Errorf("expected the warning %q, got %v", expected, warnings)
+		}
+	}
+}
+
+func TestImportReportsDifferences(t *testing.T) {
+	importer := NewImporter(nil, "vault-admin", vaultutils.KubeAuthConfiguration{}, vaultutils.ManagementPolicyAdopt)
+	policy := &redhatcopv1alpha1.Policy{
+		TypeMeta:   typeMeta("Policy"),
+		ObjectMeta: importer.objectMeta("Policy", "reader"),
+		Spec:       redhatcopv1alpha1.PolicySpec{Policy: "path \"secret/*\" {}", Type: "acl", Name: "reader"},
+	}
+	importer.verify(context.Background(), importedObject{object: policy, path: policy.GetPath(), payload: map[string]interface{}{
+		"name":   "reader",
+		"policy": "path \"kv/*\" {}",
+	}})
+	warnings := importer.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "update policy") {
+		t.Errorf("unexpected warnings %v", warnings)
+	}
+}
+
+func TestWriteManifests(t *testing.T) {
+	importer := NewImporter(nil, "vault-admin", vaultutils.KubeAuthConfiguration{Path: "kubernetes", Role: "vault-admin"}, vaultutils.ManagementPolicyObserve)
+	policy := &redhatcopv1alpha1.Policy{
+		TypeMeta:   typeMeta("Policy"),
+		ObjectMeta: importer.objectMeta("Policy", "Team_A/Reader"),
+		Spec:       redhatcopv1alpha1.PolicySpec{Policy: "path \"secret/*\" {}", Type: "acl", Name: "Team_A/Reader", ManagementPolicy: vaultutils.ManagementPolicyObserve},
+	}
+	var buffer bytes.Buffer
+	err := writeManifests(&buffer, []client.Object{policy})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	manifest := buffer.String()
+	for _, expected := range []string{"apiVersion: redhatcop.redhat.io/v1alpha1", "kind: Policy", "name: team-a-reader", "managementPolicy: Observe"} {
+		if !strings.Contains(manifest, expected) {
+			t.Errorf("expected manifest to contain %q:\n%s", expected, manifest)
+		}
+	}
+	for _, unexpected := range []string{"status", "creationTimestamp"} {
+		if strings.Contains(manifest, unexpected) {
+			t.Errorf("expected manifest not to contain %q:\n%s", unexpected, manifest)
+		}
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	for input, expected := range map[string]string{
+		"reader":            "reader",
+		"team-a/database/":  "team-a-database",
+		"Team_A.Admins":     "team-a-admins",
+		"clusters/cluster1": "clusters-cluster1",
+	} {
+		if name := resourceName(input); name != expected {
+			t.Errorf("expected %s to be named %s, got %s", input, expected, name)
+		}
+	}
+}
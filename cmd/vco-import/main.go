@@ -0,0 +1,138 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// vco-import generates the resources of the vault-config-operator describing the configuration of a live Vault server.
+// It connects to Vault with the standard Vault environment variables (VAULT_ADDR, VAULT_TOKEN, VAULT_NAMESPACE, VAULT_CACERT...).
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	vault "github.com/hashicorp/vault/api"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/yaml"
+)
+
+func main() {
+	var namespace string
+	var output string
+	var authPath string
+	var authRole string
+	var serviceAccount string
+	var managementPolicy string
+	flag.StringVar(&namespace, "namespace", "vault-admin", "The namespace of the generated resources.")
+	flag.StringVar(&output, "output", "-", "The file the generated resources are written to, - for the standard output.")
+	flag.StringVar(&authPath, "auth-path", "kubernetes", "The spec.authentication.path of the generated resources.")
+	flag.StringVar(&authRole, "auth-role", "", "The spec.authentication.role of the generated resources.")
+	flag.StringVar(&serviceAccount, "service-account", "", "The spec.authentication.serviceAccount of the generated resources, the default service account if empty.")
+	flag.StringVar(&managementPolicy, "management-policy", string(vaultutils.ManagementPolicyAdopt), "The spec.managementPolicy of the generated resources, one of CreateOnly, Adopt or Observe.")
+	opts := zap.Options{}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+
+	err := run(namespace, output, authPath, authRole, serviceAccount, vaultutils.ManagementPolicy(managementPolicy))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(namespace string, output string, authPath string, authRole string, serviceAccount string, managementPolicy vaultutils.ManagementPolicy) error {
+	if authRole == "" {
+		return errors.New("--auth-role is required")
+	}
+	switch managementPolicy {
+	case vaultutils.ManagementPolicyCreateOnly, vaultutils.ManagementPolicyAdopt, vaultutils.ManagementPolicyObserve:
+	default:
+		return fmt.Errorf("unsupported management policy %q", managementPolicy)
+	}
+	vaultClient, err := vault.NewClient(vault.DefaultConfig())
+	if err != nil {
+		return fmt.Errorf("unable to create vault client: %w", err)
+	}
+	if vaultClient.Token() == "" {
+		return errors.New("no vault token found, set VAULT_TOKEN")
+	}
+
+	authentication := vaultutils.KubeAuthConfiguration{
+		Path:      vaultutils.Path(authPath),
+		Role:      authRole,
+		Namespace: vaultClient.Namespace(),
+	}
+	if serviceAccount != "" {
+		authentication.ServiceAccount = &corev1.LocalObjectReference{Name: serviceAccount}
+	}
+
+	importer := NewImporter(vaultClient, namespace, authentication, managementPolicy)
+	objects, err := importer.Import(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, warning := range importer.Warnings() {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
+	}
+
+	var writer io.Writer = os.Stdout
+	if output != "-" {
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		writer = file
+	}
+	return writeManifests(writer, objects)
+}
+
+// writeManifests writes the objects as a multi document yaml, without the status and the fields set by the api server
+func writeManifests(writer io.Writer, objects []client.Object) error {
+	for _, object := range objects {
+		data, err := json.Marshal(object)
+		if err != nil {
+			return err
+		}
+		manifest := map[string]interface{}{}
+		err = json.Unmarshal(data, &manifest)
+		if err != nil {
+			return err
+		}
+		delete(manifest, "status")
+		if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "creationTimestamp")
+		}
+		data, err = yaml.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(writer, "---\n%s", data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+)
+
+// systemSecretEngineTypes are mounted by Vault itself and cannot be managed by a SecretEngineMount
+var systemSecretEngineTypes = map[string]bool{
+	"system":    true,
+	"identity":  true,
+	"cubbyhole": true,
+}
+
+// systemAuthEngineTypes are mounted by Vault itself and cannot be managed by an AuthEngineMount
+var systemAuthEngineTypes = map[string]bool{
+	"token": true,
+}
+
+func (i *Importer) importSecretEngineMounts(ctx context.Context) ([]importedObject, error) {
+	mounts, err := i.read("sys/mounts")
+	if err != nil {
+		return nil, err
+	}
+	imported := []importedObject{}
+	for _, key := range sortedKeys(mounts) {
+		mount, ok := mounts[key].(map[string]interface{})
+		if !ok || systemSecretEngineTypes[toString(mount["type"])] {
+			continue
+		}
+		mountPath := strings.Trim(key, "/")
+		path, name := splitMountPath(mountPath)
+		config, _ := mount["config"].(map[string]interface{})
+		secretEngineMount := &redhatcopv1alpha1.SecretEngineMount{
+			TypeMeta:   typeMeta("SecretEngineMount"),
+			ObjectMeta: i.objectMeta("SecretEngineMount", mountPath),
+			Spec: redhatcopv1alpha1.SecretEngineMountSpec{
+				Authentication: i.authentication,
+				Mount: redhatcopv1alpha1.Mount{
+					Type:        toString(mount["type"]),
+					Description: toString(mount["description"]),
+					Config: redhatcopv1alpha1.MountConfig{
+						DefaultLeaseTTL:           toTTL(config["default_lease_ttl"]),
+						MaxLeaseTTL:               toTTL(config["max_lease_ttl"]),
+						ForceNoCache:              toBool(config["force_no_cache"]),
+						AuditNonHMACRequestKeys:   toStringSlice(config["audit_non_hmac_request_keys"]),
+						AuditNonHMACResponseKeys:  toStringSlice(config["audit_non_hmac_response_keys"]),
+						ListingVisibility:         toString(config["listing_visibility"]),
+						PassthroughRequestHeaders: toStringSlice(config["passthrough_request_headers"]),
+						AllowedResponseHeaders:    toStringSlice(config["allowed_response_headers"]),
+					},
+					Local:                 toBool(mount["local"]),
+					SealWrap:              toBool(mount["seal_wrap"]),
+					ExternalEntropyAccess: toBool(mount["external_entropy_access"]),
+					Options:               toStringMap(mount["options"]),
+				},
+				Path:             vaultutils.Path(path),
+				Name:             name,
+				ManagementPolicy: i.managementPolicy,
+			},
+		}
+		tunePayload, err := i.read(secretEngineMount.GetEngineTunePath())
+		if err != nil {
+			return nil, err
+		}
+		imported = append(imported, importedObject{object: secretEngineMount, path: secretEngineMount.GetEngineTunePath(), payload: tunePayload})
+
+		if roleImporter, ok := secretEngineRoleImporters[secretEngineMount.Spec.Type]; ok {
+			roles, err := i.importRoles(roleImporter, "", mountPath)
+			if err != nil {
+				return nil, err
+			}
+			imported = append(imported, roles...)
+		}
+		i.warnUnimportedRoles(unimportedSecretEngineRoleKinds[secretEngineMount.Spec.Type], secretEngineMount.Spec.Type, mountPath)
+	}
+	return imported, nil
+}
+
+func (i *Importer) importAuthEngineMounts(ctx context.Context) ([]importedObject, error) {
+	mounts, err := i.read("sys/auth")
+	if err != nil {
+		return nil, err
+	}
+	imported := []importedObject{}
+	for _, key := range sortedKeys(mounts) {
+		mount, ok := mounts[key].(map[string]interface{})
+		if !ok || systemAuthEngineTypes[toString(mount["type"])] {
+			continue
+		}
+		mountPath := strings.Trim(key, "/")
+		path, name := splitMountPath(mountPath)
+		if path == "" {
+			// spec.path is required, an AuthEngineMount always mounts the engine at {spec.path}/{spec.name}
+			i.warnf("AuthEngineMount: the auth engine mounted at auth/%s cannot be described by an AuthEngineMount, only its roles are imported", mountPath)
+		} else {
+			config, _ := mount["config"].(map[string]interface{})
+			authEngineMount := &redhatcopv1alpha1.AuthEngineMount{
+				TypeMeta:   typeMeta("AuthEngineMount"),
+				ObjectMeta: i.objectMeta("AuthEngineMount", mountPath),
+				Spec: redhatcopv1alpha1.AuthEngineMountSpec{
+					Authentication: i.authentication,
+					AuthMount: redhatcopv1alpha1.AuthMount{
+						Type:        toString(mount["type"]),
+						Description: toString(mount["description"]),
+						Config: redhatcopv1alpha1.AuthMountConfig{
+							DefaultLeaseTTL:           toTTL(config["default_lease_ttl"]),
+							MaxLeaseTTL:               toTTL(config["max_lease_ttl"]),
+							AuditNonHMACRequestKeys:   toStringSlice(config["audit_non_hmac_request_keys"]),
+							AuditNonHMACResponseKeys:  toStringSlice(config["audit_non_hmac_response_keys"]),
+							ListingVisibility:         toString(config["listing_visibility"]),
+							PassthroughRequestHeaders: toStringSlice(config["passthrough_request_headers"]),
+							AllowedResponseHeaders:    toStringSlice(config["allowed_response_headers"]),
+							Options:                   toStringMap(mount["options"]),
+							TokenType:                 toString(config["token_type"]),
+						},
+						Local:    toBool(mount["local"]),
+						SealWrap: toBool(mount["seal_wrap"]),
+					},
+					Path:             vaultutils.Path(path),
+					Name:             name,
+					ManagementPolicy: i.managementPolicy,
+				},
+			}
+			// the description is tuned through the config of auth engines
+			if description := toString(mount["description"]); description != "" {
+				authEngineMount.Spec.Config.Description = &description
+			}
+			tunePayload, err := i.read(authEngineMount.GetEngineTunePath())
+			if err != nil {
+				return nil, err
+			}
+			imported = append(imported, importedObject{object: authEngineMount, path: authEngineMount.GetEngineTunePath(), payload: tunePayload})
+		}
+
+		if roleImporter, ok := authEngineRoleImporters[toString(mount["type"])]; ok {
+			roles, err := i.importRoles(roleImporter, "auth/", mountPath)
+			if err != nil {
+				return nil, err
+			}
+			imported = append(imported, roles...)
+		}
+		i.warnUnimportedRoles(unimportedAuthEngineRoleKinds[toString(mount["type"])], toString(mount["type"]), "auth/"+mountPath)
+	}
+	return imported, nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
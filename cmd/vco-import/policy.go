@@ -0,0 +1,62 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+)
+
+// builtinPolicies cannot be deleted from Vault, deleting a Policy resource managing them would fail
+var builtinPolicies = map[string]bool{
+	"root":    true,
+	"default": true,
+}
+
+func (i *Importer) importPolicies(ctx context.Context) ([]importedObject, error) {
+	names, err := i.list("sys/policies/acl")
+	if err != nil {
+		return nil, err
+	}
+	imported := []importedObject{}
+	for _, name := range names {
+		if builtinPolicies[name] {
+			continue
+		}
+		payload, err := i.read("sys/policies/acl/" + name)
+		if err != nil {
+			return nil, err
+		}
+		if payload == nil {
+			continue
+		}
+		policy := &redhatcopv1alpha1.Policy{
+			TypeMeta:   typeMeta("Policy"),
+			ObjectMeta: i.objectMeta("Policy", name),
+			Spec: redhatcopv1alpha1.PolicySpec{
+				Authentication:   i.authentication,
+				Policy:           toString(payload["policy"]),
+				Type:             "acl",
+				Name:             name,
+				ManagementPolicy: i.managementPolicy,
+			},
+		}
+		imported = append(imported, importedObject{object: policy, path: policy.GetPath(), payload: payload})
+	}
+	return imported, nil
+}
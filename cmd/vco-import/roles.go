@@ -0,0 +1,153 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"strings"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// roleImporter builds the resources describing the roles of a secret or auth engine
+type roleImporter struct {
+	// rolesPath is the path of the roles, relative to the mount
+	rolesPath string
+	newRole   func(i *Importer, mountPath string, name string, payload map[string]interface{}) client.Object
+}
+
+// secretEngineRoleImporters are keyed by secret engine type
+var secretEngineRoleImporters = map[string]roleImporter{
+	"database": {rolesPath: "roles", newRole: (*Importer).newDatabaseSecretEngineRole},
+}
+
+// authEngineRoleImporters are keyed by auth engine type
+var authEngineRoleImporters = map[string]roleImporter{
+	"kubernetes": {rolesPath: "role", newRole: (*Importer).newKubernetesAuthEngineRole},
+}
+
+// unimportedSecretEngineRoleKinds are the kinds, keyed by secret engine type, of the roles this operator manages but does not import yet
+var unimportedSecretEngineRoleKinds = map[string][]string{
+	"aws":                         {"AWSSecretEngineRole"},
+	"azure":                       {"AzureSecretEngineRole"},
+	"database":                    {"DatabaseSecretEngineStaticRole"},
+	"kubernetes":                  {"KubernetesSecretEngineRole"},
+	"ldap":                        {"LDAPSecretEngineDynamicRole", "LDAPSecretEngineStaticRole", "LDAPSecretEngineLibrary"},
+	"pki":                         {"PKISecretEngineRole"},
+	"rabbitmq":                    {"RabbitMQSecretEngineRole"},
+	"ssh":                         {"SSHSecretEngineRole"},
+	"vault-plugin-secrets-github": {"GitHubSecretEngineRole"},
+	"vault-plugin-secrets-quay":   {"QuaySecretEngineRole", "QuaySecretEngineStaticRole"},
+}
+
+// unimportedAuthEngineRoleKinds are the kinds, keyed by auth engine type, of the roles this operator manages but does not import yet
+var unimportedAuthEngineRoleKinds = map[string][]string{
+	"azure": {"AzureAuthEngineRole"},
+	"cert":  {"CertAuthEngineRole"},
+	"gcp":   {"GCPAuthEngineRole"},
+	"jwt":   {"JWTOIDCAuthEngineRole"},
+	"ldap":  {"LDAPAuthEngineGroup"},
+	"oidc":  {"JWTOIDCAuthEngineRole"},
+}
+
+// warnUnimportedRoles reports the engine mounted at mountPath, whose roles of the passed kinds are skipped
+func (i *Importer) warnUnimportedRoles(kinds []string, engineType string, mountPath string) {
+	if len(kinds) == 0 {
+		return
+	}
+	i.warnf("%s: the roles of the %s engine mounted at %s are not imported, describe them by hand", strings.Join(kinds, ", "), engineType, mountPath)
+}
+
+func (i *Importer) importRoles(roleImporter roleImporter, prefix string, mountPath string) ([]importedObject, error) {
+	rolesPath := prefix + mountPath + "/" + roleImporter.rolesPath
+	names, err := i.list(rolesPath)
+	if err != nil {
+		return nil, err
+	}
+	imported := []importedObject{}
+	for _, name := range names {
+		payload, err := i.read(rolesPath + "/" + name)
+		if err != nil {
+			return nil, err
+		}
+		if payload == nil {
+			continue
+		}
+		role := roleImporter.newRole(i, mountPath, name, payload)
+		imported = append(imported, importedObject{object: role, path: role.(vaultutils.VaultObject).GetPath(), payload: payload})
+	}
+	return imported, nil
+}
+
+func (i *Importer) newDatabaseSecretEngineRole(mountPath string, name string, payload map[string]interface{}) client.Object {
+	return &redhatcopv1alpha1.DatabaseSecretEngineRole{
+		TypeMeta:   typeMeta("DatabaseSecretEngineRole"),
+		ObjectMeta: i.objectMeta("DatabaseSecretEngineRole", mountPath, name),
+		Spec: redhatcopv1alpha1.DatabaseSecretEngineRoleSpec{
+			Authentication: i.authentication,
+			Path:           vaultutils.Path(mountPath),
+			DBSERole: redhatcopv1alpha1.DBSERole{
+				DBName:               toString(payload["db_name"]),
+				DefaultTTL:           toDuration(payload["default_ttl"]),
+				MaxTTL:               toDuration(payload["max_ttl"]),
+				CreationStatements:   toStringSlice(payload["creation_statements"]),
+				RevocationStatements: toStringSlice(payload["revocation_statements"]),
+				RollbackStatements:   toStringSlice(payload["rollback_statements"]),
+				RenewStatements:      toStringSlice(payload["renew_statements"]),
+			},
+			Name:             name,
+			ManagementPolicy: i.managementPolicy,
+		},
+	}
+}
+
+func (i *Importer) newKubernetesAuthEngineRole(mountPath string, name string, payload map[string]interface{}) client.Object {
+	role := &redhatcopv1alpha1.KubernetesAuthEngineRole{
+		TypeMeta:   typeMeta("KubernetesAuthEngineRole"),
+		ObjectMeta: i.objectMeta("KubernetesAuthEngineRole", mountPath, name),
+		Spec: redhatcopv1alpha1.KubernetesAuthEngineRoleSpec{
+			Authentication: i.authentication,
+			Path:           vaultutils.Path(mountPath),
+			VRole: redhatcopv1alpha1.VRole{
+				TargetServiceAccounts: toStringSlice(payload["bound_service_account_names"]),
+				AliasNameSource:       toString(payload["alias_name_source"]),
+				TokenTTL:              toInt(payload["token_ttl"]),
+				Policies:              toStringSlice(payload["token_policies"]),
+				TokenMaxTTL:           toInt(payload["token_max_ttl"]),
+				TokenBoundCIDRs:       toStringSlice(payload["token_bound_cidrs"]),
+				TokenExplicitMaxTTL:   toInt(payload["token_explicit_max_ttl"]),
+				TokenNoDefaultPolicy:  toBool(payload["token_no_default_policy"]),
+				TokenNumUses:          toInt(payload["token_num_uses"]),
+				TokenPeriod:           toInt(payload["token_period"]),
+				TokenType:             toString(payload["token_type"]),
+			},
+			TargetNamespaces: vaultutils.TargetNamespaceConfig{
+				TargetNamespaces: toStringSlice(payload["bound_service_account_namespaces"]),
+			},
+			Name:             name,
+			ManagementPolicy: i.managementPolicy,
+		},
+	}
+	if audience := toString(payload["audience"]); audience != "" {
+		role.Spec.Audience = &audience
+	}
+	if selector := toString(payload["bound_service_account_namespace_selector"]); selector != "" {
+		i.warnf("KubernetesAuthEngineRole %s: bound_service_account_namespace_selector cannot be imported, set spec.targetNamespaces.targetNamespaceSelector to the equivalent label selector", role.Name)
+	}
+	return role
+}
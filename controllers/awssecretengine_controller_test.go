@@ -0,0 +1,315 @@
+//go:build integration
+// +build integration
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("AWSSecretEngine controllers", func() {
+
+	timeout := time.Second * 120
+	interval := time.Second * 2
+
+	Context("When preparing an AWS Secret Engine", func() {
+		It("Should create an AWS Secret Engine when created", func() {
+			By("By creating new Policies")
+			pInstance, err := decoder.GetPolicyInstance("../test/awssecretengine/aws-secret-engine-admin-policy.yaml")
+			Expect(err).To(BeNil())
+			pInstance.Namespace = vaultAdminNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, pInstance)).Should(Succeed())
+
+			pLookupKey := types.NamespacedName{Name: pInstance.Name, Namespace: pInstance.Namespace}
+			pCreated := &redhatcopv1alpha1.Policy{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, pLookupKey, pCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range pCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			kaerInstance, err := decoder.GetKubernetesAuthEngineRoleInstance("../test/awssecretengine/aws-secret-engine-kube-auth-role.yaml")
+			Expect(err).To(BeNil())
+			kaerInstance.Namespace = vaultAdminNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, kaerInstance)).Should(Succeed())
+
+			kaerLookupKey := types.NamespacedName{Name: kaerInstance.Name, Namespace: kaerInstance.Namespace}
+			kaerCreated := &redhatcopv1alpha1.KubernetesAuthEngineRole{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, kaerLookupKey, kaerCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range kaerCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			By("By creating a new SecretEngineMount")
+
+			semInstance, err := decoder.GetSecretEngineMountInstance("../test/awssecretengine/aws-secret-engine.yaml")
+			Expect(err).To(BeNil())
+			semInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, semInstance)).Should(Succeed())
+
+			semLookupKey := types.NamespacedName{Name: semInstance.Name, Namespace: semInstance.Namespace}
+			semCreated := &redhatcopv1alpha1.SecretEngineMount{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, semLookupKey, semCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range semCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
+	Context("When creating an AWSSecretEngineConfig", func() {
+		It("Should configure the root credentials and the lease when created", func() {
+
+			By("By creating the secret of the root credentials")
+
+			rootCredentials := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-root-credentials", Namespace: vaultTestNamespaceName},
+				StringData: map[string]string{"username": "AKIAEXAMPLE", "password": "s3cr3t"},
+			}
+			Expect(k8sIntegrationClient.Create(ctx, rootCredentials)).Should(Succeed())
+
+			awsConfigInstance, err := decoder.GetAWSSecretEngineConfigInstance("../test/awssecretengine/aws-secret-engine-config.yaml")
+			Expect(err).To(BeNil())
+			awsConfigInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, awsConfigInstance)).Should(Succeed())
+
+			awsConfigLookupKey := types.NamespacedName{Name: awsConfigInstance.Name, Namespace: awsConfigInstance.Namespace}
+			awsConfigCreated := &redhatcopv1alpha1.AWSSecretEngineConfig{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, awsConfigLookupKey, awsConfigCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range awsConfigCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			By("Reading the configuration back from Vault")
+
+			secret, err := vaultClient.Logical().Read(awsConfigInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["access_key"]).To(Equal("AKIAEXAMPLE"))
+			Expect(secret.Data["region"]).To(Equal("us-east-1"))
+
+			secret, err = vaultClient.Logical().Read(awsConfigInstance.GetLeasePath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["lease"]).To(Equal("1h0m0s"))
+			Expect(secret.Data["lease_max"]).To(Equal("24h0m0s"))
+		})
+	})
+
+	Context("When creating an AWSSecretEngineRole", func() {
+		It("Should create the role when created and update it when changed", func() {
+
+			awsRoleInstance, err := decoder.GetAWSSecretEngineRoleInstance("../test/awssecretengine/aws-secret-engine-role.yaml")
+			Expect(err).To(BeNil())
+			awsRoleInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, awsRoleInstance)).Should(Succeed())
+
+			awsRoleLookupKey := types.NamespacedName{Name: awsRoleInstance.Name, Namespace: awsRoleInstance.Namespace}
+			awsRoleCreated := &redhatcopv1alpha1.AWSSecretEngineRole{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, awsRoleLookupKey, awsRoleCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range awsRoleCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			By("Reading the role back from Vault")
+
+			secret, err := vaultClient.Logical().Read(awsRoleInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["credential_type"]).To(Equal("assumed_role"))
+			Expect(secret.Data["role_arns"]).To(Equal([]interface{}{"arn:aws:iam::123456789012:role/deployer"}))
+			Expect(secret.Data["default_sts_ttl"]).To(Equal(json.Number("3600")))
+
+			By("Updating the default sts ttl of the role")
+
+			Eventually(func() error {
+				err := k8sIntegrationClient.Get(ctx, awsRoleLookupKey, awsRoleCreated)
+				if err != nil {
+					return err
+				}
+				awsRoleCreated.Spec.DefaultSTSTTL = metav1.Duration{Duration: 2 * time.Hour}
+				return k8sIntegrationClient.Update(ctx, awsRoleCreated)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() error {
+				secret, err := vaultClient.Logical().Read(awsRoleInstance.GetPath())
+				if err != nil {
+					return err
+				}
+				if secret == nil {
+					return fmt.Errorf("role %s not found", awsRoleInstance.GetPath())
+				}
+				if secret.Data["default_sts_ttl"] != json.Number("7200") {
+					return fmt.Errorf("unexpected default_sts_ttl %v", secret.Data["default_sts_ttl"])
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	Context("When deleting the AWS Secret Engine resources", func() {
+		It("They should be deleted from Vault", func() {
+
+			By("Deleting AWSSecretEngineRole")
+
+			awsRoleInstance, err := decoder.GetAWSSecretEngineRoleInstance("../test/awssecretengine/aws-secret-engine-role.yaml")
+			Expect(err).To(BeNil())
+			awsRoleInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, awsRoleInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(awsRoleInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting AWSSecretEngineConfig, whose root configuration is left in Vault until the engine is unmounted")
+
+			awsConfigInstance, err := decoder.GetAWSSecretEngineConfigInstance("../test/awssecretengine/aws-secret-engine-config.yaml")
+			Expect(err).To(BeNil())
+			awsConfigInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, awsConfigInstance)).Should(Succeed())
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, types.NamespacedName{Name: awsConfigInstance.Name, Namespace: awsConfigInstance.Namespace}, &redhatcopv1alpha1.AWSSecretEngineConfig{})
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+
+			Expect(k8sIntegrationClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "aws-root-credentials", Namespace: vaultTestNamespaceName}})).Should(Succeed())
+
+			By("Deleting SecretEngineMount")
+
+			semInstance, err := decoder.GetSecretEngineMountInstance("../test/awssecretengine/aws-secret-engine.yaml")
+			Expect(err).To(BeNil())
+			semInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, semInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(semInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting KubernetesAuthEngineRole")
+
+			kaerInstance, err := decoder.GetKubernetesAuthEngineRoleInstance("../test/awssecretengine/aws-secret-engine-kube-auth-role.yaml")
+			Expect(err).To(BeNil())
+			kaerInstance.Namespace = vaultAdminNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, kaerInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(kaerInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting Policy")
+
+			pInstance, err := decoder.GetPolicyInstance("../test/awssecretengine/aws-secret-engine-admin-policy.yaml")
+			Expect(err).To(BeNil())
+			pInstance.Namespace = vaultAdminNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, pInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(pInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+})
@@ -182,7 +182,7 @@ func (r *AzureAuthEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager) err
 			}
 			return res
 		}), builder.WithPredicates(isUpdatedRandomSecret)).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("AzureAuthEngineConfig", r))
 
 }
 
@@ -71,5 +71,5 @@ func (r *AzureAuthEngineRoleReconciler) Reconcile(ctx context.Context, req ctrl.
 func (r *AzureAuthEngineRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.AzureAuthEngineRole{}, builder.WithPredicates(vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("AzureAuthEngineRole", r))
 }
@@ -188,7 +188,7 @@ func (r *AzureSecretEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager) e
 			}
 			return res
 		}), builder.WithPredicates(isUpdatedRandomSecret)).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("AzureSecretEngineConfig", r))
 
 }
 
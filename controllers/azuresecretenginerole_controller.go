@@ -70,5 +70,5 @@ func (r *AzureSecretEngineRoleReconciler) Reconcile(ctx context.Context, req ctr
 func (r *AzureSecretEngineRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.AzureSecretEngineRole{}, builder.WithPredicates(vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("AzureSecretEngineRole", r))
 }
@@ -73,5 +73,5 @@ func (r *CertAuthEngineConfigReconciler) Reconcile(ctx context.Context, req ctrl
 func (r *CertAuthEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.CertAuthEngineConfig{}).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("CertAuthEngineConfig", r))
 }
@@ -73,5 +73,5 @@ func (r *CertAuthEngineRoleReconciler) Reconcile(ctx context.Context, req ctrl.R
 func (r *CertAuthEngineRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.CertAuthEngineRole{}).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("CertAuthEngineRole", r))
 }
@@ -0,0 +1,433 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	vaultsecretutils "github.com/redhat-cop/vault-config-operator/controllers/vaultsecretutils"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const clusterVaultSecretKind = "ClusterVaultSecret"
+
+// ClusterVaultSecretReconciler reconciles a ClusterVaultSecret object
+type ClusterVaultSecretReconciler struct {
+	vaultresourcecontroller.ReconcilerBase
+	// VaultEvents, when set, notifies the KV v2 secret writes that trigger an immediate refresh of the ClusterVaultSecrets reading them
+	VaultEvents     *vaultresourcecontroller.VaultEventSubscriber
+	refreshRequests vaultresourcecontroller.RefreshRequests
+}
+
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=clustervaultsecrets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=clustervaultsecrets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=clustervaultsecrets/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
+//+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;patch
+
+// Reconcile reads the secrets of a ClusterVaultSecret from Vault once and outputs them to each of its target namespaces.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.9.2/pkg/reconcile
+func (r *ClusterVaultSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	// Fetch the instance
+	instance := &redhatcopv1alpha1.ClusterVaultSecret{}
+	err := r.GetClient().Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	ctx = vaultutils.WithKubeClient(ctx, r.GetClient())
+	ctx = vaultutils.WithRestConfig(ctx, r.GetRestConfig())
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		if !controllerutil.ContainsFinalizer(instance, vaultutils.GetFinalizer(instance)) {
+			return reconcile.Result{}, nil
+		}
+		err := r.manageCleanUpLogic(ctx, instance)
+		if err != nil {
+			r.Log.Error(err, "unable to delete instance", "instance", instance)
+			return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+		}
+		controllerutil.RemoveFinalizer(instance, vaultutils.GetFinalizer(instance))
+		err = r.GetClient().Update(ctx, instance)
+		if err != nil {
+			r.Log.Error(err, "unable to update instance", "instance", instance)
+			return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	targetNamespaces, err := r.getTargetNamespaces(ctx, instance)
+	if err != nil {
+		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+	}
+
+	// a conflict in a namespace does not prevent the output to the other namespaces, it is reported once they are synced
+	conflicts := &multierror.Error{}
+	inSyncNamespaces, outOfSyncNamespaces := []string{}, []string{}
+	for _, namespace := range targetNamespaces {
+		outputInSync, err := isOutputInSync(ctx, r.GetClient(), instance, &instance.Spec.TemplatizedK8sSecret, namespace)
+		switch {
+		case err != nil:
+			conflicts = multierror.Append(conflicts, err)
+		case outputInSync:
+			inSyncNamespaces = append(inSyncNamespaces, namespace)
+		default:
+			outOfSyncNamespaces = append(outOfSyncNamespaces, namespace)
+		}
+	}
+	syncedNamespaces := append(append([]string{}, inSyncNamespaces...), outOfSyncNamespaces...)
+	sort.Strings(syncedNamespaces)
+
+	// a refresh requested by a vault event reads the secrets regardless of the refresh schedule
+	refreshRequested := r.refreshRequests.Take(req.NamespacedName)
+
+	switch {
+	case refreshRequested:
+		err = r.manageSyncLogic(ctx, instance, syncedNamespaces)
+		if err != nil {
+			r.refreshRequests.Request(req.NamespacedName)
+		}
+	case isRefreshDue(instance.Spec.RefreshPeriod, instance.Spec.RefreshThreshold, instance.Status.LastVaultSecretUpdate, instance.Status.LastLeaseRenewal, instance.Status.VaultSecretDefinitionsStatus):
+		// when only the refresh of the secrets is due, their leases are renewed rather than new credentials read
+		if len(outOfSyncNamespaces) > 0 || !r.manageRenewalLogic(ctx, instance) {
+			err = r.manageSyncLogic(ctx, instance, syncedNamespaces)
+		}
+	case len(outOfSyncNamespaces) > 0 && len(inSyncNamespaces) > 0 && (instance.Spec.TemplatizedK8sSecret.IsSecret() || instance.Spec.TemplatizedK8sSecret.IsConfigMap()):
+		// the outputs in sync hold the data read at the last sync, it is copied to the other namespaces without reading new credentials
+		err = r.manageCopyLogic(ctx, instance, inSyncNamespaces[0], outOfSyncNamespaces)
+	case len(outOfSyncNamespaces) > 0:
+		err = r.manageSyncLogic(ctx, instance, syncedNamespaces)
+	}
+	if err != nil {
+		r.Log.Error(err, "unable to complete sync logic", "instance", instance)
+		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+	}
+
+	// the output is removed from the namespaces that are no longer targeted
+	for _, namespace := range instance.Status.TargetNamespaces {
+		if !slices.Contains(syncedNamespaces, namespace) {
+			err = r.deleteOutput(ctx, instance, namespace)
+			if err != nil {
+				return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+			}
+		}
+	}
+	instance.Status.TargetNamespaces = syncedNamespaces
+
+	instance.Status.SupersededLeases = revokeSupersededLeases(ctx, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.VaultSecretDefinitions, instance.Status.SupersededLeases, false)
+	nextRevocation, revocationPending := nextLeaseRevocation(instance.Status.SupersededLeases)
+
+	duration, ok := calculateDuration(instance.Spec.RefreshPeriod, instance.Spec.RefreshThreshold, instance.Status.VaultSecretDefinitionsStatus)
+
+	// If a duration incalculable, simply don't requeue, unless superseded leases remain to be revoked
+	if !ok {
+		instance.Status.NextVaultSecretUpdate = nil
+		if revocationPending {
+			return vaultresourcecontroller.ManageOutcomeWithRequeue(ctx, r.ReconcilerBase, instance, conflicts.ErrorOrNil(), nextRevocation)
+		}
+		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, conflicts.ErrorOrNil())
+	}
+
+	nextUpdateTime := lastRefresh(instance.Status.LastVaultSecretUpdate, instance.Status.LastLeaseRenewal).Add(duration)
+
+	nextTimestamp := metav1.NewTime(nextUpdateTime)
+	instance.Status.NextVaultSecretUpdate = &nextTimestamp
+
+	//we reschedule the next reconcile at the time in the future corresponding to
+	nextSchedule := time.Until(nextUpdateTime)
+	if revocationPending && nextRevocation < nextSchedule {
+		nextSchedule = nextRevocation
+	}
+	if nextSchedule <= 0 {
+		nextSchedule = time.Second
+	}
+	return vaultresourcecontroller.ManageOutcomeWithRequeue(ctx, r.ReconcilerBase, instance, conflicts.ErrorOrNil(), nextSchedule)
+}
+
+func (r *ClusterVaultSecretReconciler) manageCleanUpLogic(context context.Context, instance *redhatcopv1alpha1.ClusterVaultSecret) error {
+	for _, namespace := range instance.Status.TargetNamespaces {
+		err := r.deleteOutput(context, instance, namespace)
+		if err != nil {
+			return err
+		}
+	}
+	revokeLeases(context, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.VaultSecretDefinitions, instance.Status.VaultSecretDefinitionsStatus)
+	revokeSupersededLeases(context, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.VaultSecretDefinitions, instance.Status.SupersededLeases, true)
+	return nil
+}
+
+// deleteOutput deletes the output of instance in namespace, unless it is not owned by instance
+func (r *ClusterVaultSecretReconciler) deleteOutput(ctx context.Context, instance *redhatcopv1alpha1.ClusterVaultSecret, namespace string) error {
+	output := vaultsecretutils.NewOutputObject(&instance.Spec.TemplatizedK8sSecret, namespace)
+	err := r.GetClient().Get(ctx, client.ObjectKeyFromObject(output), output)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !vaultresourcecontroller.IsOwner(instance, output) {
+		return nil
+	}
+	err = r.DeleteResourceIfExists(ctx, output)
+	if err != nil {
+		r.Log.Error(err, "unable to delete k8s output", "instance", instance, "namespace", namespace)
+		return err
+	}
+	return nil
+}
+
+// getTargetNamespaces returns the sorted names of the existing namespaces selected by instance, except the namespaces being deleted
+func (r *ClusterVaultSecretReconciler) getTargetNamespaces(ctx context.Context, instance *redhatcopv1alpha1.ClusterVaultSecret) ([]string, error) {
+	namespaces := []corev1.Namespace{}
+	if instance.Spec.TargetNamespaces.TargetNamespaceSelector != nil {
+		labelSelector, err := metav1.LabelSelectorAsSelector(instance.Spec.TargetNamespaces.TargetNamespaceSelector)
+		if err != nil {
+			r.Log.Error(err, "unable to create selector from label selector", "selector", instance.Spec.TargetNamespaces.TargetNamespaceSelector)
+			return nil, err
+		}
+		namespaceList := &corev1.NamespaceList{}
+		err = r.GetClient().List(ctx, namespaceList, &client.ListOptions{LabelSelector: labelSelector})
+		if err != nil {
+			r.Log.Error(err, "unable to retrieve the list of namespaces")
+			return nil, err
+		}
+		namespaces = namespaceList.Items
+	} else {
+		for _, name := range instance.Spec.TargetNamespaces.TargetNamespaces {
+			namespace := corev1.Namespace{}
+			err := r.GetClient().Get(ctx, types.NamespacedName{Name: name}, &namespace)
+			if err != nil {
+				// the output is written when the namespace is created
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	result := []string{}
+	for i := range namespaces {
+		if namespaces[i].GetDeletionTimestamp().IsZero() {
+			result = append(result, namespaces[i].Name)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// manageRenewalLogic renews the leases of the secrets of instance, it returns false when new credentials must be read instead
+func (r *ClusterVaultSecretReconciler) manageRenewalLogic(ctx context.Context, instance *redhatcopv1alpha1.ClusterVaultSecret) bool {
+	definitionsStatus, ok := renewLeases(ctx, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.RefreshPeriod, instance.Spec.VaultSecretDefinitions, instance.Status.VaultSecretDefinitionsStatus)
+	if !ok {
+		return false
+	}
+	r.Log.V(1).Info("renewed leases", "name", instance.Name)
+	now := metav1.NewTime(time.Now())
+	instance.Status.LastLeaseRenewal = &now
+	instance.Status.VaultSecretDefinitionsStatus = definitionsStatus
+	return true
+}
+
+// manageSyncLogic reads the secrets of instance from vault and outputs them to namespaces
+func (r *ClusterVaultSecretReconciler) manageSyncLogic(ctx context.Context, instance *redhatcopv1alpha1.ClusterVaultSecret, namespaces []string) error {
+
+	r.Log.V(1).Info("Sync ClusterVaultSecret", "name", instance.Name)
+
+	namedTemplates, err := getNamedTemplates(ctx, r.GetClient(), &instance.Spec.TemplatizedK8sSecret, instance.Spec.AuthenticationNamespace)
+	if err != nil {
+		return err
+	}
+
+	mergedMap, kvMetadata, definitionsStatus, err := readVaultSecrets(ctx, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.VaultSecretDefinitions)
+	if err != nil {
+		return err
+	}
+
+	output, err := renderOutput(ctx, r.GetRestConfig(), &instance.Spec.TemplatizedK8sSecret, "", mergedMap, templateFuncMap(ctx, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.VaultSecretDefinitions, kvMetadata), namedTemplates)
+	instance.Status.TemplateErrors = templateErrors(err)
+	if err != nil {
+		r.Log.Error(err, "unable to format k8s output", "instance", instance)
+		revokeLeases(ctx, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.VaultSecretDefinitions, definitionsStatus)
+		return err
+	}
+
+	for i, namespace := range namespaces {
+		err = r.ApplyResource(ctx, instance, namespace, output.DeepCopyObject().(client.Object))
+		if err != nil {
+			vaultresourcecontroller.ManageOutputConflict(ctx, r.ReconcilerBase, instance, err)
+			// the new leases are only revoked when no output uses them yet, the secrets are read again at the next reconcile
+			if i == 0 {
+				revokeLeases(ctx, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.VaultSecretDefinitions, definitionsStatus)
+			}
+			return err
+		}
+	}
+
+	vaultresourcecontroller.ManageOutputConflict(ctx, r.ReconcilerBase, instance, nil)
+
+	supersededDefinitionsStatus := instance.Status.VaultSecretDefinitionsStatus
+	now := metav1.NewTime(time.Now())
+	instance.Status.LastVaultSecretUpdate = &now
+	instance.Status.LastLeaseRenewal = nil
+	instance.Status.VaultSecretDefinitionsStatus = definitionsStatus
+
+	// the credentials of the previous leases are no longer written to the outputs, they are revoked once the workloads had time to pick up the new ones
+	instance.Status.SupersededLeases = supersedeLeases(ctx, clusterVaultSecretKind, instance.Spec.AuthenticationNamespace, instance.Spec.VaultSecretDefinitions, instance.Status.SupersededLeases, supersededDefinitionsStatus, instance.Spec.LeaseRevocationGracePeriod)
+
+	return nil
+}
+
+// manageCopyLogic copies the output of instance in sourceNamespace, which is in sync, to namespaces
+func (r *ClusterVaultSecretReconciler) manageCopyLogic(ctx context.Context, instance *redhatcopv1alpha1.ClusterVaultSecret, sourceNamespace string, namespaces []string) error {
+	source := vaultsecretutils.NewOutputObject(&instance.Spec.TemplatizedK8sSecret, sourceNamespace)
+	err := r.GetClient().Get(ctx, client.ObjectKeyFromObject(source), source)
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		r.Log.V(1).Info("Copy ClusterVaultSecret output", "name", instance.Name, "namespace", namespace)
+		output, ok := vaultsecretutils.CopyOutputObject(&instance.Spec.TemplatizedK8sSecret, source, namespace)
+		if !ok {
+			return fmt.Errorf("unable to copy the k8s %s output", instance.Spec.TemplatizedK8sSecret.GetGroupVersionKind().Kind)
+		}
+		err = r.ApplyResource(ctx, instance, namespace, output)
+		vaultresourcecontroller.ManageOutputConflict(ctx, r.ReconcilerBase, instance, err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterVaultSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	k8sOutputPredicate := newOutputPredicate(r.Log, clusterVaultSecretKind)
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&redhatcopv1alpha1.ClusterVaultSecret{}, builder.WithPredicates(vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
+		Owns(&corev1.Secret{}, builder.WithPredicates(k8sOutputPredicate)).
+		Owns(&corev1.ConfigMap{}, builder.WithPredicates(k8sOutputPredicate)).
+		Watches(&corev1.Namespace{
+			TypeMeta: metav1.TypeMeta{
+				Kind: "Namespace",
+			},
+		}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			res := []reconcile.Request{}
+			ns := a.(*corev1.Namespace)
+			cvsl, err := r.findApplicableClusterVaultSecrets(ctx, ns)
+			if err != nil {
+				r.Log.Error(err, "unable to find applicable clusterVaultSecrets for namespace", "namespace", ns.Name)
+				return []reconcile.Request{}
+			}
+			for _, clusterVaultSecret := range cvsl {
+				res = append(res, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name: clusterVaultSecret.GetName(),
+					},
+				})
+			}
+			return res
+		}))
+	if r.VaultEvents != nil {
+		controllerBuilder = controllerBuilder.WatchesRawSource(vaultEventSource(r.VaultEvents, r.findClusterVaultSecretsReading), &handler.EnqueueRequestForObject{})
+	}
+	return controllerBuilder.Complete(vaultresourcecontroller.NewTracingReconciler(clusterVaultSecretKind, r))
+}
+
+// findClusterVaultSecretsReading returns the ClusterVaultSecrets that read the secret at path and requests their refresh
+func (r *ClusterVaultSecretReconciler) findClusterVaultSecretsReading(ctx context.Context, path string) []client.Object {
+	clusterVaultSecrets := &redhatcopv1alpha1.ClusterVaultSecretList{}
+	err := r.GetClient().List(ctx, clusterVaultSecrets)
+	if err != nil {
+		r.Log.Error(err, "unable to list ClusterVaultSecrets", "path", path)
+		return nil
+	}
+	ctx = vaultutils.WithKubeClient(ctx, r.GetClient())
+	objects := []client.Object{}
+	for i := range clusterVaultSecrets.Items {
+		clusterVaultSecret := &clusterVaultSecrets.Items[i]
+		if readsVaultPath(ctx, r.VaultEvents, clusterVaultSecret.Spec.AuthenticationNamespace, clusterVaultSecret.Spec.VaultSecretDefinitions, path) {
+			r.Log.V(1).Info("Vault Event - Secret written", "kind", clusterVaultSecretKind, "name", clusterVaultSecret.Name, "path", path)
+			r.refreshRequests.Request(client.ObjectKeyFromObject(clusterVaultSecret))
+			objects = append(objects, clusterVaultSecret)
+		}
+	}
+	return objects
+}
+
+// findApplicableClusterVaultSecrets returns the ClusterVaultSecrets that target namespace, or that output to namespace while it is no longer targeted
+func (r *ClusterVaultSecretReconciler) findApplicableClusterVaultSecrets(ctx context.Context, namespace *corev1.Namespace) ([]redhatcopv1alpha1.ClusterVaultSecret, error) {
+	result := []redhatcopv1alpha1.ClusterVaultSecret{}
+	cvsl := &redhatcopv1alpha1.ClusterVaultSecretList{}
+	err := r.GetClient().List(ctx, cvsl, &client.ListOptions{})
+	if err != nil {
+		r.Log.Error(err, "unable to retrieve the list of ClusterVaultSecrets")
+		return []redhatcopv1alpha1.ClusterVaultSecret{}, err
+	}
+	for _, cvs := range cvsl.Items {
+		if slices.Contains(cvs.Status.TargetNamespaces, namespace.Name) || slices.Contains(cvs.Spec.TargetNamespaces.TargetNamespaces, namespace.Name) {
+			result = append(result, cvs)
+			continue
+		}
+		if cvs.Spec.TargetNamespaces.TargetNamespaceSelector != nil {
+			labelSelector, err := metav1.LabelSelectorAsSelector(cvs.Spec.TargetNamespaces.TargetNamespaceSelector)
+			if err != nil {
+				r.Log.Error(err, "unable to create selector from label selector", "selector", cvs.Spec.TargetNamespaces.TargetNamespaceSelector)
+				return []redhatcopv1alpha1.ClusterVaultSecret{}, err
+			}
+			if labelSelector.Matches(labels.Set(namespace.GetLabels())) {
+				result = append(result, cvs)
+			}
+		}
+	}
+	return result, nil
+}
@@ -17,14 +17,18 @@ type VaultAuthenticableResource interface {
 
 func prepareContext(ctx context.Context, r vaultresourcecontroller.ReconcilerBase, VAR VaultAuthenticableResource) (context.Context, error) {
 	rlog := log.FromContext(ctx)
-	ctx = context.WithValue(ctx, "kubeClient", r.GetClient())
-	ctx = context.WithValue(ctx, "restConfig", r.GetRestConfig())
-	ctx = context.WithValue(ctx, "vaultConnection", VAR.GetVaultConnection())
-	vaultClient, err := VAR.GetKubeAuthConfiguration().GetVaultClient(ctx, VAR.GetNamespace())
+	ctx = vaultutils.WithKubeClient(ctx, r.GetClient())
+	ctx = vaultutils.WithRestConfig(ctx, r.GetRestConfig())
+	ctx = vaultutils.WithVaultConnection(ctx, VAR.GetVaultConnection())
+	ctx = vaultutils.WithReconciledKind(ctx, VAR.GetObjectKind().GroupVersionKind().Kind)
+	// the span only covers the preparation, the reconcile cycle continues in the span of ctx
+	spanCtx, span := vaultutils.StartSpan(ctx, "prepareContext")
+	vaultClient, err := VAR.GetKubeAuthConfiguration().GetVaultClient(spanCtx, VAR.GetNamespace())
+	vaultutils.EndSpan(span, err)
 	if err != nil {
 		rlog.Error(err, "unable to create vault client", "KubeAuthConfiguration", VAR.GetKubeAuthConfiguration(), "namespace", VAR.GetNamespace())
 		return nil, err
 	}
-	ctx = context.WithValue(ctx, "vaultClient", vaultClient)
+	ctx = vaultutils.WithVaultClient(ctx, vaultClient)
 	return ctx, nil
 }
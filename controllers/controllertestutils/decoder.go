@@ -186,3 +186,153 @@ func (d *decoder) GetDatabaseSecretEngineStaticRoleInstance(filename string) (*r
 
 	return nil, errDecode
 }
+
+func (d *decoder) GetSSHSecretEngineConfigInstance(filename string) (*redhatcopv1alpha1.SSHSecretEngineConfig, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.SSHSecretEngineConfig{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.SSHSecretEngineConfig)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetSSHSecretEngineRoleInstance(filename string) (*redhatcopv1alpha1.SSHSecretEngineRole, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.SSHSecretEngineRole{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.SSHSecretEngineRole)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetAWSSecretEngineConfigInstance(filename string) (*redhatcopv1alpha1.AWSSecretEngineConfig, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.AWSSecretEngineConfig{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.AWSSecretEngineConfig)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetAWSSecretEngineRoleInstance(filename string) (*redhatcopv1alpha1.AWSSecretEngineRole, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.AWSSecretEngineRole{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.AWSSecretEngineRole)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetLDAPSecretEngineConfigInstance(filename string) (*redhatcopv1alpha1.LDAPSecretEngineConfig, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.LDAPSecretEngineConfig{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.LDAPSecretEngineConfig)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetLDAPSecretEngineStaticRoleInstance(filename string) (*redhatcopv1alpha1.LDAPSecretEngineStaticRole, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.LDAPSecretEngineStaticRole{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.LDAPSecretEngineStaticRole)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetLDAPSecretEngineDynamicRoleInstance(filename string) (*redhatcopv1alpha1.LDAPSecretEngineDynamicRole, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.LDAPSecretEngineDynamicRole{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.LDAPSecretEngineDynamicRole)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetLDAPSecretEngineLibraryInstance(filename string) (*redhatcopv1alpha1.LDAPSecretEngineLibrary, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.LDAPSecretEngineLibrary{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.LDAPSecretEngineLibrary)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetEntityInstance(filename string) (*redhatcopv1alpha1.Entity, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.Entity{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.Entity)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
+
+func (d *decoder) GetEntityAliasInstance(filename string) (*redhatcopv1alpha1.EntityAlias, error) {
+	obj, groupKindVersion, err := d.decodeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := reflect.TypeOf(redhatcopv1alpha1.EntityAlias{}).Name()
+	if groupKindVersion.Kind == kind {
+		o := obj.(*redhatcopv1alpha1.EntityAlias)
+		return o, nil
+	}
+
+	return nil, errDecode
+}
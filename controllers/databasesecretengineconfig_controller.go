@@ -22,6 +22,7 @@ import (
 	"time"
 
 	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -97,7 +98,7 @@ func (r *DatabaseSecretEngineConfigReconciler) Reconcile(ctx context.Context, re
 	//    and reschedule for the period
 	// if rotation is requested and rotation period is defined and we are at more than 95% reschedule for the remainder of the period
 
-	if instance.Spec.RootPasswordRotation != nil && instance.Spec.RootPasswordRotation.Enable {
+	if instance.Spec.RootPasswordRotation != nil && instance.Spec.RootPasswordRotation.Enable && !vaultutils.IsObserveOnly(instance) {
 		log.V(1).Info("we need to rotate the password")
 		if instance.Status.LastRootPasswordRotation.IsZero() {
 			log.V(1).Info("first password rotation")
@@ -255,7 +256,7 @@ func (r *DatabaseSecretEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager
 			}
 			return res
 		}), builder.WithPredicates(isUpdatedRandomSecret)).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("DatabaseSecretEngineConfig", r))
 }
 
 func (r *DatabaseSecretEngineConfigReconciler) findApplicableBDSCForSecret(ctx context.Context, secret *corev1.Secret) ([]redhatcopv1alpha1.DatabaseSecretEngineConfig, error) {
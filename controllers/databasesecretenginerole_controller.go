@@ -81,5 +81,5 @@ func (r *DatabaseSecretEngineRoleReconciler) Reconcile(ctx context.Context, req
 func (r *DatabaseSecretEngineRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.DatabaseSecretEngineRole{}, builder.WithPredicates(vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("DatabaseSecretEngineRole", r))
 }
@@ -0,0 +1,200 @@
+//go:build integration
+// +build integration
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Entity controllers", func() {
+
+	timeout := time.Second * 120
+	interval := time.Second * 2
+
+	Context("When creating an Entity", func() {
+		It("Should create the entity when created and update it when changed", func() {
+
+			eInstance, err := decoder.GetEntityInstance("../test/entities/entity.yaml")
+			Expect(err).To(BeNil())
+			eInstance.Namespace = vaultAdminNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, eInstance)).Should(Succeed())
+
+			eLookupKey := types.NamespacedName{Name: eInstance.Name, Namespace: eInstance.Namespace}
+			eCreated := &redhatcopv1alpha1.Entity{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, eLookupKey, eCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range eCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			secret, err := vaultClient.Logical().Read(eInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["policies"]).To(Equal([]interface{}{"team-abc-access"}))
+			Expect(secret.Data["metadata"]).To(HaveKeyWithValue("team", "team-abc"))
+
+			By("Updating the policies of the entity")
+
+			Eventually(func() error {
+				err := k8sIntegrationClient.Get(ctx, eLookupKey, eCreated)
+				if err != nil {
+					return err
+				}
+				eCreated.Spec.Policies = []string{"team-abc-access", "team-abc-admin"}
+				return k8sIntegrationClient.Update(ctx, eCreated)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() error {
+				secret, err := vaultClient.Logical().Read(eInstance.GetPath())
+				if err != nil {
+					return err
+				}
+				if secret == nil {
+					return fmt.Errorf("entity %s not found", eInstance.GetPath())
+				}
+				if fmt.Sprint(secret.Data["policies"]) != "[team-abc-access team-abc-admin]" {
+					return fmt.Errorf("unexpected policies %v", secret.Data["policies"])
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	Context("When creating an EntityAlias", func() {
+		It("Should create the alias of the entity when created and update it when changed", func() {
+
+			eaInstance, err := decoder.GetEntityAliasInstance("../test/entities/entityalias.yaml")
+			Expect(err).To(BeNil())
+			eaInstance.Namespace = vaultAdminNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, eaInstance)).Should(Succeed())
+
+			eaLookupKey := types.NamespacedName{Name: eaInstance.Name, Namespace: eaInstance.Namespace}
+			eaCreated := &redhatcopv1alpha1.EntityAlias{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, eaLookupKey, eaCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range eaCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			Expect(eaCreated.Status.ID).NotTo(BeEmpty())
+			Expect(eaCreated.Status.Ownership).To(Equal(vaultutils.VaultOwnershipCreated))
+
+			By("Reading the alias and its entity in Vault")
+
+			entity, err := vaultClient.Logical().Read("identity/entity/name/entity-sample")
+			Expect(err).To(BeNil())
+			Expect(entity).NotTo(BeNil())
+
+			secret, err := vaultClient.Logical().Read(eaCreated.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["name"]).To(Equal("entityalias-sample"))
+			Expect(secret.Data["canonical_id"]).To(Equal(entity.Data["id"]))
+			Expect(secret.Data["custom_metadata"]).To(HaveKeyWithValue("team", "team-abc"))
+
+			By("Updating the custom metadata of the alias")
+
+			Eventually(func() error {
+				err := k8sIntegrationClient.Get(ctx, eaLookupKey, eaCreated)
+				if err != nil {
+					return err
+				}
+				eaCreated.Spec.CustomMetadata = map[string]string{"team": "team-xyz"}
+				return k8sIntegrationClient.Update(ctx, eaCreated)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() error {
+				secret, err := vaultClient.Logical().Read(eaCreated.GetPath())
+				if err != nil {
+					return err
+				}
+				if secret == nil {
+					return fmt.Errorf("entity alias %s not found", eaCreated.GetPath())
+				}
+				customMetadata, _ := secret.Data["custom_metadata"].(map[string]interface{})
+				if customMetadata["team"] != "team-xyz" {
+					return fmt.Errorf("unexpected custom metadata %v", secret.Data["custom_metadata"])
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	Context("When deleting the Entity resources", func() {
+		It("They should be deleted from Vault", func() {
+
+			By("Deleting EntityAlias")
+
+			eaLookupKey := types.NamespacedName{Name: "entityalias-sample", Namespace: vaultAdminNamespaceName}
+			eaCreated := &redhatcopv1alpha1.EntityAlias{}
+			Expect(k8sIntegrationClient.Get(ctx, eaLookupKey, eaCreated)).Should(Succeed())
+
+			Expect(k8sIntegrationClient.Delete(ctx, eaCreated)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(eaCreated.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting Entity")
+
+			eInstance, err := decoder.GetEntityInstance("../test/entities/entity.yaml")
+			Expect(err).To(BeNil())
+			eInstance.Namespace = vaultAdminNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, eInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(eInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+})
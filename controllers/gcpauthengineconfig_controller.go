@@ -183,7 +183,7 @@ func (r *GCPAuthEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager) error
 			}
 			return res
 		}), builder.WithPredicates(isUpdatedRandomSecret)).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("GCPAuthEngineConfig", r))
 
 }
 
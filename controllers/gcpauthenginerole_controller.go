@@ -71,5 +71,5 @@ func (r *GCPAuthEngineRoleReconciler) Reconcile(ctx context.Context, req ctrl.Re
 func (r *GCPAuthEngineRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.GCPAuthEngineRole{}).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("GCPAuthEngineRole", r))
 }
@@ -141,7 +141,7 @@ func (r *GitHubSecretEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager)
 			}
 			return res
 		}), builder.WithPredicates(isSSHSecret)).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("GitHubSecretEngineConfig", r))
 }
 
 func (r *GitHubSecretEngineConfigReconciler) findApplicableGHSCForSecret(ctx context.Context, secret *corev1.Secret) ([]redhatcopv1alpha1.GitHubSecretEngineConfig, error) {
@@ -20,9 +20,15 @@ import (
 	"context"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
@@ -37,6 +43,7 @@ type GroupReconciler struct {
 //+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=groups,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=groups/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=groups/finalizers,verbs=update
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=entities,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -76,7 +83,74 @@ func (r *GroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *GroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// the groups referencing an entity by name are reconciled once the entity exists in Vault, or when it is renamed
+	isEntityInVault := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			newEntity, ok := e.ObjectNew.(*redhatcopv1alpha1.Entity)
+			if !ok {
+				return false
+			}
+			oldEntity, ok := e.ObjectOld.(*redhatcopv1alpha1.Entity)
+			if !ok {
+				return true
+			}
+			return oldEntity.Status.Ownership != newEntity.Status.Ownership || oldEntity.GetEntityName() != newEntity.GetEntityName()
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.Group{}, builder.WithPredicates(vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
-		Complete(r)
+		Watches(&redhatcopv1alpha1.Entity{
+			TypeMeta: metav1.TypeMeta{
+				Kind: "Entity",
+			},
+		}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			res := []reconcile.Request{}
+			entity := a.(*redhatcopv1alpha1.Entity)
+			groups, err := r.findApplicableGroupsForEntity(ctx, entity)
+			if err != nil {
+				r.Log.Error(err, "unable to find applicable Groups for namespace", "namespace", entity.Namespace)
+				return []reconcile.Request{}
+			}
+			for _, group := range groups {
+				res = append(res, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      group.GetName(),
+						Namespace: group.GetNamespace(),
+					},
+				})
+			}
+			return res
+		}), builder.WithPredicates(isEntityInVault)).
+		Complete(vaultresourcecontroller.NewTracingReconciler("Group", r))
+}
+
+func (r *GroupReconciler) findApplicableGroupsForEntity(ctx context.Context, entity *redhatcopv1alpha1.Entity) ([]redhatcopv1alpha1.Group, error) {
+	result := []redhatcopv1alpha1.Group{}
+	vrl := &redhatcopv1alpha1.GroupList{}
+	err := r.GetClient().List(ctx, vrl, &client.ListOptions{
+		Namespace: entity.Namespace,
+	})
+	if err != nil {
+		r.Log.Error(err, "unable to retrieve the list of Group")
+		return nil, err
+	}
+	for _, vr := range vrl.Items {
+		for _, member := range vr.Spec.MemberEntityIDs {
+			if member == entity.Name {
+				result = append(result, vr)
+				break
+			}
+		}
+	}
+	return result, nil
 }
@@ -187,7 +187,7 @@ func (r *JWTOIDCAuthEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager) e
 			}
 			return res
 		}), builder.WithPredicates(isUpdatedRandomSecret)).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("JWTOIDCAuthEngineConfig", r))
 
 }
 
@@ -109,7 +109,7 @@ func (r *KubernetesAuthEngineRoleReconciler) SetupWithManager(mgr ctrl.Manager)
 			}
 			return res
 		})).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("KubernetesAuthEngineRole", r))
 }
 
 func (r *KubernetesAuthEngineRoleReconciler) findApplicableKubernetesAuthEngineRoles(ctx context.Context, namespace *corev1.Namespace) ([]redhatcopv1alpha1.KubernetesAuthEngineRole, error) {
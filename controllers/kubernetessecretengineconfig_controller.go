@@ -135,7 +135,7 @@ func (r *KubernetesSecretEngineConfigReconciler) SetupWithManager(mgr ctrl.Manag
 			}
 			return res
 		}), builder.WithPredicates(isSATokenSecret)).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("KubernetesSecretEngineConfig", r))
 }
 
 func (r *KubernetesSecretEngineConfigReconciler) findApplicableKSECForSecret(ctx context.Context, secret *corev1.Secret) ([]redhatcopv1alpha1.KubernetesSecretEngineConfig, error) {
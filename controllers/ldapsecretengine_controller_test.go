@@ -0,0 +1,523 @@
+//go:build integration
+// +build integration
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("LDAPSecretEngine controllers", func() {
+
+	timeout := time.Second * 120
+	interval := time.Second * 2
+
+	Context("When preparing an LDAP Secret Engine", func() {
+		It("Should create an LDAP Secret Engine when created", func() {
+			By("By creating new Policies")
+			pInstance, err := decoder.GetPolicyInstance("../test/ldapsecretengine/ldap-secret-engine-admin-policy.yaml")
+			Expect(err).To(BeNil())
+			pInstance.Namespace = vaultAdminNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, pInstance)).Should(Succeed())
+
+			pLookupKey := types.NamespacedName{Name: pInstance.Name, Namespace: pInstance.Namespace}
+			pCreated := &redhatcopv1alpha1.Policy{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, pLookupKey, pCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range pCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			kaerInstance, err := decoder.GetKubernetesAuthEngineRoleInstance("../test/ldapsecretengine/ldap-secret-engine-kube-auth-role.yaml")
+			Expect(err).To(BeNil())
+			kaerInstance.Namespace = vaultAdminNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, kaerInstance)).Should(Succeed())
+
+			kaerLookupKey := types.NamespacedName{Name: kaerInstance.Name, Namespace: kaerInstance.Namespace}
+			kaerCreated := &redhatcopv1alpha1.KubernetesAuthEngineRole{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, kaerLookupKey, kaerCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range kaerCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			By("By creating a new SecretEngineMount")
+
+			semInstance, err := decoder.GetSecretEngineMountInstance("../test/ldapsecretengine/ldap-secret-engine.yaml")
+			Expect(err).To(BeNil())
+			semInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, semInstance)).Should(Succeed())
+
+			semLookupKey := types.NamespacedName{Name: semInstance.Name, Namespace: semInstance.Namespace}
+			semCreated := &redhatcopv1alpha1.SecretEngineMount{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, semLookupKey, semCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range semCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
+	Context("When creating an LDAPSecretEngineConfig", func() {
+		It("Should configure the connection to the LDAP server when created", func() {
+
+			By("By creating the secret of the bind credentials")
+
+			bindCredentials := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ldap-bind-credentials", Namespace: vaultTestNamespaceName},
+				Type:       corev1.SecretTypeBasicAuth,
+				StringData: map[string]string{"username": "cn=admin,dc=example,dc=com", "password": "admin"},
+			}
+			Expect(k8sIntegrationClient.Create(ctx, bindCredentials)).Should(Succeed())
+
+			ldapConfigInstance, err := decoder.GetLDAPSecretEngineConfigInstance("../test/ldapsecretengine/ldap-secret-engine-config.yaml")
+			Expect(err).To(BeNil())
+			ldapConfigInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, ldapConfigInstance)).Should(Succeed())
+
+			ldapConfigLookupKey := types.NamespacedName{Name: ldapConfigInstance.Name, Namespace: ldapConfigInstance.Namespace}
+			ldapConfigCreated := &redhatcopv1alpha1.LDAPSecretEngineConfig{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, ldapConfigLookupKey, ldapConfigCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range ldapConfigCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			Expect(ldapConfigCreated.Status.BindPassHash).NotTo(BeEmpty())
+
+			By("Reading the configuration back from Vault")
+
+			secret, err := vaultClient.Logical().Read(ldapConfigInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["url"]).To(Equal("ldap://ldap.ldap.svc.cluster.local"))
+			Expect(secret.Data["binddn"]).To(Equal("cn=admin,dc=example,dc=com"))
+			Expect(secret.Data["userattr"]).To(Equal("uid"))
+		})
+	})
+
+	Context("When creating an LDAPSecretEngineStaticRole", func() {
+		It("Should manage the password of the account when created and update the role when changed", func() {
+
+			staticRoleInstance, err := decoder.GetLDAPSecretEngineStaticRoleInstance("../test/ldapsecretengine/ldap-secret-engine-static-role.yaml")
+			Expect(err).To(BeNil())
+			staticRoleInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, staticRoleInstance)).Should(Succeed())
+
+			staticRoleLookupKey := types.NamespacedName{Name: staticRoleInstance.Name, Namespace: staticRoleInstance.Namespace}
+			staticRoleCreated := &redhatcopv1alpha1.LDAPSecretEngineStaticRole{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, staticRoleLookupKey, staticRoleCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range staticRoleCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			secret, err := vaultClient.Logical().Read(staticRoleInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["username"]).To(Equal("dev1"))
+			Expect(secret.Data["dn"]).To(Equal("uid=dev1,ou=Users,dc=example,dc=com"))
+			Expect(secret.Data["rotation_period"]).To(Equal(json.Number("86400")))
+
+			By("Reading the password of the account")
+
+			secret, err = vaultClient.Logical().Read("test-vault-config-operator/ldap/static-cred/dev1")
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["password"]).NotTo(BeEmpty())
+
+			By("Updating the rotation period of the role")
+
+			Eventually(func() error {
+				err := k8sIntegrationClient.Get(ctx, staticRoleLookupKey, staticRoleCreated)
+				if err != nil {
+					return err
+				}
+				staticRoleCreated.Spec.RotationPeriod = metav1.Duration{Duration: 48 * time.Hour}
+				return k8sIntegrationClient.Update(ctx, staticRoleCreated)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() error {
+				secret, err := vaultClient.Logical().Read(staticRoleInstance.GetPath())
+				if err != nil {
+					return err
+				}
+				if secret == nil {
+					return fmt.Errorf("%s not found", staticRoleInstance.GetPath())
+				}
+				if secret.Data["rotation_period"] != json.Number("172800") {
+					return fmt.Errorf("unexpected rotation_period %v", secret.Data["rotation_period"])
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	Context("When creating an LDAPSecretEngineDynamicRole", func() {
+		It("Should create the role when created and update it when changed", func() {
+
+			dynamicRoleInstance, err := decoder.GetLDAPSecretEngineDynamicRoleInstance("../test/ldapsecretengine/ldap-secret-engine-dynamic-role.yaml")
+			Expect(err).To(BeNil())
+			dynamicRoleInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, dynamicRoleInstance)).Should(Succeed())
+
+			dynamicRoleLookupKey := types.NamespacedName{Name: dynamicRoleInstance.Name, Namespace: dynamicRoleInstance.Namespace}
+			dynamicRoleCreated := &redhatcopv1alpha1.LDAPSecretEngineDynamicRole{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, dynamicRoleLookupKey, dynamicRoleCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range dynamicRoleCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			secret, err := vaultClient.Logical().Read(dynamicRoleInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["creation_ldif"]).To(ContainSubstring("uid={{.Username}},ou=Users,dc=example,dc=com"))
+			Expect(secret.Data["default_ttl"]).To(Equal(json.Number("3600")))
+
+			By("Updating the default ttl of the role")
+
+			Eventually(func() error {
+				err := k8sIntegrationClient.Get(ctx, dynamicRoleLookupKey, dynamicRoleCreated)
+				if err != nil {
+					return err
+				}
+				dynamicRoleCreated.Spec.DefaultTTL = metav1.Duration{Duration: 2 * time.Hour}
+				return k8sIntegrationClient.Update(ctx, dynamicRoleCreated)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() error {
+				secret, err := vaultClient.Logical().Read(dynamicRoleInstance.GetPath())
+				if err != nil {
+					return err
+				}
+				if secret == nil {
+					return fmt.Errorf("%s not found", dynamicRoleInstance.GetPath())
+				}
+				if secret.Data["default_ttl"] != json.Number("7200") {
+					return fmt.Errorf("unexpected default_ttl %v", secret.Data["default_ttl"])
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	Context("When creating an LDAPSecretEngineLibrary", func() {
+		It("Should create the library set when created and update it when changed", func() {
+
+			libraryInstance, err := decoder.GetLDAPSecretEngineLibraryInstance("../test/ldapsecretengine/ldap-secret-engine-library.yaml")
+			Expect(err).To(BeNil())
+			libraryInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, libraryInstance)).Should(Succeed())
+
+			libraryLookupKey := types.NamespacedName{Name: libraryInstance.Name, Namespace: libraryInstance.Namespace}
+			libraryCreated := &redhatcopv1alpha1.LDAPSecretEngineLibrary{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, libraryLookupKey, libraryCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range libraryCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			secret, err := vaultClient.Logical().Read(libraryInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["service_account_names"]).To(Equal([]interface{}{"dev2", "dev3"}))
+			Expect(secret.Data["ttl"]).To(Equal(json.Number("3600")))
+
+			By("Updating the ttl of the library set")
+
+			Eventually(func() error {
+				err := k8sIntegrationClient.Get(ctx, libraryLookupKey, libraryCreated)
+				if err != nil {
+					return err
+				}
+				libraryCreated.Spec.TTL = metav1.Duration{Duration: 2 * time.Hour}
+				return k8sIntegrationClient.Update(ctx, libraryCreated)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() error {
+				secret, err := vaultClient.Logical().Read(libraryInstance.GetPath())
+				if err != nil {
+					return err
+				}
+				if secret == nil {
+					return fmt.Errorf("%s not found", libraryInstance.GetPath())
+				}
+				if secret.Data["ttl"] != json.Number("7200") {
+					return fmt.Errorf("unexpected ttl %v", secret.Data["ttl"])
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	Context("When rotating the bind password", func() {
+		It("Should write the configuration again with the rotated password", func() {
+
+			ldapConfigLookupKey := types.NamespacedName{Name: "ldap", Namespace: vaultTestNamespaceName}
+			ldapConfigCreated := &redhatcopv1alpha1.LDAPSecretEngineConfig{}
+			Expect(k8sIntegrationClient.Get(ctx, ldapConfigLookupKey, ldapConfigCreated)).Should(Succeed())
+			bindPassHash := ldapConfigCreated.Status.BindPassHash
+
+			bindCredentialsLookupKey := types.NamespacedName{Name: "ldap-bind-credentials", Namespace: vaultTestNamespaceName}
+			rotateBindPassword := func(password string) {
+				Eventually(func() error {
+					bindCredentials := &corev1.Secret{}
+					err := k8sIntegrationClient.Get(ctx, bindCredentialsLookupKey, bindCredentials)
+					if err != nil {
+						return err
+					}
+					bindCredentials.Data["password"] = []byte(password)
+					return k8sIntegrationClient.Update(ctx, bindCredentials)
+				}, timeout, interval).Should(Succeed())
+			}
+
+			By("Rotating the password of the bind credentials")
+
+			rotateBindPassword("rotated")
+
+			Eventually(func() (string, error) {
+				err := k8sIntegrationClient.Get(ctx, ldapConfigLookupKey, ldapConfigCreated)
+				return ldapConfigCreated.Status.BindPassHash, err
+			}, timeout, interval).ShouldNot(Equal(bindPassHash))
+
+			By("Restoring the password of the bind credentials")
+
+			rotateBindPassword("admin")
+
+			Eventually(func() (string, error) {
+				err := k8sIntegrationClient.Get(ctx, ldapConfigLookupKey, ldapConfigCreated)
+				return ldapConfigCreated.Status.BindPassHash, err
+			}, timeout, interval).Should(Equal(bindPassHash))
+		})
+	})
+
+	Context("When deleting the LDAP Secret Engine resources", func() {
+		It("They should be deleted from Vault", func() {
+
+			By("Deleting LDAPSecretEngineLibrary")
+
+			libraryInstance, err := decoder.GetLDAPSecretEngineLibraryInstance("../test/ldapsecretengine/ldap-secret-engine-library.yaml")
+			Expect(err).To(BeNil())
+			libraryInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, libraryInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(libraryInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting LDAPSecretEngineDynamicRole")
+
+			dynamicRoleInstance, err := decoder.GetLDAPSecretEngineDynamicRoleInstance("../test/ldapsecretengine/ldap-secret-engine-dynamic-role.yaml")
+			Expect(err).To(BeNil())
+			dynamicRoleInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, dynamicRoleInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(dynamicRoleInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting LDAPSecretEngineStaticRole")
+
+			staticRoleInstance, err := decoder.GetLDAPSecretEngineStaticRoleInstance("../test/ldapsecretengine/ldap-secret-engine-static-role.yaml")
+			Expect(err).To(BeNil())
+			staticRoleInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, staticRoleInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(staticRoleInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting LDAPSecretEngineConfig")
+
+			ldapConfigInstance, err := decoder.GetLDAPSecretEngineConfigInstance("../test/ldapsecretengine/ldap-secret-engine-config.yaml")
+			Expect(err).To(BeNil())
+			ldapConfigInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, ldapConfigInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(ldapConfigInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			Expect(k8sIntegrationClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ldap-bind-credentials", Namespace: vaultTestNamespaceName}})).Should(Succeed())
+
+			By("Deleting SecretEngineMount")
+
+			semInstance, err := decoder.GetSecretEngineMountInstance("../test/ldapsecretengine/ldap-secret-engine.yaml")
+			Expect(err).To(BeNil())
+			semInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, semInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(semInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting KubernetesAuthEngineRole")
+
+			kaerInstance, err := decoder.GetKubernetesAuthEngineRoleInstance("../test/ldapsecretengine/ldap-secret-engine-kube-auth-role.yaml")
+			Expect(err).To(BeNil())
+			kaerInstance.Namespace = vaultAdminNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, kaerInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(kaerInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting Policy")
+
+			pInstance, err := decoder.GetPolicyInstance("../test/ldapsecretengine/ldap-secret-engine-admin-policy.yaml")
+			Expect(err).To(BeNil())
+			pInstance.Namespace = vaultAdminNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, pInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(pInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+})
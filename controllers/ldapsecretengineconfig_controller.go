@@ -0,0 +1,154 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+)
+
+// LDAPSecretEngineConfigReconciler reconciles a LDAPSecretEngineConfig object
+type LDAPSecretEngineConfigReconciler struct {
+	vaultresourcecontroller.ReconcilerBase
+}
+
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=ldapsecretengineconfigs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=ldapsecretengineconfigs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=ldapsecretengineconfigs/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
+//+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.14.4/pkg/reconcile
+func (r *LDAPSecretEngineConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	// Fetch the instance
+	instance := &redhatcopv1alpha1.LDAPSecretEngineConfig{}
+	err := r.GetClient().Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	ctx1, err := prepareContext(ctx, r.ReconcilerBase, instance)
+	if err != nil {
+		r.Log.Error(err, "unable to prepare context", "instance", instance)
+		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+	}
+	vaultResource := vaultresourcecontroller.NewVaultResource(&r.ReconcilerBase, instance)
+
+	return vaultResource.Reconcile(ctx1, instance)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LDAPSecretEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isBasicAuthOrTLSSecret := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			newSecret, ok := e.ObjectNew.DeepCopyObject().(*corev1.Secret)
+			if !ok || (newSecret.Type != corev1.SecretTypeBasicAuth && newSecret.Type != corev1.SecretTypeTLS) {
+				return false
+			}
+			oldSecret, ok := e.ObjectOld.DeepCopyObject().(*corev1.Secret)
+			if !ok {
+				return true
+			}
+			return !reflect.DeepEqual(oldSecret.Data, newSecret.Data)
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			newSecret, ok := e.Object.DeepCopyObject().(*corev1.Secret)
+			return ok && (newSecret.Type == corev1.SecretTypeBasicAuth || newSecret.Type == corev1.SecretTypeTLS)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&redhatcopv1alpha1.LDAPSecretEngineConfig{}, builder.WithPredicates(vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
+		Watches(&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind: "Secret",
+			},
+		}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			res := []reconcile.Request{}
+			s := a.(*corev1.Secret)
+			ldapsecs, err := r.findApplicableLDAPSEForSecret(ctx, s)
+			if err != nil {
+				r.Log.Error(err, "unable to find applicable LDAPSecretEngineConfig for namespace", "namespace", s.Namespace)
+				return []reconcile.Request{}
+			}
+			for _, ldapsec := range ldapsecs {
+				res = append(res, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      ldapsec.GetName(),
+						Namespace: ldapsec.GetNamespace(),
+					},
+				})
+			}
+			return res
+		}), builder.WithPredicates(isBasicAuthOrTLSSecret)).
+		Complete(vaultresourcecontroller.NewTracingReconciler("LDAPSecretEngineConfig", r))
+}
+
+func (r *LDAPSecretEngineConfigReconciler) findApplicableLDAPSEForSecret(ctx context.Context, secret *corev1.Secret) ([]redhatcopv1alpha1.LDAPSecretEngineConfig, error) {
+	result := []redhatcopv1alpha1.LDAPSecretEngineConfig{}
+	vrl := &redhatcopv1alpha1.LDAPSecretEngineConfigList{}
+	err := r.GetClient().List(ctx, vrl, &client.ListOptions{
+		Namespace: secret.Namespace,
+	})
+	if err != nil {
+		r.Log.Error(err, "unable to retrieve the list of LDAPSecretEngineConfig")
+		return nil, err
+	}
+	for _, vr := range vrl.Items {
+		if (vr.Spec.BindCredentials.Secret != nil && vr.Spec.BindCredentials.Secret.Name == secret.Name) || (vr.Spec.TLSConfig.TLSSecret != nil && vr.Spec.TLSConfig.TLSSecret.Name == secret.Name) {
+			result = append(result, vr)
+		}
+	}
+	return result, nil
+}
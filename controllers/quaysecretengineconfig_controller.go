@@ -185,7 +185,7 @@ func (r *QuaySecretEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager) er
 			}
 			return res
 		}), builder.WithPredicates(isUpdatedRandomSecret)).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("QuaySecretEngineConfig", r))
 }
 
 func (r *QuaySecretEngineConfigReconciler) findApplicableQuaySCForSecret(ctx context.Context, secret *corev1.Secret) ([]redhatcopv1alpha1.QuaySecretEngineConfig, error) {
@@ -79,8 +79,12 @@ func (r *RabbitMQSecretEngineConfigReconciler) Reconcile(ctx context.Context, re
 		// No resources supported for deletion.
 		return reconcile.Result{}, nil
 	}
+	if vaultutils.IsObserveOnly(instance) {
+		ctx1 = vaultutils.WithObserveOnly(ctx1)
+	}
 
 	err = r.manageReconcileLogic(ctx1, instance)
+	vaultresourcecontroller.ManageObserveOnly(ctx1, r.ReconcilerBase, instance)
 	if err != nil {
 		r.Log.Error(err, "unable to complete reconcile logic", "instance", instance)
 		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
@@ -127,5 +131,5 @@ func (r *RabbitMQSecretEngineConfigReconciler) SetupWithManager(mgr ctrl.Manager
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.RabbitMQSecretEngineConfig{}, builder.WithPredicates(filter, vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
-		Complete(r)
+		Complete(vaultresourcecontroller.NewTracingReconciler("RabbitMQSecretEngineConfig", r))
 }
@@ -28,8 +28,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -38,6 +40,9 @@ import (
 // RandomSecretReconciler reconciles a RandomSecret object
 type RandomSecretReconciler struct {
 	vaultresourcecontroller.ReconcilerBase
+	// VaultEvents, when set, notifies the KV v2 secret writes that trigger an immediate verification of the RandomSecrets written at their paths
+	VaultEvents    *vaultresourcecontroller.VaultEventSubscriber
+	verifyRequests vaultresourcecontroller.RefreshRequests
 }
 
 //+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=randomsecrets,verbs=get;list;watch;create;update;patch;delete
@@ -78,6 +83,9 @@ func (r *RandomSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		r.Log.Error(err, "unable to prepare context", "instance", instance)
 		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
 	}
+	if vaultutils.IsObserveOnly(instance) {
+		ctx1 = vaultutils.WithObserveOnly(ctx1)
+	}
 
 	if !instance.GetDeletionTimestamp().IsZero() {
 		if !controllerutil.ContainsFinalizer(instance, vaultutils.GetFinalizer(instance)) {
@@ -88,6 +96,7 @@ func (r *RandomSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			r.Log.Error(err, "unable to delete instance", "instance", instance)
 			return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
 		}
+		vaultresourcecontroller.ManageObserveOnly(ctx1, r.ReconcilerBase, instance)
 		controllerutil.RemoveFinalizer(instance, vaultutils.GetFinalizer(instance))
 		err = r.GetClient().Update(ctx1, instance)
 		if err != nil {
@@ -98,17 +107,37 @@ func (r *RandomSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 
 	// how to read this if: if the secret has been initialized once and there is no refresh period or time to refresh has not arrived yet, return.
-	if instance.Status.LastVaultSecretUpdate != nil && (instance.Spec.RefreshPeriod == nil || (instance.Spec.RefreshPeriod != nil && !instance.Status.LastVaultSecretUpdate.Add(instance.Spec.RefreshPeriod.Duration).Before(time.Now()))) {
+	upToDate := instance.Status.LastVaultSecretUpdate != nil && (instance.Spec.RefreshPeriod == nil || (instance.Spec.RefreshPeriod != nil && !instance.Status.LastVaultSecretUpdate.Add(instance.Spec.RefreshPeriod.Duration).Before(time.Now())))
+	// a secret written by someone else in Vault is generated again when the written version no longer holds the secret key
+	if upToDate && r.verifyRequests.Take(req.NamespacedName) && vaultutils.IsVaultObjectOwned(instance) {
+		written, err := r.isSecretKeyWritten(ctx1, instance)
+		if err != nil {
+			r.Log.Error(err, "unable to verify the vault secret", "instance", instance)
+			return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+		}
+		if !written {
+			r.Log.Info("the vault secret was written without the secret key, generating it again", "name", instance.Name, "secretKey", instance.Spec.SecretKey)
+			upToDate = false
+		}
+	}
+	if upToDate {
+		// secrets written before the ownership was recorded were created by this resource
+		if instance.Status.Ownership == "" && !vaultutils.IsObserveOnlyContext(ctx1) {
+			vaultutils.RecordVaultOwnership(instance, false)
+			return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, nil)
+		}
 		return reconcile.Result{}, nil
 	}
 
 	err = r.manageReconcileLogic(ctx1, instance)
+	vaultresourcecontroller.ManageObserveOnly(ctx1, r.ReconcilerBase, instance)
 	if err != nil {
 		r.Log.Error(err, "unable to complete reconcile logic", "instance", instance)
 		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
 	}
 
-	if instance.Spec.RefreshPeriod.Size() > 0 {
+	// in observe-only mode the secret is not written, it is generated again at the next reconcile cycle
+	if instance.Spec.RefreshPeriod.Size() > 0 && instance.Status.LastVaultSecretUpdate != nil {
 		//we reschedule the next reconcile at the time in the future corresponding to
 		nextSchedule := time.Until(instance.Status.LastVaultSecretUpdate.Add(instance.Spec.RefreshPeriod.Duration))
 		if nextSchedule > 0 {
@@ -127,6 +156,10 @@ func (r *RandomSecretReconciler) manageCleanUpLogic(context context.Context, ins
 	if instance.Spec.KvSecretRetainPolicy == redhatcopv1alpha1.RetainKvSecretRetainPolicy {
 		return nil
 	}
+	if !vaultutils.IsVaultObjectOwned(instance) {
+		r.Log.Info("the vault secret was neither created nor adopted by the operator, skipping its deletion", "instance", instance)
+		return nil
+	}
 
 	vaultEndpoint := vaultutils.NewVaultEndpoint(instance)
 
@@ -152,19 +185,26 @@ func (r *RandomSecretReconciler) manageReconcileLogic(context context.Context, i
 		return nil
 	}
 	vaultEndpoint := vaultutils.NewVaultEndpoint(instance)
+	found, err := vaultEndpoint.Exists(context)
+	if err != nil {
+		r.Log.Error(err, "unable to verify secret existence", "instance", instance)
+		return err
+	}
+	err = vaultutils.CheckManagementPolicy(instance, instance.GetPath(), found, nil)
+	if err != nil {
+		r.Log.Error(err, "unable to manage Vault Secret", "instance", instance)
+		return err
+	}
 	// When this is a newly created RandomSecret and no refresh period is defined (= one-off random password)
-	// check that the Vault KV secret does not exist already to avoid overwriting its existing value.
-	if instance.Status.LastVaultSecretUpdate == nil && instance.Spec.RefreshPeriod == nil {
-		found, err := vaultEndpoint.Exists(context)
-		if err != nil {
-			r.Log.Info("unable to verify secret existence", "instance", instance, "error", err)
-		}
-		if found {
-			r.Log.Info("no refresh period is defined and Vault secret already exists - nothing to do", "name", instance.Name)
-			return nil
+	// the existing Vault KV secret is adopted without overwriting its value.
+	if found && instance.Status.LastVaultSecretUpdate == nil && instance.Spec.RefreshPeriod == nil {
+		r.Log.Info("no refresh period is defined and Vault secret already exists - nothing to do", "name", instance.Name)
+		if !vaultutils.IsObserveOnlyContext(context) {
+			vaultutils.RecordVaultOwnership(instance, found)
 		}
+		return nil
 	}
-	err := instance.PrepareInternalValues(context, instance)
+	err = instance.PrepareInternalValues(context, instance)
 	if err != nil {
 		r.Log.Error(err, "unable to generate new secret", "instance", instance)
 		return err
@@ -174,11 +214,29 @@ func (r *RandomSecretReconciler) manageReconcileLogic(context context.Context, i
 		r.Log.Error(err, "unable to create/update Vault Secret", "instance", instance)
 		return err
 	}
+	if vaultutils.IsObserveOnlyContext(context) {
+		return nil
+	}
+	vaultutils.RecordVaultOwnership(instance, found)
 	now := metav1.NewTime(time.Now())
 	instance.Status.LastVaultSecretUpdate = &now
 	return nil
 }
 
+// isSecretKeyWritten returns whether the current version of the vault secret of instance holds its secret key
+func (r *RandomSecretReconciler) isSecretKeyWritten(context context.Context, instance *redhatcopv1alpha1.RandomSecret) (bool, error) {
+	secret, found, err := vaultutils.ReadSecret(context, instance.GetPath())
+	if err != nil || !found {
+		return false, err
+	}
+	data := secret.Data
+	if instance.IsKVSecretsEngineV2() {
+		data, _ = secret.Data["data"].(map[string]interface{})
+	}
+	_, ok := data[instance.Spec.SecretKey]
+	return ok, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *RandomSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
@@ -202,11 +260,36 @@ func (r *RandomSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		},
 
 		GenericFunc: func(e event.GenericEvent) bool {
-			return false
+			return true
 		},
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&redhatcopv1alpha1.RandomSecret{}, builder.WithPredicates(needsCreation, vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
-		Complete(r)
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&redhatcopv1alpha1.RandomSecret{}, builder.WithPredicates(needsCreation, vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate()))
+	if r.VaultEvents != nil {
+		controllerBuilder = controllerBuilder.WatchesRawSource(vaultEventSource(r.VaultEvents, r.findRandomSecretsWritten), &handler.EnqueueRequestForObject{})
+	}
+	return controllerBuilder.Complete(vaultresourcecontroller.NewTracingReconciler("RandomSecret", r))
+}
+
+// findRandomSecretsWritten returns the RandomSecrets whose KV v2 secret is at path and requests their verification
+func (r *RandomSecretReconciler) findRandomSecretsWritten(ctx context.Context, path string) []client.Object {
+	randomSecrets := &redhatcopv1alpha1.RandomSecretList{}
+	err := r.GetClient().List(ctx, randomSecrets)
+	if err != nil {
+		r.Log.Error(err, "unable to list RandomSecrets", "path", path)
+		return nil
+	}
+	ctx = vaultutils.WithKubeClient(ctx, r.GetClient())
+	objects := []client.Object{}
+	for i := range randomSecrets.Items {
+		randomSecret := &randomSecrets.Items[i]
+		if randomSecret.IsKVSecretsEngineV2() && vaultresourcecontroller.IsVaultEventPath(randomSecret.GetPath(), path) &&
+			r.VaultEvents.Watches(ctx, randomSecret.GetVaultConnection(), randomSecret.GetKubeAuthConfiguration(), randomSecret.Namespace) {
+			r.Log.V(1).Info("Vault Event - Secret written", "kind", "RandomSecret", "namespacedName", client.ObjectKeyFromObject(randomSecret), "path", path)
+			r.verifyRequests.Request(client.ObjectKeyFromObject(randomSecret))
+			objects = append(objects, randomSecret)
+		}
+	}
+	return objects
 }
@@ -0,0 +1,264 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	vault "github.com/hashicorp/vault/api"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeKV is a vault answering the password policy generation and storing kv secrets, it records the writes and deletes it receives
+type fakeKV struct {
+	secrets map[string]map[string]interface{}
+	writes  []string
+	deletes []string
+}
+
+func newRandomSecretTestContext(t *testing.T, kv *fakeKV) context.Context {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/sys/policies/password/simple/generate" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"password": "g3n3r4t3d"}})
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			data, ok := kv.secrets[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+		case http.MethodPut, http.MethodPost:
+			payload := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&payload)
+			kv.secrets[r.URL.Path] = payload
+			kv.writes = append(kv.writes, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			delete(kv.secrets, r.URL.Path)
+			kv.deletes = append(kv.deletes, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	config := vault.DefaultConfig()
+	config.Address = server.URL
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	return vaultutils.WithVaultClient(context.TODO(), vaultClient)
+}
+
+func newTestRandomSecret() *redhatcopv1alpha1.RandomSecret {
+	return &redhatcopv1alpha1.RandomSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team-a"},
+		Spec: redhatcopv1alpha1.RandomSecretSpec{
+			Path:         "kv",
+			SecretKey:    "password",
+			SecretFormat: redhatcopv1alpha1.VaultPasswordPolicy{PasswordPolicyName: "simple"},
+		},
+	}
+}
+
+func newTestRandomSecretReconciler() *RandomSecretReconciler {
+	return &RandomSecretReconciler{ReconcilerBase: vaultresourcecontroller.NewReconcilerBase(nil, nil, nil, record.NewFakeRecorder(10), nil, logr.Discard(), "RandomSecret")}
+}
+
+func TestRandomSecretObserveOnly(t *testing.T) {
+	kv := &fakeKV{secrets: map[string]map[string]interface{}{}}
+	ctx := vaultutils.WithObserveOnly(newRandomSecretTestContext(t, kv))
+	r := newTestRandomSecretReconciler()
+	instance := newTestRandomSecret()
+
+	if err := r.manageReconcileLogic(ctx, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kv.writes) != 0 {
+		t.Errorf("expected nothing to be written in observe-only mode, got %v", kv.writes)
+	}
+	if instance.Status.LastVaultSecretUpdate != nil {
+		t.Errorf("expected the secret not to be recorded as written in observe-only mode")
+	}
+	if operations := vaultutils.GetPlannedOperations(ctx); len(operations) != 1 || operations[0].String() != "write kv/app" {
+		t.Errorf("expected the write to be planned, got %v", operations)
+	}
+
+	instance.Spec.KvSecretRetainPolicy = redhatcopv1alpha1.DeleteKvSecretRetainPolicy
+	if err := r.manageCleanUpLogic(ctx, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kv.deletes) != 0 {
+		t.Errorf("expected nothing to be deleted in observe-only mode, got %v", kv.deletes)
+	}
+}
+
+func TestRandomSecretManagementPolicy(t *testing.T) {
+	tests := []struct {
+		name              string
+		policy            vaultutils.ManagementPolicy
+		existing          bool
+		expectedErr       bool
+		expectedWrites    int
+		expectedOwnership vaultutils.VaultOwnership
+	}{
+		{name: "new secret is created", policy: vaultutils.ManagementPolicyAdopt, expectedWrites: 1, expectedOwnership: vaultutils.VaultOwnershipCreated},
+		{name: "existing secret is adopted without being overwritten", policy: vaultutils.ManagementPolicyAdopt, existing: true, expectedOwnership: vaultutils.VaultOwnershipAdopted},
+		{name: "new secret is created with CreateOnly", policy: vaultutils.ManagementPolicyCreateOnly, expectedWrites: 1, expectedOwnership: vaultutils.VaultOwnershipCreated},
+		{name: "existing secret is rejected with CreateOnly", policy: vaultutils.ManagementPolicyCreateOnly, existing: true, expectedErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := &fakeKV{secrets: map[string]map[string]interface{}{}}
+			if tt.existing {
+				kv.secrets["/v1/kv/app"] = map[string]interface{}{"password": "existing"}
+			}
+			ctx := newRandomSecretTestContext(t, kv)
+			r := newTestRandomSecretReconciler()
+			instance := newTestRandomSecret()
+			instance.Spec.ManagementPolicy = tt.policy
+
+			err := r.manageReconcileLogic(ctx, instance)
+			if tt.expectedErr {
+				if !errors.Is(err, vaultutils.ErrVaultObjectNotOwned) {
+					t.Fatalf("expected a not owned error, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(kv.writes) != tt.expectedWrites {
+				t.Errorf("expected %d writes, got %v", tt.expectedWrites, kv.writes)
+			}
+			if instance.Status.Ownership != tt.expectedOwnership {
+				t.Errorf("expected ownership %q, got %q", tt.expectedOwnership, instance.Status.Ownership)
+			}
+		})
+	}
+}
+
+func TestRandomSecretCleanUpSkipsUnownedSecret(t *testing.T) {
+	kv := &fakeKV{secrets: map[string]map[string]interface{}{"/v1/kv/app": {"password": "existing"}}}
+	ctx := newRandomSecretTestContext(t, kv)
+	r := newTestRandomSecretReconciler()
+	instance := newTestRandomSecret()
+	instance.Spec.KvSecretRetainPolicy = redhatcopv1alpha1.DeleteKvSecretRetainPolicy
+
+	if err := r.manageCleanUpLogic(ctx, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kv.deletes) != 0 {
+		t.Errorf("expected an unowned secret not to be deleted, got %v", kv.deletes)
+	}
+
+	instance.Status.Ownership = vaultutils.VaultOwnershipAdopted
+	if err := r.manageCleanUpLogic(ctx, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kv.deletes) != 1 {
+		t.Errorf("expected an owned secret to be deleted, got %v", kv.deletes)
+	}
+}
+
+func TestRandomSecretIsSecretKeyWritten(t *testing.T) {
+	tests := []struct {
+		name     string
+		secret   map[string]interface{}
+		expected bool
+	}{
+		{name: "secret holding the secret key", secret: map[string]interface{}{"data": map[string]interface{}{"password": "g3n3r4t3d"}}, expected: true},
+		{name: "secret holding another value of the secret key", secret: map[string]interface{}{"data": map[string]interface{}{"password": "rotated"}}, expected: true},
+		{name: "secret written without the secret key", secret: map[string]interface{}{"data": map[string]interface{}{"username": "admin"}}},
+		{name: "missing secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := &fakeKV{secrets: map[string]map[string]interface{}{}}
+			if tt.secret != nil {
+				kv.secrets["/v1/kv/data/app"] = tt.secret
+			}
+			ctx := newRandomSecretTestContext(t, kv)
+			instance := newTestRandomSecret()
+			instance.Spec.Path = "kv/data"
+			instance.Spec.IsKVSecretsEngineV2 = true
+
+			written, err := newTestRandomSecretReconciler().isSecretKeyWritten(ctx, instance)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if written != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, written)
+			}
+		})
+	}
+}
+
+func TestFindRandomSecretsWritten(t *testing.T) {
+	t.Setenv("VAULT_ADDR", "https://vault.example.com:8200")
+	t.Setenv("VAULT_NAMESPACE", "")
+	scheme := runtime.NewScheme()
+	if err := redhatcopv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to build scheme: %v", err)
+	}
+	kvV2 := newTestRandomSecret()
+	kvV2.Spec.Path = "kv/data"
+	kvV2.Spec.IsKVSecretsEngineV2 = true
+	kvV1 := newTestRandomSecret()
+	kvV1.Name = "app-v1"
+	kvV1.Spec.Path = "kv/data"
+	otherVault := newTestRandomSecret()
+	otherVault.Name = "other"
+	otherVault.Spec.Path = "kv/data"
+	otherVault.Spec.Name = "app"
+	otherVault.Spec.IsKVSecretsEngineV2 = true
+	otherVault.Spec.Connection = &vaultutils.VaultConnection{Address: "https://other.example.com:8200"}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kvV2, kvV1, otherVault).Build()
+
+	vaultClient, err := vault.NewClient(vault.DefaultConfig())
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	r := &RandomSecretReconciler{
+		ReconcilerBase: vaultresourcecontroller.NewReconcilerBase(kubeClient, scheme, nil, record.NewFakeRecorder(10), kubeClient, logr.Discard(), "RandomSecret"),
+		VaultEvents:    vaultresourcecontroller.NewVaultEventSubscriber(vaultClient, nil),
+	}
+
+	objects := r.findRandomSecretsWritten(context.TODO(), "kv/data/app")
+	if len(objects) != 1 || objects[0].GetName() != "app" {
+		t.Fatalf("expected only the KV v2 RandomSecret of the subscribed vault to be found, got %v", objects)
+	}
+	if !r.verifyRequests.Take(client.ObjectKeyFromObject(kvV2)) {
+		t.Errorf("expected the verification of the RandomSecret to be requested")
+	}
+	if r.verifyRequests.Take(client.ObjectKeyFromObject(otherVault)) {
+		t.Errorf("expected no verification of the RandomSecret of another vault to be requested")
+	}
+}
@@ -0,0 +1,311 @@
+//go:build integration
+// +build integration
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("SSHSecretEngine controllers", func() {
+
+	timeout := time.Second * 120
+	interval := time.Second * 2
+
+	Context("When preparing an SSH Secret Engine", func() {
+		It("Should create an SSH Secret Engine when created", func() {
+			By("By creating new Policies")
+			pInstance, err := decoder.GetPolicyInstance("../test/sshsecretengine/ssh-secret-engine-admin-policy.yaml")
+			Expect(err).To(BeNil())
+			pInstance.Namespace = vaultAdminNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, pInstance)).Should(Succeed())
+
+			pLookupKey := types.NamespacedName{Name: pInstance.Name, Namespace: pInstance.Namespace}
+			pCreated := &redhatcopv1alpha1.Policy{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, pLookupKey, pCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range pCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			kaerInstance, err := decoder.GetKubernetesAuthEngineRoleInstance("../test/sshsecretengine/ssh-secret-engine-kube-auth-role.yaml")
+			Expect(err).To(BeNil())
+			kaerInstance.Namespace = vaultAdminNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, kaerInstance)).Should(Succeed())
+
+			kaerLookupKey := types.NamespacedName{Name: kaerInstance.Name, Namespace: kaerInstance.Namespace}
+			kaerCreated := &redhatcopv1alpha1.KubernetesAuthEngineRole{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, kaerLookupKey, kaerCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range kaerCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			By("By creating a new SecretEngineMount")
+
+			semInstance, err := decoder.GetSecretEngineMountInstance("../test/sshsecretengine/ssh-secret-engine.yaml")
+			Expect(err).To(BeNil())
+			semInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, semInstance)).Should(Succeed())
+
+			semLookupKey := types.NamespacedName{Name: semInstance.Name, Namespace: semInstance.Namespace}
+			semCreated := &redhatcopv1alpha1.SecretEngineMount{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, semLookupKey, semCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range semCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
+	Context("When creating an SSHSecretEngineConfig", func() {
+		It("Should generate the CA and publish its public key when created", func() {
+
+			sshConfigInstance, err := decoder.GetSSHSecretEngineConfigInstance("../test/sshsecretengine/ssh-secret-engine-config.yaml")
+			Expect(err).To(BeNil())
+			sshConfigInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, sshConfigInstance)).Should(Succeed())
+
+			sshConfigLookupKey := types.NamespacedName{Name: sshConfigInstance.Name, Namespace: sshConfigInstance.Namespace}
+			sshConfigCreated := &redhatcopv1alpha1.SSHSecretEngineConfig{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, sshConfigLookupKey, sshConfigCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range sshConfigCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			By("Reading the public key of the CA in Vault and in the ConfigMap")
+
+			secret, err := vaultClient.Logical().Read(sshConfigInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			publicKey, ok := secret.Data["public_key"].(string)
+			Expect(ok).To(BeTrue())
+			Expect(publicKey).To(HavePrefix("ssh-ed25519 "))
+
+			configMap := &corev1.ConfigMap{}
+			Eventually(func() error {
+				return k8sIntegrationClient.Get(ctx, types.NamespacedName{Name: sshConfigInstance.Spec.PublicKeyConfigMap.Name, Namespace: vaultTestNamespaceName}, configMap)
+			}, timeout, interval).Should(Succeed())
+			Expect(strings.TrimSpace(configMap.Data[redhatcopv1alpha1.SSHCAPublicKeyConfigMapKey])).To(Equal(strings.TrimSpace(publicKey)))
+		})
+	})
+
+	Context("When creating an SSHSecretEngineRole", func() {
+		It("Should create the role when created and update it when changed", func() {
+
+			sshRoleInstance, err := decoder.GetSSHSecretEngineRoleInstance("../test/sshsecretengine/ssh-secret-engine-role.yaml")
+			Expect(err).To(BeNil())
+			sshRoleInstance.Namespace = vaultTestNamespaceName
+			Expect(k8sIntegrationClient.Create(ctx, sshRoleInstance)).Should(Succeed())
+
+			sshRoleLookupKey := types.NamespacedName{Name: sshRoleInstance.Name, Namespace: sshRoleInstance.Namespace}
+			sshRoleCreated := &redhatcopv1alpha1.SSHSecretEngineRole{}
+
+			Eventually(func() bool {
+				err := k8sIntegrationClient.Get(ctx, sshRoleLookupKey, sshRoleCreated)
+				if err != nil {
+					return false
+				}
+
+				for _, condition := range sshRoleCreated.Status.Conditions {
+					if condition.Type == vaultresourcecontroller.ReconcileSuccessful && condition.Status == metav1.ConditionTrue {
+						return true
+					}
+				}
+
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			secret, err := vaultClient.Logical().Read(sshRoleInstance.GetPath())
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeNil())
+			Expect(secret.Data["key_type"]).To(Equal("ca"))
+			Expect(secret.Data["default_user"]).To(Equal("ubuntu"))
+			Expect(secret.Data["ttl"]).To(Equal(json.Number("1800")))
+
+			By("Updating the ttl of the role")
+
+			Eventually(func() error {
+				err := k8sIntegrationClient.Get(ctx, sshRoleLookupKey, sshRoleCreated)
+				if err != nil {
+					return err
+				}
+				sshRoleCreated.Spec.TTL = metav1.Duration{Duration: 45 * time.Minute}
+				return k8sIntegrationClient.Update(ctx, sshRoleCreated)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() error {
+				secret, err := vaultClient.Logical().Read(sshRoleInstance.GetPath())
+				if err != nil {
+					return err
+				}
+				if secret == nil {
+					return fmt.Errorf("role %s not found", sshRoleInstance.GetPath())
+				}
+				if secret.Data["ttl"] != json.Number("2700") {
+					return fmt.Errorf("unexpected ttl %v", secret.Data["ttl"])
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	Context("When deleting the SSH Secret Engine resources", func() {
+		It("They should be deleted from Vault", func() {
+
+			By("Deleting SSHSecretEngineRole")
+
+			sshRoleInstance, err := decoder.GetSSHSecretEngineRoleInstance("../test/sshsecretengine/ssh-secret-engine-role.yaml")
+			Expect(err).To(BeNil())
+			sshRoleInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, sshRoleInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(sshRoleInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting SSHSecretEngineConfig")
+
+			sshConfigInstance, err := decoder.GetSSHSecretEngineConfigInstance("../test/sshsecretengine/ssh-secret-engine-config.yaml")
+			Expect(err).To(BeNil())
+			sshConfigInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, sshConfigInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(sshConfigInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting SecretEngineMount")
+
+			semInstance, err := decoder.GetSecretEngineMountInstance("../test/sshsecretengine/ssh-secret-engine.yaml")
+			Expect(err).To(BeNil())
+			semInstance.Namespace = vaultTestNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, semInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(semInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting KubernetesAuthEngineRole")
+
+			kaerInstance, err := decoder.GetKubernetesAuthEngineRoleInstance("../test/sshsecretengine/ssh-secret-engine-kube-auth-role.yaml")
+			Expect(err).To(BeNil())
+			kaerInstance.Namespace = vaultAdminNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, kaerInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(kaerInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+
+			By("Deleting Policy")
+
+			pInstance, err := decoder.GetPolicyInstance("../test/sshsecretengine/ssh-secret-engine-admin-policy.yaml")
+			Expect(err).To(BeNil())
+			pInstance.Namespace = vaultAdminNamespaceName
+
+			Expect(k8sIntegrationClient.Delete(ctx, pInstance)).Should(Succeed())
+
+			Eventually(func() error {
+				secret, _ := vaultClient.Logical().Read(pInstance.GetPath())
+				if secret == nil {
+					return nil
+				}
+				out, err := json.Marshal(secret)
+				if err != nil {
+					panic(err)
+				}
+				return fmt.Errorf("secret is not nil %s", string(out))
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+})
@@ -0,0 +1,144 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// VaultConnectionReconciler reconciles a VaultConnection object
+type VaultConnectionReconciler struct {
+	vaultresourcecontroller.ReconcilerBase
+}
+
+// vaultConnectionObject is implemented by VaultConnection and NamespacedVaultConnection
+type vaultConnectionObject interface {
+	client.Object
+	vaultutils.ConditionsAware
+	GetVaultConnection() *vaultutils.VaultConnection
+	GetTLSSecretNamespace() string
+	GetHealthCheckInterval() time.Duration
+	GetVaultConnectionStatus() *redhatcopv1alpha1.VaultConnectionStatus
+}
+
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=vaultconnections,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=vaultconnections/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=vaultconnections/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;patch
+
+// Reconcile checks the health of the Vault server described by a VaultConnection and records reachability, seal state and version in its status.
+func (r *VaultConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	// Fetch the instance
+	instance := &redhatcopv1alpha1.VaultConnection{}
+	err := r.GetClient().Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	return manageVaultConnectionHealth(ctx, r.ReconcilerBase, instance)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VaultConnectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&redhatcopv1alpha1.VaultConnection{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(vaultresourcecontroller.NewTracingReconciler("VaultConnection", r))
+}
+
+// manageVaultConnectionHealth calls sys/health on the Vault server described by the passed connection, updates the connection status accordingly and requeues at the connection health check interval.
+func manageVaultConnectionHealth(ctx context.Context, r vaultresourcecontroller.ReconcilerBase, instance vaultConnectionObject) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	ctx = vaultutils.WithKubeClient(ctx, r.GetClient())
+	ctx = vaultutils.WithRestConfig(ctx, r.GetRestConfig())
+
+	status := instance.GetVaultConnectionStatus()
+	now := metav1.Now()
+	status.LastHealthCheck = &now
+
+	health, err := instance.GetVaultConnection().GetHealth(ctx, instance.GetTLSSecretNamespace())
+	var reachable, sealed metav1.Condition
+	if err != nil {
+		log.Error(err, "unable to check vault health", "address", instance.GetVaultConnection().Address)
+		r.GetRecorder().Event(instance, "Warning", "HealthCheckFailed", err.Error())
+		reachable = metav1.Condition{
+			Type:               redhatcopv1alpha1.VaultConnectionReachable,
+			LastTransitionTime: now,
+			ObservedGeneration: instance.GetGeneration(),
+			Reason:             "HealthCheckFailed",
+			Message:            err.Error(),
+			Status:             metav1.ConditionFalse,
+		}
+		sealed = metav1.Condition{
+			Type:               redhatcopv1alpha1.VaultConnectionSealed,
+			LastTransitionTime: now,
+			ObservedGeneration: instance.GetGeneration(),
+			Reason:             "HealthCheckFailed",
+			Status:             metav1.ConditionUnknown,
+		}
+	} else {
+		status.ServerVersion = health.Version
+		status.ClusterName = health.ClusterName
+		reachable = metav1.Condition{
+			Type:               redhatcopv1alpha1.VaultConnectionReachable,
+			LastTransitionTime: now,
+			ObservedGeneration: instance.GetGeneration(),
+			Reason:             "HealthCheckSucceeded",
+			Status:             metav1.ConditionTrue,
+		}
+		sealed = metav1.Condition{
+			Type:               redhatcopv1alpha1.VaultConnectionSealed,
+			LastTransitionTime: now,
+			ObservedGeneration: instance.GetGeneration(),
+			Reason:             map[bool]string{true: "VaultSealed", false: "VaultUnsealed"}[health.Sealed],
+			Status:             map[bool]metav1.ConditionStatus{true: metav1.ConditionTrue, false: metav1.ConditionFalse}[health.Sealed],
+		}
+		if !health.Initialized {
+			sealed.Message = "vault is not initialized"
+		}
+	}
+	conditions := vaultutils.AddOrReplaceCondition(reachable, instance.GetConditions())
+	conditions = vaultutils.AddOrReplaceCondition(sealed, conditions)
+	instance.SetConditions(conditions)
+
+	err = r.GetClient().Status().Update(ctx, instance)
+	if err != nil {
+		log.Error(err, "unable to update status")
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: instance.GetHealthCheckInterval()}, nil
+}
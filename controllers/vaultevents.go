@@ -0,0 +1,41 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// vaultEventSource returns a source of generic events for the objects that match the KV v2 secrets written in Vault, as notified to subscriber
+func vaultEventSource(subscriber *vaultresourcecontroller.VaultEventSubscriber, match func(ctx context.Context, path string) []client.Object) source.Source {
+	events := make(chan event.GenericEvent)
+	subscriber.AddHandler(func(ctx context.Context, path string) {
+		for _, object := range match(ctx, path) {
+			select {
+			case events <- event.GenericEvent{Object: object}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+	return &source.Channel{Source: events}
+}
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/Masterminds/sprig/v3"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,9 +57,15 @@ func AdvancedTemplateFuncMap(config *rest.Config, logger logr.Logger) template.F
 		"fromJsonArray": fromJSONArray,
 
 		// A variety of known templating functions that have not been implemented yet
-		"include":  func(string, interface{}) string { return "not implemented" },
-		"tpl":      func(string, interface{}) interface{} { return "not implemented" },
 		"required": func(string, interface{}) (interface{}, error) { return "not implemented", nil },
+
+		// include and tpl are bound to the template executing them by NewTemplate
+		"include": func(string, interface{}) (string, error) {
+			return "", errors.New("include is only available to the templates created by NewTemplate")
+		},
+		"tpl": func(string, interface{}) (string, error) {
+			return "", errors.New("tpl is only available to the templates created by NewTemplate")
+		},
 	}
 
 	for k, v := range extra {
@@ -82,6 +90,88 @@ func AdvancedTemplateFuncMap(config *rest.Config, logger logr.Logger) template.F
 	return f
 }
 
+const (
+	// recursionMaxNums is the maximum number of nested calls of include for a same named template
+	recursionMaxNums = 100
+	// includeMaxCalls is the maximum number of calls of include and tpl while rendering a template, it stops templates including themselves several times
+	includeMaxCalls = 10000
+)
+
+// includeBudget tracks the calls of include and tpl while rendering a template
+type includeBudget struct {
+	// nested counts the nested calls of include for each named template
+	nested map[string]int
+	calls  int
+}
+
+func (b *includeBudget) call() error {
+	b.calls++
+	if b.calls > includeMaxCalls {
+		return errors.Errorf("rendering template exceeds the maximum of %d calls of include and tpl", includeMaxCalls)
+	}
+	return nil
+}
+
+// NewTemplate returns a template named name with the functions of funcMap, the include and tpl functions, and the named templates of namedTemplates, ready to parse the text of name.
+func NewTemplate(name string, funcMap template.FuncMap, namedTemplates map[string]string) (*template.Template, error) {
+	t := template.New(name).Funcs(funcMap)
+	bindIncludeFunctions(t, &includeBudget{nested: map[string]int{}})
+	names := make([]string, 0, len(namedTemplates))
+	for namedTemplate := range namedTemplates {
+		names = append(names, namedTemplate)
+	}
+	sort.Strings(names)
+	for _, namedTemplate := range names {
+		if _, err := t.New(namedTemplate).Parse(namedTemplates[namedTemplate]); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// bindIncludeFunctions binds include and tpl to t, so that they can execute the named templates of t.
+// budget limits the nested calls of include for each named template and the total calls of include and tpl, to stop recursive templates.
+func bindIncludeFunctions(t *template.Template, budget *includeBudget) {
+	t.Funcs(template.FuncMap{
+		// include executes the named template name with data and returns its output, so that it can be piped to other functions
+		"include": func(name string, data interface{}) (string, error) {
+			if err := budget.call(); err != nil {
+				return "", err
+			}
+			if budget.nested[name] > recursionMaxNums {
+				return "", errors.Errorf("rendering template has a nested reference name: %s", name)
+			}
+			budget.nested[name]++
+			defer func() { budget.nested[name]-- }()
+			var b strings.Builder
+			if err := t.ExecuteTemplate(&b, name, data); err != nil {
+				return "", err
+			}
+			return b.String(), nil
+		},
+		// tpl executes text as a template with data, the named templates of t are available to text
+		"tpl": func(text string, data interface{}) (string, error) {
+			if err := budget.call(); err != nil {
+				return "", err
+			}
+			clone, err := t.Clone()
+			if err != nil {
+				return "", errors.Wrap(err, "cannot clone template")
+			}
+			bindIncludeFunctions(clone, budget)
+			tpl, err := clone.New(t.Name()).Parse(text)
+			if err != nil {
+				return "", errors.Wrapf(err, "cannot parse template %q", text)
+			}
+			var b strings.Builder
+			if err := tpl.Execute(&b, data); err != nil {
+				return "", errors.Wrapf(err, "error during tpl function execution for %q", text)
+			}
+			return b.String(), nil
+		},
+	})
+}
+
 // toYAML takes an interface, marshals it to yaml, and returns a string. It will
 // always return a string, even on marshal error (empty string).
 //
@@ -189,7 +279,7 @@ func NewLookupFunction(config *rest.Config, logger logr.Logger) lookupFunc {
 	return func(apiversion string, resource string, namespace string, name string) (map[string]interface{}, error) {
 		var client dynamic.ResourceInterface
 		ctx := context.TODO()
-		ctx = context.WithValue(ctx, "restConfig", config)
+		ctx = vaultutils.WithRestConfig(ctx, config)
 		ctx = log.IntoContext(ctx, logger.WithName("lookup function"))
 		c, namespaced, err := GetDynamicClientForGVK(ctx, schema.FromAPIVersionAndKind(apiversion, resource))
 		if err != nil {
@@ -226,3 +316,38 @@ func NewLookupFunction(config *rest.Config, logger logr.Logger) lookupFunc {
 		return obj.UnstructuredContent(), nil
 	}
 }
+
+// VaultTemplateFuncMap returns the templating functions calling vault. The first argument of each function is the name of a vault secret definition, the function calls vault with the
+// authenticated client of that definition, held by the context returned by definitionContext.
+func VaultTemplateFuncMap(definitionContext func(name string) (context.Context, error)) template.FuncMap {
+	return template.FuncMap{
+		// vaultRead returns the data of the secret at path, or an empty map when there is no secret at path
+		"vaultRead": func(name string, path string) (map[string]interface{}, error) {
+			ctx, err := definitionContext(name)
+			if err != nil {
+				return map[string]interface{}{}, err
+			}
+			secret, ok, err := vaultutils.ReadSecret(ctx, path)
+			if err != nil || !ok {
+				return map[string]interface{}{}, err
+			}
+			return secret.Data, nil
+		},
+		// vaultList returns the keys at path, the keys ending with / are folders
+		"vaultList": func(name string, path string) ([]string, error) {
+			ctx, err := definitionContext(name)
+			if err != nil {
+				return []string{}, err
+			}
+			return vaultutils.ListSecret(ctx, path)
+		},
+		// transitEncrypt returns the ciphertext of plaintext encrypted with key by the transit secret engine mounted at mount
+		"transitEncrypt": func(name string, mount string, key string, plaintext string) (string, error) {
+			ctx, err := definitionContext(name)
+			if err != nil {
+				return "", err
+			}
+			return vaultutils.TransitEncrypt(ctx, mount, key, plaintext)
+		},
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/go-logr/logr"
+	vault "github.com/hashicorp/vault/api"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+)
+
+func TestVaultTemplateFuncMap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v1/kv/app" && r.URL.Query().Get("list") == "true":
+			_, _ = w.Write([]byte(`{"data": {"keys": ["db", "api"]}}`))
+		case r.URL.Path == "/v1/kv/app/db":
+			_, _ = w.Write([]byte(`{"data": {"password": "db-password"}}`))
+		case r.URL.Path == "/v1/kv/app/api":
+			_, _ = w.Write([]byte(`{"data": {"password": "api-password"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	config := vault.DefaultConfig()
+	config.Address = server.URL
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	vaultClient.SetToken("test")
+	funcMap := VaultTemplateFuncMap(func(name string) (context.Context, error) {
+		if name != "kv" {
+			return nil, fmt.Errorf("%s is not the name of a vault secret definition", name)
+		}
+		return vaultutils.WithVaultClient(context.Background(), vaultClient), nil
+	})
+
+	render := func(text string) (string, error) {
+		tpl, err := template.New("").Funcs(funcMap).Parse(text)
+		if err != nil {
+			return "", err
+		}
+		var b bytes.Buffer
+		err = tpl.Execute(&b, nil)
+		return b.String(), err
+	}
+
+	rendered, err := render(`{{ range $key := vaultList "kv" "kv/app" }}{{ $key }}={{ (vaultRead "kv" (print "kv/app/" $key)).password }}
+{{ end }}`)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if rendered != "db=db-password\napi=api-password\n" {
+		t.Errorf("unexpected rendered template %q", rendered)
+	}
+
+	rendered, err = render(`{{ vaultRead "kv" "kv/app/missing" | len }}`)
+	if err != nil || rendered != "0" {
+		t.Errorf("expected an empty map for a missing secret, got %q (%v)", rendered, err)
+	}
+
+	if _, err := render(`{{ vaultList "unknown" "kv/app" }}`); err == nil {
+		t.Errorf("expected an error for an unknown vault secret definition")
+	}
+}
+
+func TestNewTemplate(t *testing.T) {
+	namedTemplates := map[string]string{
+		"dsn":       `postgres://{{ .username }}:{{ .password }}@db:5432`,
+		"recursive": `{{ include "recursive" . }}`,
+		"doubling":  `{{ if lt (len .) 40 }}{{ include "doubling" (append . 1) }}{{ include "doubling" (append . 1) }}{{ end }}`,
+	}
+	render := func(text string, data interface{}) (string, error) {
+		tpl, err := NewTemplate("key", AdvancedTemplateFuncMap(nil, logr.Discard()), namedTemplates)
+		if err != nil {
+			return "", err
+		}
+		if tpl, err = tpl.Parse(text); err != nil {
+			return "", err
+		}
+		var b bytes.Buffer
+		err = tpl.Execute(&b, data)
+		return b.String(), err
+	}
+	data := map[string]interface{}{"username": "app", "password": "secret", "text": `{{ .username | upper }}`}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "template action", text: `{{ template "dsn" . }}`, expected: "postgres://app:secret@db:5432"},
+		{name: "include", text: `{{ include "dsn" . | b64enc }}`, expected: "cG9zdGdyZXM6Ly9hcHA6c2VjcmV0QGRiOjU0MzI="},
+		{name: "tpl", text: `{{ tpl .text . }}`, expected: "APP"},
+		{name: "tpl with named template", text: `{{ tpl "{{ include \"dsn\" . | quote }}" . }}`, expected: `"postgres://app:secret@db:5432"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := render(tt.text, data)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if rendered != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, rendered)
+			}
+		})
+	}
+
+	if _, err := render(`{{ include "recursive" . }}`, data); err == nil || !strings.Contains(err.Error(), "nested reference") {
+		t.Errorf("expected a recursion error, got %v", err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := render(`{{ include "doubling" . }}`, []interface{}{})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "maximum of") {
+			t.Errorf("expected a template including itself several times to exceed the calls of include, got %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("expected a template including itself several times to be stopped")
+	}
+	if _, err := render(`{{ include "missing" . }}`, data); err == nil {
+		t.Errorf("expected an error for a missing named template")
+	}
+	if _, err := NewTemplate("key", AdvancedTemplateFuncMap(nil, logr.Discard()), map[string]string{"invalid": "{{ .unclosed"}); err == nil {
+		t.Errorf("expected a parse error for an invalid named template")
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"context"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// FieldManager is the field manager of the resources applied by the operator
+const FieldManager = "vault-config-operator"
+
+// legacyFieldManagers are the field managers of the resources created and updated by the operator before they were applied, the api server derived them from the name of the operator binary
+var legacyFieldManagers = sets.New("manager")
+
+const OutputConflict = "OutputConflict"
+const FieldConflictReason = "FieldConflict"
+const NoFieldConflictReason = "NoFieldConflict"
+
+// ApplyResource applies obj with server-side apply, so that only the fields set in obj are managed by the operator and the fields set by other field managers, such as labels and annotations, are kept.
+// if owner is not nil, the owner field is set
+// if namespace is not "", the namespace field of the object is overwritten with the passed value
+// Setting a field managed by another field manager fails with a conflict error, the field is not overwritten.
+func (r *ReconcilerBase) ApplyResource(context context.Context, owner client.Object, namespace string, obj client.Object) error {
+	log := log.FromContext(context)
+	if owner != nil {
+		_ = controllerutil.SetControllerReference(owner, obj, r.GetScheme())
+	}
+	if namespace != "" {
+		obj.SetNamespace(namespace)
+	}
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+
+	err := r.upgradeManagedFields(context, obj)
+	if err != nil {
+		log.Error(err, "unable to upgrade the managed fields of object", "object", obj)
+		return err
+	}
+
+	err = r.GetClient().Patch(context, obj, client.Apply, client.FieldOwner(FieldManager))
+	if err != nil {
+		log.Error(err, "unable to apply object", "object", obj)
+		return err
+	}
+	return nil
+}
+
+// upgradeManagedFields transfers the fields of the existing obj managed by the legacy field managers of the operator to FieldManager, otherwise applying obj would conflict with the operator's own updates
+func (r *ReconcilerBase) upgradeManagedFields(context context.Context, obj client.Object) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+	err := r.GetClient().Get(context, types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}, existing)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing, legacyFieldManagers, FieldManager)
+	if err != nil || patch == nil {
+		return err
+	}
+	return r.GetClient().Patch(context, existing, client.RawPatch(types.JSONPatchType, patch))
+}
+
+// ManageOutputConflict records on the instance whether applying its output conflicted with the fields of another field manager, err being the outcome of the apply. Once the conflict is resolved, the condition is set to false. The status is persisted by ManageOutcome.
+func ManageOutputConflict(context context.Context, r ReconcilerBase, obj client.Object, err error) {
+	conditionsAware, ok := obj.(vaultutils.ConditionsAware)
+	if !ok {
+		return
+	}
+	condition := metav1.Condition{
+		Type:               OutputConflict,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: obj.GetGeneration(),
+	}
+	if err != nil && !apierrors.IsConflict(err) {
+		return
+	}
+	if err == nil {
+		if meta.FindStatusCondition(conditionsAware.GetConditions(), OutputConflict) == nil {
+			return
+		}
+		condition.Reason = NoFieldConflictReason
+		condition.Status = metav1.ConditionFalse
+		conditionsAware.SetConditions(vaultutils.AddOrReplaceCondition(condition, conditionsAware.GetConditions()))
+		return
+	}
+	condition.Reason = FieldConflictReason
+	condition.Status = metav1.ConditionTrue
+	condition.Message = err.Error()
+	r.GetRecorder().Event(obj, "Warning", FieldConflictReason, condition.Message)
+	conditionsAware.SetConditions(vaultutils.AddOrReplaceCondition(condition, conditionsAware.GetConditions()))
+}
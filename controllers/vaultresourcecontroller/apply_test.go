@@ -0,0 +1,63 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestManageOutputConflict(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := ReconcilerBase{recorder: recorder}
+	instance := &redhatcopv1alpha1.VaultSecret{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "test"}}
+	ctx := context.Background()
+
+	ManageOutputConflict(ctx, r, instance, nil)
+	if len(instance.Status.Conditions) != 0 {
+		t.Fatalf("expected no condition without a previous conflict, got %v", instance.Status.Conditions)
+	}
+
+	conflict := apierrors.NewConflict(schema.GroupResource{Resource: "secrets"}, "app", errors.New(`Apply failed with 1 conflict: conflict with "kubectl-edit" using v1: .data.password`))
+	ManageOutputConflict(ctx, r, instance, conflict)
+	condition := meta.FindStatusCondition(instance.Status.Conditions, OutputConflict)
+	if condition == nil || condition.Status != metav1.ConditionTrue || condition.Reason != FieldConflictReason || condition.Message != conflict.Error() {
+		t.Fatalf("expected a conflict condition, got %v", condition)
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("expected a conflict event")
+	}
+
+	ManageOutputConflict(ctx, r, instance, errors.New("connection refused"))
+	if condition := meta.FindStatusCondition(instance.Status.Conditions, OutputConflict); condition.Status != metav1.ConditionTrue {
+		t.Errorf("expected other errors to keep the conflict condition")
+	}
+
+	ManageOutputConflict(ctx, r, instance, nil)
+	condition = meta.FindStatusCondition(instance.Status.Conditions, OutputConflict)
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Reason != NoFieldConflictReason {
+		t.Errorf("expected the conflict to be resolved, got %v", condition)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"context"
+	"strings"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const Drift = "Drift"
+const DriftCorrectedReason = "DriftCorrected"
+const DriftDetectedReason = "DriftDetected"
+const NoDriftDetectedReason = "NoDriftDetected"
+
+// ManageDrift records on the instance the drift corrected during the current reconcile cycle, a nil report means Vault matched the desired state. In observe-only mode the drift is recorded as detected rather than corrected. The status is persisted by ManageOutcome.
+func ManageDrift(context context.Context, r ReconcilerBase, obj client.Object, report *vaultutils.DriftReport) {
+	log := log.FromContext(context)
+	conditionsAware, ok := obj.(vaultutils.ConditionsAware)
+	if !ok {
+		return
+	}
+	condition := metav1.Condition{
+		Type:               Drift,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             NoDriftDetectedReason,
+		Status:             metav1.ConditionFalse,
+	}
+	if report != nil {
+		condition.Reason = DriftCorrectedReason
+		condition.Message = "drift corrected"
+		if vaultutils.IsObserveOnlyContext(context) {
+			condition.Reason = DriftDetectedReason
+			condition.Message = "drift detected"
+		}
+		if summary := report.Summary(); summary != "" {
+			condition.Message += " (" + summary + ")"
+		}
+		condition.Status = metav1.ConditionTrue
+		log.Info(condition.Message, "drift", report.Fields)
+		r.GetRecorder().Event(obj, "Normal", condition.Reason, condition.Message)
+		if driftAware, ok := obj.(vaultutils.DriftAware); ok {
+			driftAware.SetDriftReport(report)
+		}
+	}
+	conditionsAware.SetConditions(vaultutils.AddOrReplaceCondition(condition, conditionsAware.GetConditions()))
+}
+
+const ObserveOnly = "ObserveOnly"
+const PendingChangesReason = "PendingChanges"
+const InSyncReason = "InSync"
+const ObserveOnlyDisabledReason = "ObserveOnlyDisabled"
+
+// ManageObserveOnly reports on the instance the Vault writes and deletes skipped during an observe-only reconcile cycle. Outside observe-only mode it only clears a previously reported ObserveOnly condition. The status is persisted by ManageOutcome.
+func ManageObserveOnly(context context.Context, r ReconcilerBase, obj client.Object) {
+	conditionsAware, ok := obj.(vaultutils.ConditionsAware)
+	if !ok {
+		return
+	}
+	condition := metav1.Condition{
+		Type:               ObserveOnly,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: obj.GetGeneration(),
+	}
+	if !vaultutils.IsObserveOnlyContext(context) {
+		if meta.FindStatusCondition(conditionsAware.GetConditions(), ObserveOnly) == nil {
+			return
+		}
+		condition.Reason = ObserveOnlyDisabledReason
+		condition.Status = metav1.ConditionFalse
+		conditionsAware.SetConditions(vaultutils.AddOrReplaceCondition(condition, conditionsAware.GetConditions()))
+		return
+	}
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = InSyncReason
+	operations := vaultutils.GetPlannedOperations(context)
+	if len(operations) > 0 {
+		planned := []string{}
+		for _, operation := range operations {
+			planned = append(planned, operation.String())
+		}
+		condition.Reason = PendingChangesReason
+		condition.Message = "observe-only, skipped: " + strings.Join(planned, "; ")
+		r.GetRecorder().Event(obj, "Normal", PendingChangesReason, condition.Message)
+	}
+	conditionsAware.SetConditions(vaultutils.AddOrReplaceCondition(condition, conditionsAware.GetConditions()))
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var reconcileFailedDesc = prometheus.NewDesc(
+	"vault_config_operator_resources_reconcile_failed",
+	"Number of resources whose last reconcile cycle failed, by kind.",
+	[]string{"kind"}, nil,
+)
+
+// reconcileFailedCollector counts the resources in ReconcileFailed state when metrics are collected. Reading the resources from the manager cache, rather than tracking the reconcile outcomes, ensures that deleted resources are never counted.
+type reconcileFailedCollector struct {
+	reader client.Reader
+	kinds  map[string]schema.GroupVersionKind
+	scheme *runtime.Scheme
+}
+
+// NewReconcileFailedCollector returns a collector of the number of resources in ReconcileFailed state, for every kind of groupVersion that reports conditions
+func NewReconcileFailedCollector(reader client.Reader, scheme *runtime.Scheme, groupVersion schema.GroupVersion) prometheus.Collector {
+	kinds := map[string]schema.GroupVersionKind{}
+	for kind := range scheme.KnownTypes(groupVersion) {
+		obj, err := scheme.New(groupVersion.WithKind(kind))
+		if err != nil {
+			continue
+		}
+		if _, ok := obj.(vaultutils.ConditionsAware); !ok {
+			continue
+		}
+		if _, ok := scheme.KnownTypes(groupVersion)[kind+"List"]; ok {
+			kinds[kind] = groupVersion.WithKind(kind + "List")
+		}
+	}
+	return &reconcileFailedCollector{
+		reader: reader,
+		kinds:  kinds,
+		scheme: scheme,
+	}
+}
+
+func (c *reconcileFailedCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- reconcileFailedDesc
+}
+
+func (c *reconcileFailedCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	kinds := make([]string, 0, len(c.kinds))
+	for kind := range c.kinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		obj, err := c.scheme.New(c.kinds[kind])
+		if err != nil {
+			continue
+		}
+		list, ok := obj.(client.ObjectList)
+		if !ok {
+			continue
+		}
+		// the resources cannot be read before the cache is started, the kind is not reported rather than reported as zero
+		if err := c.reader.List(ctx, list); err != nil {
+			continue
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			continue
+		}
+		failed := 0
+		for _, item := range items {
+			if conditionsAware, ok := item.(vaultutils.ConditionsAware); ok && IsReconcileFailed(conditionsAware.GetConditions()) {
+				failed++
+			}
+		}
+		ch <- prometheus.MustNewConstMetric(reconcileFailedDesc, prometheus.GaugeValue, float64(failed), kind)
+	}
+}
+
+// IsReconcileFailed returns whether the last reconcile cycle failed. The ReconcileSuccessful and ReconcileFailed conditions are both kept once set, the most recent one reports the outcome of the last reconcile cycle.
+func IsReconcileFailed(conditions []metav1.Condition) bool {
+	failed := meta.FindStatusCondition(conditions, ReconcileFailed)
+	if failed == nil {
+		return false
+	}
+	successful := meta.FindStatusCondition(conditions, ReconcileSuccessful)
+	return successful == nil || !failed.LastTransitionTime.Before(&successful.LastTransitionTime)
+}
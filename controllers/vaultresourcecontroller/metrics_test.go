@@ -0,0 +1,94 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsReconcileFailed(t *testing.T) {
+	earlier := metav1.NewTime(time.Now().Add(-time.Minute))
+	later := metav1.NewTime(time.Now())
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		expected   bool
+	}{
+		{name: "no conditions", expected: false},
+		{name: "successful", conditions: []metav1.Condition{{Type: ReconcileSuccessful, LastTransitionTime: later}}, expected: false},
+		{name: "failed", conditions: []metav1.Condition{{Type: ReconcileFailed, LastTransitionTime: later}}, expected: true},
+		{name: "failed after success", conditions: []metav1.Condition{
+			{Type: ReconcileSuccessful, LastTransitionTime: earlier},
+			{Type: ReconcileFailed, LastTransitionTime: later},
+		}, expected: true},
+		{name: "successful after failure", conditions: []metav1.Condition{
+			{Type: ReconcileSuccessful, LastTransitionTime: later},
+			{Type: ReconcileFailed, LastTransitionTime: earlier},
+		}, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if IsReconcileFailed(tt.conditions) != tt.expected {
+				t.Errorf("expected IsReconcileFailed to be %v", tt.expected)
+			}
+		})
+	}
+}
+
+func TestReconcileFailedCollector(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := redhatcopv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to build scheme: %v", err)
+	}
+	failed := []metav1.Condition{{Type: ReconcileFailed, Status: metav1.ConditionFalse, Reason: ReconcileFailedReason, LastTransitionTime: metav1.Now()}}
+	successful := []metav1.Condition{{Type: ReconcileSuccessful, Status: metav1.ConditionTrue, Reason: ReconcileSuccessfulReason, LastTransitionTime: metav1.Now()}}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&redhatcopv1alpha1.Policy{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "test"}, Status: redhatcopv1alpha1.PolicyStatus{Conditions: failed}},
+		&redhatcopv1alpha1.Policy{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "test"}, Status: redhatcopv1alpha1.PolicyStatus{Conditions: failed}},
+		&redhatcopv1alpha1.Policy{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "test"}, Status: redhatcopv1alpha1.PolicyStatus{Conditions: successful}},
+		&redhatcopv1alpha1.Group{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "test"}, Status: redhatcopv1alpha1.GroupStatus{Conditions: successful}},
+	).Build()
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(NewReconcileFailedCollector(kubeClient, scheme, redhatcopv1alpha1.GroupVersion))
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("unable to gather metrics: %v", err)
+	}
+	counts := map[string]float64{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			counts[metric.GetLabel()[0].GetValue()] = metric.GetGauge().GetValue()
+		}
+	}
+	// every kind reporting conditions is collected, including the kinds without resources
+	for kind, expected := range map[string]float64{"Policy": 2, "Group": 0, "SecretEngineMount": 0} {
+		if count, ok := counts[kind]; !ok || count != expected {
+			t.Errorf("expected %v %s resources in ReconcileFailed state, got %v", expected, kind, counts[kind])
+		}
+	}
+	if _, ok := counts["VaultSecretList"]; ok {
+		t.Errorf("expected list kinds not to be collected")
+	}
+}
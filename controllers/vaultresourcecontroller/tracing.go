@@ -0,0 +1,49 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"context"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"go.opentelemetry.io/otel/attribute"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type tracingReconciler struct {
+	kind       string
+	reconciler reconcile.Reconciler
+}
+
+// NewTracingReconciler returns a reconciler that runs each reconcile cycle of reconciler in a Reconcile span, so that the spans of the cycle, including the vault requests, share the same trace
+func NewTracingReconciler(kind string, reconciler reconcile.Reconciler) reconcile.Reconciler {
+	return &tracingReconciler{
+		kind:       kind,
+		reconciler: reconciler,
+	}
+}
+
+func (r *tracingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	ctx, span := vaultutils.StartSpan(ctx, "Reconcile",
+		attribute.String("k8s.resource.kind", r.kind),
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.resource.name", req.Name),
+	)
+	defer func() { vaultutils.EndSpan(span, err) }()
+	return r.reconciler.Reconcile(ctx, req)
+}
@@ -25,9 +25,7 @@ import (
 	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
@@ -230,6 +228,11 @@ func (p PeriodicReconcilePredicate) Update(e event.UpdateEvent) bool {
 		return true
 	}
 
+	// Always reconcile when observe-only mode is turned on or off for the object
+	if vaultutils.IsObserveOnly(e.ObjectNew) != vaultutils.IsObserveOnly(e.ObjectOld) {
+		return true
+	}
+
 	// Only do periodic drift detection if enabled
 	if !IsDriftDetectionEnabled() {
 		return false
@@ -254,48 +257,6 @@ func (p PeriodicReconcilePredicate) Update(e event.UpdateEvent) bool {
 	return false
 }
 
-// CreateOrUpdateResource creates a resource if it doesn't exist, and updates (overwrites it), if it exist
-// if owner is not nil, the owner field os set
-// if namespace is not "", the namespace field of the object is overwritten with the passed value
-func (r *ReconcilerBase) CreateOrUpdateResource(context context.Context, owner client.Object, namespace string, obj client.Object) error {
-	log := log.FromContext(context)
-	if owner != nil {
-		_ = controllerutil.SetControllerReference(owner, obj, r.GetScheme())
-	}
-	if namespace != "" {
-		obj.SetNamespace(namespace)
-	}
-
-	obj2 := &unstructured.Unstructured{}
-	obj2.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
-
-	err := r.GetClient().Get(context, types.NamespacedName{
-		Namespace: obj.GetNamespace(),
-		Name:      obj.GetName(),
-	}, obj2)
-
-	if apierrors.IsNotFound(err) {
-		err = r.GetClient().Create(context, obj)
-		if err != nil {
-			log.Error(err, "unable to create object", "object", obj)
-			return err
-		}
-		return nil
-	}
-	if err == nil {
-		obj.SetResourceVersion(obj2.GetResourceVersion())
-		err = r.GetClient().Update(context, obj)
-		if err != nil {
-			log.Error(err, "unable to update object", "object", obj)
-			return err
-		}
-		return nil
-
-	}
-	log.Error(err, "unable to lookup object", "object", obj)
-	return err
-}
-
 // DeleteResourceIfExists deletes an existing resource. It doesn't fail if the resource does not exist
 func (r *ReconcilerBase) DeleteResourceIfExists(context context.Context, obj client.Object) error {
 	log := log.FromContext(context)
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	"github.com/stretchr/testify/mock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -258,3 +259,27 @@ func TestPeriodicReconcilePredicate_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriodicReconcilePredicate_UpdateObserveOnlyAnnotation(t *testing.T) {
+	predicate := NewPeriodicReconcilePredicate(5 * time.Minute)
+	os.Unsetenv("ENABLE_DRIFT_DETECTION")
+
+	oldObj := &MockConditionsAware{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation:  1,
+			Annotations: map[string]string{vaultutils.ObserveOnlyAnnotation: "true"},
+		},
+	}
+	newObj := &MockConditionsAware{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 1,
+		},
+	}
+
+	if !predicate.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj}) {
+		t.Errorf("expected removing the observe-only annotation to trigger a reconcile")
+	}
+	if predicate.Update(event.UpdateEvent{ObjectOld: newObj, ObjectNew: newObj}) {
+		t.Errorf("expected no reconcile when neither generation nor observe-only annotation changed")
+	}
+}
@@ -47,6 +47,10 @@ func (r *VaultEngineResource) manageCleanUpLogic(context context.Context, instan
 			return nil
 		}
 	}
+	if !vaultutils.IsVaultObjectOwned(instance) {
+		log.Info("the vault object was neither created nor adopted by the operator, skipping its deletion", "instance", instance)
+		return nil
+	}
 	// we delete this only if it has actually been created. We assume that if there was a successful reconcile cycle the resource was created in Vault
 	if conditionAware, ok := instance.(vaultutils.ConditionsAware); ok {
 		for _, condition := range conditionAware.GetConditions() {
@@ -66,6 +70,9 @@ func (r *VaultEngineResource) Reconcile(ctx context.Context, instance client.Obj
 	log := log.FromContext(ctx)
 	log.Info("starting reconcile cycle")
 	log.V(1).Info("reconcile", "instance", instance)
+	if vaultutils.IsObserveOnly(instance) {
+		ctx = vaultutils.WithObserveOnly(ctx)
+	}
 	if !instance.GetDeletionTimestamp().IsZero() {
 		if !controllerutil.ContainsFinalizer(instance, vaultutils.GetFinalizer(instance)) {
 			return reconcile.Result{}, nil
@@ -75,6 +82,7 @@ func (r *VaultEngineResource) Reconcile(ctx context.Context, instance client.Obj
 			log.Error(err, "unable to delete instance", "instance", instance)
 			return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
 		}
+		ManageObserveOnly(ctx, *r.reconcilerBase, instance)
 		controllerutil.RemoveFinalizer(instance, vaultutils.GetFinalizer(instance))
 		err = r.reconcilerBase.GetClient().Update(ctx, instance)
 		if err != nil {
@@ -84,6 +92,7 @@ func (r *VaultEngineResource) Reconcile(ctx context.Context, instance client.Obj
 		return reconcile.Result{}, nil
 	}
 	err := r.manageReconcileLogic(ctx, instance)
+	ManageObserveOnly(ctx, *r.reconcilerBase, instance)
 	if err != nil {
 		log.Error(err, "unable to complete reconcile logic", "instance", instance)
 		return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
@@ -111,6 +120,11 @@ func (r *VaultEngineResource) manageReconcileLogic(context context.Context, inst
 		log.Error(err, "unable to check if exists", "instance", instance)
 		return err
 	}
+	err = vaultutils.CheckManagementPolicy(instance, instance.(vaultutils.VaultObject).GetPath(), found, nil)
+	if err != nil {
+		log.Error(err, "unable to manage engine", "instance", instance)
+		return err
+	}
 	if !found {
 		err = r.vaultEngineEndpoint.Create(context)
 		if err != nil {
@@ -123,6 +137,10 @@ func (r *VaultEngineResource) manageReconcileLogic(context context.Context, inst
 			log.Error(err, "unable to create or update tune config", "instance", instance)
 			return err
 		}
+		ManageDrift(context, *r.reconcilerBase, instance, r.vaultEngineEndpoint.GetDriftReport())
+	}
+	if !vaultutils.IsObserveOnlyContext(context) {
+		vaultutils.RecordVaultOwnership(instance, found)
 	}
 	accessor, err := r.vaultEngineEndpoint.GetAccessor(context)
 	if err != nil {
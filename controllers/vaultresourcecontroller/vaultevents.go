@@ -0,0 +1,326 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	vault "github.com/hashicorp/vault/api"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"golang.org/x/net/websocket"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// KVv2DataWriteEventType is the type of the Vault events notified when the data of a KV v2 secret is written
+const KVv2DataWriteEventType = "kv-v2/data-write"
+
+// serviceAccountNamespaceFile holds the namespace of the operator
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+const vaultEventsMinBackoff = time.Second
+const vaultEventsMaxBackoff = 5 * time.Minute
+const vaultEventsRevokeTimeout = 10 * time.Second
+
+// IsVaultEventsSubscriptionEnabled returns whether the KV v2 secret writes are watched through the Vault event notifications.
+// Controlled via VAULT_EVENTS_SUBSCRIBE environment variable (default: false)
+func IsVaultEventsSubscriptionEnabled() bool {
+	subscribe, ok := os.LookupEnv("VAULT_EVENTS_SUBSCRIBE")
+	return ok && subscribe == "true"
+}
+
+// VaultEventHandler handles the path of a KV v2 secret written in Vault, for example secret/data/foo
+type VaultEventHandler func(ctx context.Context, path string)
+
+// VaultEventSubscriber subscribes over a WebSocket to the Vault notifications of the KV v2 secret writes and dispatches the paths of the written secrets to its handlers.
+// The subscription is reestablished with an exponential backoff when it is lost, the secrets written in the meantime are only picked up by the periodic refreshes.
+type VaultEventSubscriber struct {
+	vaultClient *vault.Client
+	// login returns the token the subscription is authenticated with
+	login func(ctx context.Context, vaultClient *vault.Client) (string, error)
+	// revokeTokens is set when login logs in, the token of each subscription is then revoked when the subscription ends
+	revokeTokens bool
+	handlers     []VaultEventHandler
+	log          logr.Logger
+}
+
+// NewVaultEventSubscriberFromEnv creates a subscriber to the Vault configured by the standard VAULT_ADDR, VAULT_CACERT, VAULT_NAMESPACE... environment variables.
+// The subscription is authenticated with VAULT_TOKEN when set, otherwise the operator logs in with VAULT_EVENTS_AUTHENTICATION, an authentication block in YAML or JSON, using the service accounts and secrets of its own namespace.
+// kubeClient and restConfig are used by the auth methods to read the secrets and request the service account tokens.
+func NewVaultEventSubscriberFromEnv(kubeClient client.Client, restConfig *rest.Config) (*VaultEventSubscriber, error) {
+	config := vault.DefaultConfig()
+	if config.Error != nil {
+		return nil, config.Error
+	}
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	if vaultClient.Token() != "" {
+		return NewVaultEventSubscriber(vaultClient, nil), nil
+	}
+	authentication, ok := os.LookupEnv("VAULT_EVENTS_AUTHENTICATION")
+	if !ok || authentication == "" {
+		return nil, errors.New("VAULT_TOKEN or VAULT_EVENTS_AUTHENTICATION must be set to subscribe to the vault events")
+	}
+	kc := &vaultutils.KubeAuthConfiguration{}
+	err = yaml.UnmarshalStrict([]byte(authentication), kc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAULT_EVENTS_AUTHENTICATION: %w", err)
+	}
+	if kc.Path == "" {
+		kc.Path = vaultutils.KubernetesAuthMethod
+	}
+	if kc.GetNamespace() != "" {
+		vaultClient.SetNamespace(kc.GetNamespace())
+	}
+	kubeNamespace, err := os.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the namespace of the operator: %w", err)
+	}
+	return NewVaultEventSubscriber(vaultClient, authenticatedLogin(kc, strings.TrimSpace(string(kubeNamespace)), kubeClient, restConfig)), nil
+}
+
+// NewVaultEventSubscriber creates a subscriber to the Vault of vaultClient. The subscription is authenticated with the token returned by login, or with the token of vaultClient when login is nil.
+// The tokens returned by login are revoked when their subscription ends, so that a subscription reestablished many times does not leave as many tokens behind.
+func NewVaultEventSubscriber(vaultClient *vault.Client, login func(ctx context.Context, vaultClient *vault.Client) (string, error)) *VaultEventSubscriber {
+	revokeTokens := login != nil
+	if login == nil {
+		login = func(_ context.Context, vaultClient *vault.Client) (string, error) {
+			return vaultClient.Token(), nil
+		}
+	}
+	return &VaultEventSubscriber{
+		vaultClient:  vaultClient,
+		login:        login,
+		revokeTokens: revokeTokens,
+		log:          ctrl.Log.WithName("vault-events"),
+	}
+}
+
+// authenticatedLogin logs in with the auth method of kc, as an object of kubeNamespace would
+func authenticatedLogin(kc *vaultutils.KubeAuthConfiguration, kubeNamespace string, kubeClient client.Client, restConfig *rest.Config) func(ctx context.Context, vaultClient *vault.Client) (string, error) {
+	return func(ctx context.Context, vaultClient *vault.Client) (string, error) {
+		ctx = vaultutils.WithKubeClient(ctx, kubeClient)
+		ctx = vaultutils.WithRestConfig(ctx, restConfig)
+		secret, err := kc.Login(ctx, vaultClient, kubeNamespace)
+		if err != nil {
+			return "", err
+		}
+		return secret.Auth.ClientToken, nil
+	}
+}
+
+// Watches returns whether the subscriber receives the events of the Vault an object connects to with connection and authentication, as found in its spec, in kubeNamespace.
+// The objects connecting to another Vault server or namespace are only refreshed on their refresh schedule.
+func (s *VaultEventSubscriber) Watches(ctx context.Context, connection *vaultutils.VaultConnection, authentication *vaultutils.KubeAuthConfiguration, kubeNamespace string) bool {
+	// like the clients of the objects, the subscriber falls back to the namespace of VAULT_NAMESPACE
+	namespace := authentication.GetNamespace()
+	if namespace == "" {
+		namespace = os.Getenv(vault.EnvVaultNamespace)
+	}
+	if vaultutils.CleansePath(namespace) != vaultutils.CleansePath(s.vaultClient.Namespace()) {
+		return false
+	}
+	address, err := connection.GetAddress(ctx, kubeNamespace)
+	if err != nil {
+		s.log.Error(err, "unable to resolve the vault address", "namespace", kubeNamespace)
+		return false
+	}
+	return strings.TrimSuffix(address, "/") == strings.TrimSuffix(s.vaultClient.Address(), "/")
+}
+
+// AddHandler registers handler to be called with the path of each KV v2 secret written in Vault. Handlers must be added before the subscriber is started.
+func (s *VaultEventSubscriber) AddHandler(handler VaultEventHandler) {
+	s.handlers = append(s.handlers, handler)
+}
+
+// Start subscribes to the Vault events until ctx is done, it implements manager.Runnable.
+func (s *VaultEventSubscriber) Start(ctx context.Context) error {
+	backoff := vaultEventsMinBackoff
+	for {
+		subscribed, err := s.subscribe(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if subscribed {
+			backoff = vaultEventsMinBackoff
+		}
+		s.log.Error(err, "vault events subscription lost", "eventType", KVv2DataWriteEventType, "retryIn", backoff)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, vaultEventsMaxBackoff)
+	}
+}
+
+// subscribe dispatches the events received on a new subscription until it is lost, it returns whether the subscription was established
+func (s *VaultEventSubscriber) subscribe(ctx context.Context) (bool, error) {
+	token, err := s.login(ctx, s.vaultClient)
+	if err != nil {
+		return false, fmt.Errorf("unable to log in to vault: %w", err)
+	}
+	if s.revokeTokens {
+		defer s.revoke(token)
+	}
+	config, err := s.websocketConfig(token)
+	if err != nil {
+		return false, err
+	}
+	conn, err := config.DialContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+	// the receive below only returns when the connection is closed
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer stop()
+	s.log.Info("subscribed to vault events", "eventType", KVv2DataWriteEventType)
+
+	for {
+		var message []byte
+		err := websocket.Message.Receive(conn, &message)
+		if err != nil {
+			return true, err
+		}
+		path, err := parseKVWriteEvent(message)
+		if err != nil {
+			s.log.Error(err, "unable to parse vault event", "event", string(message))
+			continue
+		}
+		s.log.V(1).Info("received vault event", "eventType", KVv2DataWriteEventType, "path", path)
+		for _, handler := range s.handlers {
+			handler(ctx, path)
+		}
+	}
+}
+
+// revoke revokes the token a subscription logged in with. The token is revoked even when the subscriber is stopping, the revocation therefore has its own timeout.
+func (s *VaultEventSubscriber) revoke(token string) {
+	vaultClient, err := s.vaultClient.Clone()
+	if err != nil {
+		s.log.Error(err, "unable to revoke the vault events subscription token")
+		return
+	}
+	vaultClient.SetToken(token)
+	vaultClient.SetNamespace(s.vaultClient.Namespace())
+	ctx, cancel := context.WithTimeout(context.Background(), vaultEventsRevokeTimeout)
+	defer cancel()
+	err = vaultClient.Auth().Token().RevokeSelfWithContext(ctx, "")
+	if err != nil {
+		s.log.Error(err, "unable to revoke the vault events subscription token")
+	}
+}
+
+// websocketConfig returns the configuration of the WebSocket connection to the sys/events/subscribe endpoint of Vault
+func (s *VaultEventSubscriber) websocketConfig(token string) (*websocket.Config, error) {
+	address, err := url.Parse(s.vaultClient.Address())
+	if err != nil {
+		return nil, err
+	}
+	origin := *address
+	switch address.Scheme {
+	case "http":
+		address.Scheme = "ws"
+	case "https":
+		address.Scheme = "wss"
+	default:
+		return nil, fmt.Errorf("unsupported vault address scheme %s", address.Scheme)
+	}
+	address = address.JoinPath("v1", "sys", "events", "subscribe", KVv2DataWriteEventType)
+	address.RawQuery = url.Values{"json": []string{"true"}}.Encode()
+	config, err := websocket.NewConfig(address.String(), origin.String())
+	if err != nil {
+		return nil, err
+	}
+	config.Header.Set("X-Vault-Token", token)
+	if namespace := s.vaultClient.Namespace(); namespace != "" {
+		config.Header.Set("X-Vault-Namespace", namespace)
+	}
+	if transport, ok := s.vaultClient.CloneConfig().HttpClient.Transport.(*http.Transport); ok && transport.TLSClientConfig != nil {
+		config.TlsConfig = transport.TLSClientConfig.Clone()
+	}
+	return config, nil
+}
+
+// vaultEvent is the CloudEvents envelope of a Vault event notification in JSON
+type vaultEvent struct {
+	Data struct {
+		Event struct {
+			Metadata struct {
+				Path     string `json:"path"`
+				DataPath string `json:"data_path"`
+			} `json:"metadata"`
+		} `json:"event"`
+	} `json:"data"`
+}
+
+// parseKVWriteEvent returns the path of the KV v2 secret written according to the event notification message
+func parseKVWriteEvent(message []byte) (string, error) {
+	event := vaultEvent{}
+	err := json.Unmarshal(message, &event)
+	if err != nil {
+		return "", err
+	}
+	// data_path was added to the event metadata in Vault 1.14, the path of Vault 1.13 is the data path as well
+	path := event.Data.Event.Metadata.DataPath
+	if path == "" {
+		path = event.Data.Event.Metadata.Path
+	}
+	if path == "" {
+		return "", errors.New("no path in the event metadata")
+	}
+	return vaultutils.CleansePath(path), nil
+}
+
+// IsVaultEventPath returns whether the secret at path, as found in a spec, is the secret at eventPath, as notified by a Vault event
+func IsVaultEventPath(path string, eventPath string) bool {
+	return vaultutils.CleansePath(path) == vaultutils.CleansePath(eventPath)
+}
+
+// RefreshRequests records the objects to refresh on their next reconcile cycle, regardless of their refresh schedule.
+type RefreshRequests struct {
+	requests sync.Map
+}
+
+// Request records that the object named key must be refreshed
+func (r *RefreshRequests) Request(key types.NamespacedName) {
+	r.requests.Store(key, struct{}{})
+}
+
+// Take returns whether a refresh of the object named key was requested and clears the request
+func (r *RefreshRequests) Take(key types.NamespacedName) bool {
+	_, requested := r.requests.LoadAndDelete(key)
+	return requested
+}
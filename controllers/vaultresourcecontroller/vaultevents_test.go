@@ -0,0 +1,265 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	"golang.org/x/net/websocket"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestVaultEventSubscriberDispatchesWrittenPaths(t *testing.T) {
+	var mutex sync.Mutex
+	connections := 0
+	mux := http.NewServeMux()
+	mux.Handle("/v1/sys/events/subscribe/kv-v2/data-write", websocket.Handler(func(conn *websocket.Conn) {
+		request := conn.Request()
+		if request.Header.Get("X-Vault-Token") != "s.events" || request.URL.Query().Get("json") != "true" {
+			t.Errorf("unexpected subscription request, token: %s, query: %s", request.Header.Get("X-Vault-Token"), request.URL.RawQuery)
+			return
+		}
+		mutex.Lock()
+		connections++
+		connection := connections
+		mutex.Unlock()
+		if connection == 1 {
+			// Vault 1.13 only has the path in the event metadata, the malformed event is skipped
+			websocket.Message.Send(conn, `{"data":{"event":{"metadata":{"operation":"data-write","path":"secret/data/foo"}},"event_type":"kv-v2/data-write"}}`)
+			websocket.Message.Send(conn, `not an event`)
+			// the subscription is lost and reestablished
+			return
+		}
+		websocket.Message.Send(conn, `{"data":{"event":{"metadata":{"current_version":"2","data_path":"secret/data/bar","operation":"data-write","path":"secret/data/bar"}},"event_type":"kv-v2/data-write"}}`)
+		var message []byte
+		websocket.Message.Receive(conn, &message)
+	}))
+	revoked := []string{}
+	mux.HandleFunc("/v1/auth/token/revoke-self", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		revoked = append(revoked, r.Header.Get("X-Vault-Token"))
+		mutex.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := vault.DefaultConfig()
+	config.Address = server.URL
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	logins := 0
+	subscriber := NewVaultEventSubscriber(vaultClient, func(_ context.Context, _ *vault.Client) (string, error) {
+		logins++
+		return "s.events", nil
+	})
+	paths := make(chan string, 10)
+	subscriber.AddHandler(func(_ context.Context, path string) {
+		paths <- path
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- subscriber.Start(ctx)
+	}()
+	for _, expected := range []string{"secret/data/foo", "secret/data/bar"} {
+		select {
+		case path := <-paths:
+			if path != expected {
+				t.Errorf("expected path %s, got %s", expected, path)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("no event received for %s", expected)
+		}
+	}
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected the subscriber to stop without error, got %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("the subscriber did not stop")
+	}
+	if logins != 2 {
+		t.Errorf("expected a login per subscription, got %d logins", logins)
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(revoked) != 2 || revoked[0] != "s.events" || revoked[1] != "s.events" {
+		t.Errorf("expected the token of each subscription to be revoked when it ends, got %v", revoked)
+	}
+}
+
+func TestVaultEventSubscriberWebsocketConfig(t *testing.T) {
+	config := vault.DefaultConfig()
+	config.Address = "https://vault.example.com:8200/"
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	vaultClient.SetNamespace("team-a")
+	subscriber := NewVaultEventSubscriber(vaultClient, nil)
+	if subscriber.revokeTokens {
+		t.Errorf("expected the token of the vault client not to be revoked")
+	}
+	wsConfig, err := subscriber.websocketConfig("s.token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wsConfig.Location.String() != "wss://vault.example.com:8200/v1/sys/events/subscribe/kv-v2/data-write?json=true" {
+		t.Errorf("unexpected subscription url %s", wsConfig.Location)
+	}
+	if wsConfig.Header.Get("X-Vault-Token") != "s.token" || wsConfig.Header.Get("X-Vault-Namespace") != "team-a" {
+		t.Errorf("unexpected subscription headers %v", wsConfig.Header)
+	}
+}
+
+func TestVaultEventSubscriberWatches(t *testing.T) {
+	t.Setenv("VAULT_ADDR", "https://vault.example.com:8200")
+	t.Setenv("VAULT_NAMESPACE", "")
+	connection := &unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"address": "https://vault.example.com:8200/"}}}
+	connection.SetGroupVersionKind(schema.GroupVersionKind{Group: "redhatcop.redhat.io", Version: "v1alpha1", Kind: vaultutils.NamespacedVaultConnectionKind})
+	connection.SetNamespace("team-a")
+	connection.SetName("local")
+	ctx := vaultutils.WithKubeClient(context.TODO(), fake.NewClientBuilder().WithObjects(connection).Build())
+
+	config := vault.DefaultConfig()
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	vaultClient.SetNamespace("team-a")
+	subscriber := NewVaultEventSubscriber(vaultClient, nil)
+
+	tests := []struct {
+		name       string
+		connection *vaultutils.VaultConnection
+		namespace  string
+		expected   bool
+	}{
+		{"default connection", nil, "team-a", true},
+		{"same address", &vaultutils.VaultConnection{Address: "https://vault.example.com:8200/"}, "team-a/", true},
+		{"other address", &vaultutils.VaultConnection{Address: "https://other.example.com:8200"}, "team-a", false},
+		{"referenced connection", &vaultutils.VaultConnection{ConnectionRef: &vaultutils.VaultConnectionReference{Kind: vaultutils.NamespacedVaultConnectionKind, Name: "local"}}, "team-a", true},
+		{"missing referenced connection", &vaultutils.VaultConnection{ConnectionRef: &vaultutils.VaultConnectionReference{Name: "missing"}}, "team-a", false},
+		{"other vault namespace", nil, "team-b", false},
+		{"root vault namespace", nil, "", false},
+	}
+	for _, test := range tests {
+		if actual := subscriber.Watches(ctx, test.connection, &vaultutils.KubeAuthConfiguration{Namespace: test.namespace}, "team-a"); actual != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+	}
+
+	// the objects without a vault namespace use the namespace of VAULT_NAMESPACE, as the subscriber does
+	t.Setenv("VAULT_NAMESPACE", "team-a")
+	if !subscriber.Watches(ctx, nil, &vaultutils.KubeAuthConfiguration{}, "team-a") {
+		t.Errorf("expected the objects without a vault namespace to be watched")
+	}
+}
+
+func TestVaultEventSubscriberAuthenticatedLogin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/auth/approle/login" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		payload := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload["role_id"] != "events" || payload["secret_id"] != "s3cr3t" {
+			t.Errorf("unexpected login payload %v", payload)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"auth":{"client_token":"s.approle"}}`))
+	}))
+	defer server.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "vault-config-operator", Name: "vault-events"},
+		Data:       map[string][]byte{"role_id": []byte("events"), "secret_id": []byte("s3cr3t")},
+	}
+	config := vault.DefaultConfig()
+	config.Address = server.URL
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		t.Fatalf("unable to create vault client: %v", err)
+	}
+	login := authenticatedLogin(&vaultutils.KubeAuthConfiguration{
+		Method:  vaultutils.AppRoleAuthMethod,
+		Path:    "approle",
+		AppRole: &vaultutils.AppRoleAuthentication{Secret: &corev1.LocalObjectReference{Name: "vault-events"}},
+	}, "vault-config-operator", fake.NewClientBuilder().WithObjects(secret).Build(), nil)
+	token, err := login(context.TODO(), vaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "s.approle" {
+		t.Errorf("expected the token of the approle login, got %s", token)
+	}
+}
+
+func TestIsVaultEventPath(t *testing.T) {
+	tests := []struct {
+		path      string
+		eventPath string
+		expected  bool
+	}{
+		{"secret/data/foo", "secret/data/foo", true},
+		{"/secret/data//foo/", "secret/data/foo", true},
+		{"secret/data/foo", "secret/data/foobar", false},
+		{"secret/foo", "secret/data/foo", false},
+	}
+	for _, test := range tests {
+		if actual := IsVaultEventPath(test.path, test.eventPath); actual != test.expected {
+			t.Errorf("IsVaultEventPath(%q, %q) = %v, expected %v", test.path, test.eventPath, actual, test.expected)
+		}
+	}
+}
+
+func TestRefreshRequests(t *testing.T) {
+	requests := RefreshRequests{}
+	key := types.NamespacedName{Namespace: "test", Name: "app"}
+	if requests.Take(key) {
+		t.Errorf("expected no refresh request")
+	}
+	requests.Request(key)
+	requests.Request(key)
+	if !requests.Take(key) {
+		t.Errorf("expected a refresh request")
+	}
+	if requests.Take(key) {
+		t.Errorf("expected the refresh request to be cleared")
+	}
+}
@@ -47,6 +47,10 @@ func (r *VaultPKIEngineResource) manageCleanUpLogic(context context.Context, ins
 			return nil
 		}
 	}
+	if !vaultutils.IsVaultObjectOwned(instance) {
+		log.Info("the vault object was neither created nor adopted by the operator, skipping its deletion", "instance", instance)
+		return nil
+	}
 	if conditionAware, ok := instance.(vaultutils.ConditionsAware); ok {
 		for _, condition := range conditionAware.GetConditions() {
 			if condition.Status == metav1.ConditionTrue && condition.Type == ReconcileSuccessful {
@@ -66,6 +70,9 @@ func (r *VaultPKIEngineResource) Reconcile(ctx context.Context, instance client.
 	log := log.FromContext(ctx)
 	log.Info("starting reconcile cycle")
 	log.V(1).Info("reconcile", "instance", instance)
+	if vaultutils.IsObserveOnly(instance) {
+		ctx = vaultutils.WithObserveOnly(ctx)
+	}
 	if !instance.GetDeletionTimestamp().IsZero() {
 		log.Info("Delete", "Try to: ", instance)
 
@@ -78,6 +85,7 @@ func (r *VaultPKIEngineResource) Reconcile(ctx context.Context, instance client.
 			log.Error(err, "unable to delete instance", "instance", instance)
 			return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
 		}
+		ManageObserveOnly(ctx, *r.reconcilerBase, instance)
 		log.Info("RemoveFinalizer", "Try to: ", instance)
 		controllerutil.RemoveFinalizer(instance, vaultutils.GetFinalizer(instance))
 		err = r.reconcilerBase.GetClient().Update(ctx, instance)
@@ -88,6 +96,7 @@ func (r *VaultPKIEngineResource) Reconcile(ctx context.Context, instance client.
 		return reconcile.Result{}, nil
 	}
 	err := r.manageReconcileLogic(ctx, instance)
+	ManageObserveOnly(ctx, *r.reconcilerBase, instance)
 	if err != nil {
 		log.Error(err, "unable to complete reconcile logic", "instance", instance)
 		return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
@@ -104,6 +113,9 @@ func (r *VaultPKIEngineResource) manageReconcileLogic(context context.Context, i
 		return err
 	}
 
+	// in observe-only mode the CA is neither generated nor signed, so the generated and signed status must not be set
+	observeOnly := vaultutils.IsObserveOnlyContext(context)
+
 	//Generate
 	generated := instance.(vaultutils.VaultPKIEngineObject).GetGeneratedStatus()
 	if !generated {
@@ -112,16 +124,22 @@ func (r *VaultPKIEngineResource) manageReconcileLogic(context context.Context, i
 			log.Error(err, "unable to generate CA", "instance", instance)
 			return err
 		}
-		instance.(vaultutils.VaultPKIEngineObject).SetGeneratedStatus(true)
-
-		// Exported
-		exported, err := r.vaultPKIEngineEndpoint.CreateExported(context, vaultSecret)
-		if err != nil {
-			log.Error(err, "unable to create exported configuration", "instance", instance)
-			return err
+		if !observeOnly {
+			instance.(vaultutils.VaultPKIEngineObject).SetGeneratedStatus(true)
+
+			// Exported
+			exported, err := r.vaultPKIEngineEndpoint.CreateExported(context, vaultSecret)
+			if err != nil {
+				log.Error(err, "unable to create exported configuration", "instance", instance)
+				return err
+			}
+			instance.(vaultutils.VaultPKIEngineObject).SetExportedStatus(exported)
 		}
-		instance.(vaultutils.VaultPKIEngineObject).SetExportedStatus(exported)
+	}
 
+	// the CA is always generated by the operator, which therefore owns it
+	if instance.(vaultutils.VaultPKIEngineObject).GetGeneratedStatus() {
+		vaultutils.RecordVaultOwnership(instance, false)
 	}
 
 	// Sign Intermediate
@@ -132,7 +150,9 @@ func (r *VaultPKIEngineResource) manageReconcileLogic(context context.Context, i
 			log.Error(err, "unable to create intermediate configuration", "instance", instance)
 			return err
 		}
-		instance.(vaultutils.VaultPKIEngineObject).SetSignedStatus(true)
+		if !observeOnly {
+			instance.(vaultutils.VaultPKIEngineObject).SetSignedStatus(true)
+		}
 	}
 
 	// Config
@@ -146,6 +166,7 @@ func (r *VaultPKIEngineResource) manageReconcileLogic(context context.Context, i
 		log.Error(err, "unable to create or update crl config", "instance", instance)
 		return err
 	}
+	ManageDrift(context, *r.reconcilerBase, instance, r.vaultPKIEngineEndpoint.GetDriftReport())
 
 	return nil
 }
@@ -44,6 +44,9 @@ func (r *VaultResource) Reconcile(ctx context.Context, instance client.Object) (
 	log := log.FromContext(ctx)
 	log.Info("starting reconcile cycle")
 	log.V(1).Info("reconcile", "instance", instance)
+	if vaultutils.IsObserveOnly(instance) {
+		ctx = vaultutils.WithObserveOnly(ctx)
+	}
 	if !instance.GetDeletionTimestamp().IsZero() {
 		if !controllerutil.ContainsFinalizer(instance, vaultutils.GetFinalizer(instance)) {
 			return reconcile.Result{}, nil
@@ -53,6 +56,7 @@ func (r *VaultResource) Reconcile(ctx context.Context, instance client.Object) (
 			log.Error(err, "unable to delete instance", "instance", instance)
 			return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
 		}
+		ManageObserveOnly(ctx, *r.reconcilerBase, instance)
 		controllerutil.RemoveFinalizer(instance, vaultutils.GetFinalizer(instance))
 		err = r.reconcilerBase.GetClient().Update(ctx, instance)
 		if err != nil {
@@ -63,6 +67,7 @@ func (r *VaultResource) Reconcile(ctx context.Context, instance client.Object) (
 	}
 
 	err := r.manageReconcileLogic(ctx, instance)
+	ManageObserveOnly(ctx, *r.reconcilerBase, instance)
 	if err != nil {
 		log.Error(err, "unable to complete reconcile logic", "instance", instance)
 		return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
@@ -78,6 +83,10 @@ func (r *VaultResource) manageCleanUpLogic(context context.Context, instance cli
 			return nil
 		}
 	}
+	if !vaultutils.IsVaultObjectOwned(instance) {
+		log.Info("the vault object was neither created nor adopted by the operator, skipping its deletion", "instance", instance)
+		return nil
+	}
 	if conditionAware, ok := instance.(vaultutils.ConditionsAware); ok {
 		for _, condition := range conditionAware.GetConditions() {
 			if condition.Status == metav1.ConditionTrue && condition.Type == ReconcileSuccessful {
@@ -112,5 +121,11 @@ func (r *VaultResource) manageReconcileLogic(context context.Context, instance c
 		log.Error(err, "unable to create/update vault resource", "instance", instance)
 		return err
 	}
+	err = r.vaultEndpoint.CreateOrUpdateLease(context)
+	if err != nil {
+		log.Error(err, "unable to create/update lease configuration", "instance", instance)
+		return err
+	}
+	ManageDrift(context, *r.reconcilerBase, instance, r.vaultEndpoint.GetDriftReport())
 	return nil
 }
@@ -0,0 +1,125 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultresourcecontroller
+
+import (
+	"context"
+
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type VaultTransitKeyResource struct {
+	vaultTransitKeyEndpoint *vaultutils.VaultTransitKeyEndpoint
+	reconcilerBase          *ReconcilerBase
+}
+
+func NewVaultTransitKeyResource(reconcilerBase *ReconcilerBase, obj client.Object) *VaultTransitKeyResource {
+	return &VaultTransitKeyResource{
+		reconcilerBase:          reconcilerBase,
+		vaultTransitKeyEndpoint: vaultutils.NewVaultTransitKeyEndpoint(obj),
+	}
+}
+
+func (r *VaultTransitKeyResource) Reconcile(ctx context.Context, instance client.Object) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("starting reconcile cycle")
+	log.V(1).Info("reconcile", "instance", instance)
+	if vaultutils.IsObserveOnly(instance) {
+		ctx = vaultutils.WithObserveOnly(ctx)
+	}
+	if !instance.GetDeletionTimestamp().IsZero() {
+		if !controllerutil.ContainsFinalizer(instance, vaultutils.GetFinalizer(instance)) {
+			return reconcile.Result{}, nil
+		}
+		err := r.manageCleanUpLogic(ctx, instance)
+		if err != nil {
+			log.Error(err, "unable to delete instance", "instance", instance)
+			return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
+		}
+		ManageObserveOnly(ctx, *r.reconcilerBase, instance)
+		controllerutil.RemoveFinalizer(instance, vaultutils.GetFinalizer(instance))
+		err = r.reconcilerBase.GetClient().Update(ctx, instance)
+		if err != nil {
+			log.Error(err, "unable to update instance", "instance", instance)
+			return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	err := r.manageReconcileLogic(ctx, instance)
+	ManageObserveOnly(ctx, *r.reconcilerBase, instance)
+	if err != nil {
+		log.Error(err, "unable to complete reconcile logic", "instance", instance)
+		return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
+	}
+
+	return ManageOutcome(ctx, *r.reconcilerBase, instance, err)
+}
+
+// manageCleanUpLogic deletes the key only when deletion is allowed in its config, otherwise Vault refuses the deletion and the key is left in Vault
+func (r *VaultTransitKeyResource) manageCleanUpLogic(context context.Context, instance client.Object) error {
+	log := log.FromContext(context)
+	if !instance.(vaultutils.VaultObject).IsDeletable() {
+		log.Info("deletion is not allowed for the transit key, skipping its deletion", "instance", instance)
+		return nil
+	}
+	if !vaultutils.IsVaultObjectOwned(instance) {
+		log.Info("the vault object was neither created nor adopted by the operator, skipping its deletion", "instance", instance)
+		return nil
+	}
+	if conditionAware, ok := instance.(vaultutils.ConditionsAware); ok {
+		for _, condition := range conditionAware.GetConditions() {
+			if condition.Status == metav1.ConditionTrue && condition.Type == ReconcileSuccessful {
+				err := r.vaultTransitKeyEndpoint.DeleteIfExists(context)
+				if err != nil {
+					log.Error(err, "unable to delete vault resource", "instance", instance)
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *VaultTransitKeyResource) manageReconcileLogic(context context.Context, instance client.Object) error {
+	log := log.FromContext(context)
+	err := r.vaultTransitKeyEndpoint.CreateOrUpdate(context)
+	if err != nil {
+		log.Error(err, "unable to create/update vault resource", "instance", instance)
+		return err
+	}
+	ManageDrift(context, *r.reconcilerBase, instance, r.vaultTransitKeyEndpoint.GetDriftReport())
+
+	err = r.vaultTransitKeyEndpoint.Rotate(context)
+	if err != nil {
+		log.Error(err, "unable to rotate transit key", "instance", instance)
+		return err
+	}
+
+	err = r.vaultTransitKeyEndpoint.RecordLatestVersion(context)
+	if err != nil {
+		log.Error(err, "unable to read the latest version of the transit key", "instance", instance)
+		return err
+	}
+	return nil
+}
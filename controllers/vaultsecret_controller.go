@@ -33,37 +33,47 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/go-logr/logr"
 	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
 	vaultsecretutils "github.com/redhat-cop/vault-config-operator/controllers/vaultsecretutils"
 )
 
 const (
-	hashAnnotationName = "vaultsecret.redhatcop.redhat.io/secret-hash"
+	hashAnnotationName = vaultsecretutils.HashAnnotationName
 	vaultSecretKind    = "VaultSecret"
-	secretKind         = "Secret"
-	secretAPIVersion   = "v1"
+	// defaultLeaseRevocationGracePeriod is the time superseded leases are kept when the spec does not set one
+	defaultLeaseRevocationGracePeriod = 5 * time.Minute
 )
 
 // VaultSecretReconciler reconciles a VaultSecret object
 type VaultSecretReconciler struct {
 	vaultresourcecontroller.ReconcilerBase
+	// VaultEvents, when set, notifies the KV v2 secret writes that trigger an immediate refresh of the VaultSecrets reading them
+	VaultEvents     *vaultresourcecontroller.VaultEventSubscriber
+	refreshRequests vaultresourcecontroller.RefreshRequests
 }
 
 //+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=vaultsecrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=vaultsecrets/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=redhatcop.redhat.io,resources=vaultsecrets/finalizers,verbs=update
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
 //+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;patch
+//+kubebuilder:rbac:groups=apps,resources=deployments;statefulsets;daemonsets,verbs=get;list;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -87,8 +97,8 @@ func (r *VaultSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return reconcile.Result{}, err
 	}
 
-	ctx = context.WithValue(ctx, "kubeClient", r.GetClient())
-	ctx = context.WithValue(ctx, "restConfig", r.GetRestConfig())
+	ctx = vaultutils.WithKubeClient(ctx, r.GetClient())
+	ctx = vaultutils.WithRestConfig(ctx, r.GetRestConfig())
 
 	if !instance.GetDeletionTimestamp().IsZero() {
 		if !controllerutil.ContainsFinalizer(instance, vaultutils.GetFinalizer(instance)) {
@@ -108,35 +118,59 @@ func (r *VaultSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return reconcile.Result{}, nil
 	}
 
-	shouldSync, err := r.shouldSync(ctx, instance)
+	outputInSync, err := isOutputInSync(ctx, r.GetClient(), instance, &instance.Spec.TemplatizedK8sSecret, instance.Namespace)
 	if err != nil {
 		// There was a problem determining if the event should cause a sync.
 		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
 	}
 
-	if shouldSync {
-		err = r.manageSyncLogic(ctx, instance)
-		if err != nil {
-			r.Log.Error(err, "unable to complete sync logic", "instance", instance)
-			return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+	// a refresh requested by a vault event reads the secrets regardless of the refresh schedule
+	refreshRequested := r.refreshRequests.Take(req.NamespacedName)
+
+	if !outputInSync || refreshRequested || isRefreshDue(instance.Spec.RefreshPeriod, instance.Spec.RefreshThreshold, instance.Status.LastVaultSecretUpdate, instance.Status.LastLeaseRenewal, instance.Status.VaultSecretDefinitionsStatus) {
+		// when only the refresh of the secrets is due, their leases are renewed rather than new credentials read
+		if !outputInSync || refreshRequested || !r.manageRenewalLogic(ctx, instance) {
+			err = r.manageSyncLogic(ctx, instance)
+			if err != nil {
+				if refreshRequested {
+					r.refreshRequests.Request(req.NamespacedName)
+				}
+				r.Log.Error(err, "unable to complete sync logic", "instance", instance)
+				return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+			}
 		}
 	}
 
-	duration, ok := r.calculateDuration(instance)
+	err = r.manageRolloutLogic(ctx, instance)
+	if err != nil {
+		r.Log.Error(err, "unable to roll out the rollout targets", "instance", instance)
+		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, err)
+	}
+
+	instance.Status.SupersededLeases = revokeSupersededLeases(ctx, instance.GetObjectKind().GroupVersionKind().Kind, instance.Namespace, instance.Spec.VaultSecretDefinitions, instance.Status.SupersededLeases, false)
+	nextRevocation, revocationPending := nextLeaseRevocation(instance.Status.SupersededLeases)
 
-	// If a duration incalculable, simply don't requeue
+	duration, ok := calculateDuration(instance.Spec.RefreshPeriod, instance.Spec.RefreshThreshold, instance.Status.VaultSecretDefinitionsStatus)
+
+	// If a duration incalculable, simply don't requeue, unless superseded leases remain to be revoked
 	if !ok {
 		instance.Status.NextVaultSecretUpdate = nil
+		if revocationPending {
+			return vaultresourcecontroller.ManageOutcomeWithRequeue(ctx, r.ReconcilerBase, instance, nil, nextRevocation)
+		}
 		return vaultresourcecontroller.ManageOutcome(ctx, r.ReconcilerBase, instance, nil)
 	}
 
-	nextUpdateTime := instance.Status.LastVaultSecretUpdate.Add(duration)
+	nextUpdateTime := lastRefresh(instance.Status.LastVaultSecretUpdate, instance.Status.LastLeaseRenewal).Add(duration)
 
 	nextTimestamp := metav1.NewTime(nextUpdateTime)
 	instance.Status.NextVaultSecretUpdate = &nextTimestamp
 
 	//we reschedule the next reconcile at the time in the future corresponding to
 	nextSchedule := time.Until(nextUpdateTime)
+	if revocationPending && nextRevocation < nextSchedule {
+		nextSchedule = nextRevocation
+	}
 	if nextSchedule > 0 {
 		return vaultresourcecontroller.ManageOutcomeWithRequeue(ctx, r.ReconcilerBase, instance, err, nextSchedule)
 	} else {
@@ -146,84 +180,406 @@ func (r *VaultSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 }
 
 func (r *VaultSecretReconciler) manageCleanUpLogic(context context.Context, instance *redhatcopv1alpha1.VaultSecret) error {
+	output := vaultsecretutils.NewOutputObject(&instance.Spec.TemplatizedK8sSecret, instance.Namespace)
+	err := r.GetClient().Get(context, client.ObjectKeyFromObject(output), output)
+	if err != nil && !apierrors.IsNotFound(err) {
+		r.Log.Error(err, "unable to retrieve k8s output", "instance", instance, "k8s output", output)
+		return err
+	}
+	// an output object that is not owned by the VaultSecret is never touched
+	if err == nil && vaultresourcecontroller.IsOwner(instance, output) {
+		err = r.DeleteResourceIfExists(context, output)
+		if err != nil {
+			r.Log.Error(err, "unable to delete k8s output", "instance", instance, "k8s output", output)
+			return err
+		}
+	}
+	revokeLeases(context, instance.GetObjectKind().GroupVersionKind().Kind, instance.Namespace, instance.Spec.VaultSecretDefinitions, instance.Status.VaultSecretDefinitionsStatus)
+	revokeSupersededLeases(context, instance.GetObjectKind().GroupVersionKind().Kind, instance.Namespace, instance.Spec.VaultSecretDefinitions, instance.Status.SupersededLeases, true)
+	return nil
+}
 
-	k8sSecret := &corev1.Secret{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       secretKind,
-			APIVersion: secretAPIVersion,
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      instance.Spec.TemplatizedK8sSecret.Name,
-			Namespace: instance.Namespace,
-		},
+// manageRolloutLogic annotates the pod template of the rollout targets with the hash of the data of the output when it differs from the hash recorded at the last rollout, which restarts the workloads
+func (r *VaultSecretReconciler) manageRolloutLogic(ctx context.Context, instance *redhatcopv1alpha1.VaultSecret) error {
+	if len(instance.Spec.RolloutTargets) == 0 {
+		instance.Status.LastRolloutHash = ""
+		return nil
 	}
-	err := r.DeleteResourceIfExists(context, k8sSecret)
+	output := vaultsecretutils.NewOutputObject(&instance.Spec.TemplatizedK8sSecret, instance.Namespace)
+	err := r.GetClient().Get(ctx, client.ObjectKeyFromObject(output), output)
 	if err != nil {
-		r.Log.Error(err, "unable to delete k8s secret", "instance", instance, "k8s secret", k8sSecret)
 		return err
 	}
+	hash, ok := output.GetAnnotations()[hashAnnotationName]
+	if !ok || hash == instance.Status.LastRolloutHash {
+		return nil
+	}
+	// the targets already run with the data of the output when they first become targets
+	if instance.Status.LastRolloutHash == "" {
+		instance.Status.LastRolloutHash = hash
+		return nil
+	}
+	for i := range instance.Spec.RolloutTargets {
+		workloads, err := r.getRolloutTargets(ctx, instance, &instance.Spec.RolloutTargets[i])
+		if err != nil {
+			return err
+		}
+		for j := range workloads {
+			workload := &workloads[j]
+			original := workload.DeepCopy()
+			changed, err := vaultsecretutils.SetPodTemplateAnnotation(workload, instance.GetRolloutAnnotationName(), hash)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				continue
+			}
+			err = r.GetClient().Patch(ctx, workload, client.MergeFrom(original))
+			if err != nil {
+				return err
+			}
+			r.Log.Info("rolling out", "instance", toNamespacedName(instance), "kind", workload.GetKind(), "workload", workload.GetName())
+			r.GetRecorder().Event(instance, "Normal", "RolloutTriggered", fmt.Sprintf("%s %s rolled out with the new data of the output", workload.GetKind(), workload.GetName()))
+		}
+	}
+	instance.Status.LastRolloutHash = hash
 	return nil
 }
 
-func (r *VaultSecretReconciler) formatK8sSecret(instance *redhatcopv1alpha1.VaultSecret, data interface{}) (*corev1.Secret, error) {
+// getRolloutTargets returns the workloads selected by rolloutTarget. A workload selected by name that does not exist yet is skipped, it reads the current data of the output when it is created.
+func (r *VaultSecretReconciler) getRolloutTargets(ctx context.Context, instance *redhatcopv1alpha1.VaultSecret, rolloutTarget *redhatcopv1alpha1.RolloutTarget) ([]unstructured.Unstructured, error) {
+	if rolloutTarget.Name != "" {
+		workload := vaultsecretutils.NewRolloutTarget(rolloutTarget.Kind, rolloutTarget.Name, instance.Namespace)
+		err := r.GetClient().Get(ctx, client.ObjectKeyFromObject(workload), workload)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []unstructured.Unstructured{*workload}, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(rolloutTarget.Selector)
+	if err != nil {
+		return nil, err
+	}
+	list := vaultsecretutils.NewRolloutTargetList(rolloutTarget.Kind)
+	err = r.GetClient().List(ctx, list, client.InNamespace(instance.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
 
-	bytesData := make(map[string][]byte)
-	for k, v := range instance.Spec.TemplatizedK8sSecret.StringData {
+// definitionContext returns a context with a vault client authenticated with the authentication of vaultSecretDefinition, using the service accounts and secrets of kubeNamespace
+func definitionContext(ctx context.Context, kind string, kubeNamespace string, vaultSecretDefinition *redhatcopv1alpha1.VaultSecretDefinition) (context.Context, error) {
+	ctx = vaultutils.WithReconciledKind(ctx, kind)
+	ctx = vaultutils.WithVaultConnection(ctx, vaultSecretDefinition.GetVaultConnection())
+	vaultClient, err := vaultSecretDefinition.Authentication.GetVaultClient(ctx, kubeNamespace)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "unable to create vault client", "namespace", kubeNamespace, "name", vaultSecretDefinition.Name)
+		return nil, err
+	}
+	return vaultutils.WithVaultClient(ctx, vaultClient), nil
+}
+
+// readVaultSecrets reads the secrets of vaultSecretDefinitions and returns their data and, for KV v2 secrets, their metadata by definition name, with the status of their leases.
+// The leases of the secrets read before a failure are not recorded, they are revoked.
+func readVaultSecrets(ctx context.Context, kind string, kubeNamespace string, vaultSecretDefinitions []redhatcopv1alpha1.VaultSecretDefinition) (_ map[string]interface{}, _ map[string]interface{}, _ []redhatcopv1alpha1.VaultSecretDefinitionStatus, err error) {
+	rlog := log.FromContext(ctx)
+
+	mergedMap := make(map[string]interface{})
+	kvMetadata := make(map[string]interface{})
 
-		tpl, err := template.New("").Funcs(vaultresourcecontroller.AdvancedTemplateFuncMap(r.GetRestConfig(), r.Log)).Parse(v)
+	definitionsStatus := make([]redhatcopv1alpha1.VaultSecretDefinitionStatus, len(vaultSecretDefinitions))
+
+	defer func() {
 		if err != nil {
-			r.Log.Error(err, "unable to create template", "instance", instance)
-			return nil, err
+			revokeLeases(ctx, kind, kubeNamespace, vaultSecretDefinitions, definitionsStatus)
 		}
+	}()
 
-		var b bytes.Buffer
-		err = tpl.Execute(&b, data)
+	for idx := range vaultSecretDefinitions {
+		vaultSecretDefinition := &vaultSecretDefinitions[idx]
+		definitionCtx, err := definitionContext(ctx, kind, kubeNamespace, vaultSecretDefinition)
 		if err != nil {
-			r.Log.Error(err, "unable to execute template", "instance", instance)
+			return nil, nil, nil, err
+		}
+
+		vaultSecretEndpoint := vaultutils.NewVaultSecretEndpoint(vaultSecretDefinition)
+		vaultSecret, ok, err := vaultSecretEndpoint.GetSecret(definitionCtx)
+		if err != nil {
+			rlog.Error(err, "unable to read vault secret for ", "path", vaultSecretDefinition.GetPath())
+			return nil, nil, nil, err
+		}
+		if !ok {
+			return nil, nil, nil, errors.New("secret not found at path: " + vaultSecretDefinition.GetPath())
+		}
+
+		rlog.V(1).Info("", "", vaultSecret.LeaseDuration)
+
+		definitionsStatus[idx] = redhatcopv1alpha1.VaultSecretDefinitionStatus{
+			Name:          vaultSecretDefinition.Name,
+			LeaseID:       vaultSecret.LeaseID,
+			LeaseDuration: vaultSecret.LeaseDuration,
+			Renewable:     vaultSecret.Renewable,
+		}
+
+		if vaultSecret.Data == nil {
+			return nil, nil, nil, errors.New("no data returned from vault secret for " + vaultSecretDefinition.GetPath())
+		}
+
+		// if its a kv v2, then the structure returned is different
+		kv2DataMap, metadata, version, ok := vaultsecretutils.SplitKVv2Secret(vaultSecret.Data)
+		if ok {
+			mergedMap[vaultSecretDefinition.Name] = kv2DataMap
+			kvMetadata[vaultSecretDefinition.Name] = metadata
+			definitionsStatus[idx].Version = version
+		} else {
+			mergedMap[vaultSecretDefinition.Name] = vaultSecret.Data
+		}
+
+	}
+	return mergedMap, kvMetadata, definitionsStatus, nil
+}
+
+// renewLeases renews the leases of the secrets read at the last sync, up to their max TTL, and returns their new status. It returns false when new credentials must be read instead:
+// when the refresh is forced by a refresh period, when the definitions changed since the last sync, when a lease is not renewable or reached its max TTL, or when a renewal fails.
+// The secrets without a lease, such as KV secrets, are only read again with new credentials.
+func renewLeases(ctx context.Context, kind string, kubeNamespace string, refreshPeriod *metav1.Duration, vaultSecretDefinitions []redhatcopv1alpha1.VaultSecretDefinition, previousDefinitionsStatus []redhatcopv1alpha1.VaultSecretDefinitionStatus) ([]redhatcopv1alpha1.VaultSecretDefinitionStatus, bool) {
+	if refreshPeriod != nil || len(previousDefinitionsStatus) != len(vaultSecretDefinitions) {
+		return nil, false
+	}
+	renewedAny := false
+	definitionsStatus := make([]redhatcopv1alpha1.VaultSecretDefinitionStatus, len(vaultSecretDefinitions))
+	for idx := range vaultSecretDefinitions {
+		vaultSecretDefinition := &vaultSecretDefinitions[idx]
+		definitionStatus := previousDefinitionsStatus[idx]
+		if definitionStatus.Name != vaultSecretDefinition.Name {
+			return nil, false
+		}
+		if definitionStatus.LeaseID == "" {
+			definitionsStatus[idx] = definitionStatus
+			continue
+		}
+		if !definitionStatus.Renewable || definitionStatus.RenewalExhausted {
+			return nil, false
+		}
+		definitionCtx, err := definitionContext(ctx, kind, kubeNamespace, vaultSecretDefinition)
+		if err != nil {
+			return nil, false
+		}
+		renewal, err := vaultutils.RenewLease(definitionCtx, definitionStatus.LeaseID, definitionStatus.LeaseDuration)
+		if err != nil || renewal == nil {
+			return nil, false
+		}
+		// vault caps the lease duration at the max TTL of the lease, a shorter duration than requested means it cannot be extended any further
+		definitionStatus.RenewalExhausted = renewal.LeaseDuration < definitionStatus.LeaseDuration
+		definitionStatus.LeaseDuration = renewal.LeaseDuration
+		definitionsStatus[idx] = definitionStatus
+		renewedAny = true
+	}
+	return definitionsStatus, renewedAny
+}
+
+// revokeLeases revokes the leases of definitionsStatus with the authentication of the definitions of the same name. The failures are only logged, as the leases expire anyway.
+func revokeLeases(ctx context.Context, kind string, kubeNamespace string, vaultSecretDefinitions []redhatcopv1alpha1.VaultSecretDefinition, definitionsStatus []redhatcopv1alpha1.VaultSecretDefinitionStatus) {
+	for _, definitionStatus := range definitionsStatus {
+		if definitionStatus.LeaseID == "" {
+			continue
+		}
+		var vaultSecretDefinition *redhatcopv1alpha1.VaultSecretDefinition
+		for idx := range vaultSecretDefinitions {
+			if vaultSecretDefinitions[idx].Name == definitionStatus.Name {
+				vaultSecretDefinition = &vaultSecretDefinitions[idx]
+			}
+		}
+		if vaultSecretDefinition == nil {
+			log.FromContext(ctx).Info("unable to revoke the lease of a removed vault secret definition, it will expire", "name", definitionStatus.Name)
+			continue
+		}
+		definitionCtx, err := definitionContext(ctx, kind, kubeNamespace, vaultSecretDefinition)
+		if err != nil {
+			continue
+		}
+		_ = vaultutils.RevokeLease(definitionCtx, definitionStatus.LeaseID)
+	}
+}
+
+// supersedeLeases returns supersededLeases with the leases of definitionsStatus, which are no longer written to the output, to be revoked once gracePeriod has elapsed. A nil gracePeriod is the default one, the leases are revoked at once when it is zero.
+func supersedeLeases(ctx context.Context, kind string, kubeNamespace string, vaultSecretDefinitions []redhatcopv1alpha1.VaultSecretDefinition, supersededLeases []redhatcopv1alpha1.SupersededLease, definitionsStatus []redhatcopv1alpha1.VaultSecretDefinitionStatus, gracePeriod *metav1.Duration) []redhatcopv1alpha1.SupersededLease {
+	duration := defaultLeaseRevocationGracePeriod
+	if gracePeriod != nil {
+		duration = gracePeriod.Duration
+	}
+	if duration <= 0 {
+		revokeLeases(ctx, kind, kubeNamespace, vaultSecretDefinitions, definitionsStatus)
+		return supersededLeases
+	}
+	revokeAfter := metav1.NewTime(time.Now().Add(duration))
+	for _, definitionStatus := range definitionsStatus {
+		if definitionStatus.LeaseID != "" {
+			supersededLeases = append(supersededLeases, redhatcopv1alpha1.SupersededLease{Name: definitionStatus.Name, LeaseID: definitionStatus.LeaseID, RevokeAfter: revokeAfter})
+		}
+	}
+	return supersededLeases
+}
+
+// revokeSupersededLeases revokes the superseded leases whose grace period has elapsed, or all of them when all is true, and returns the remaining ones
+func revokeSupersededLeases(ctx context.Context, kind string, kubeNamespace string, vaultSecretDefinitions []redhatcopv1alpha1.VaultSecretDefinition, supersededLeases []redhatcopv1alpha1.SupersededLease, all bool) []redhatcopv1alpha1.SupersededLease {
+	due := []redhatcopv1alpha1.VaultSecretDefinitionStatus{}
+	remaining := []redhatcopv1alpha1.SupersededLease{}
+	for _, supersededLease := range supersededLeases {
+		if all || !time.Now().Before(supersededLease.RevokeAfter.Time) {
+			due = append(due, redhatcopv1alpha1.VaultSecretDefinitionStatus{Name: supersededLease.Name, LeaseID: supersededLease.LeaseID})
+		} else {
+			remaining = append(remaining, supersededLease)
+		}
+	}
+	revokeLeases(ctx, kind, kubeNamespace, vaultSecretDefinitions, due)
+	if len(remaining) == 0 {
+		return nil
+	}
+	return remaining
+}
+
+// nextLeaseRevocation returns the time until the next superseded lease must be revoked, or false when there are none
+func nextLeaseRevocation(supersededLeases []redhatcopv1alpha1.SupersededLease) (time.Duration, bool) {
+	if len(supersededLeases) == 0 {
+		return 0, false
+	}
+	next := supersededLeases[0].RevokeAfter.Time
+	for _, supersededLease := range supersededLeases[1:] {
+		if supersededLease.RevokeAfter.Before(&metav1.Time{Time: next}) {
+			next = supersededLease.RevokeAfter.Time
+		}
+	}
+	return max(time.Until(next), time.Second), true
+}
+
+// lastRefresh returns the last time the secrets were read from vault or their leases renewed
+func lastRefresh(lastVaultSecretUpdate *metav1.Time, lastLeaseRenewal *metav1.Time) *metav1.Time {
+	if lastLeaseRenewal != nil && (lastVaultSecretUpdate == nil || lastVaultSecretUpdate.Before(lastLeaseRenewal)) {
+		return lastLeaseRenewal
+	}
+	return lastVaultSecretUpdate
+}
+
+// templateFuncMap returns the templating functions bound to vaultSecretDefinitions: kvMetadata, returning the metadata of the KV v2 secrets read by the definitions, and the functions calling vault
+// with the authenticated client of a definition. The vault clients are only created when the functions calling vault are used.
+func templateFuncMap(ctx context.Context, kind string, kubeNamespace string, vaultSecretDefinitions []redhatcopv1alpha1.VaultSecretDefinition, kvMetadata map[string]interface{}) template.FuncMap {
+	definitionContexts := map[string]context.Context{}
+	funcMap := vaultresourcecontroller.VaultTemplateFuncMap(func(name string) (context.Context, error) {
+		if definitionCtx, ok := definitionContexts[name]; ok {
+			return definitionCtx, nil
+		}
+		for idx := range vaultSecretDefinitions {
+			if vaultSecretDefinitions[idx].Name == name {
+				definitionCtx, err := definitionContext(ctx, kind, kubeNamespace, &vaultSecretDefinitions[idx])
+				if err != nil {
+					return nil, err
+				}
+				definitionContexts[name] = definitionCtx
+				return definitionCtx, nil
+			}
+		}
+		return nil, fmt.Errorf("%s is not the name of a vault secret definition", name)
+	})
+	funcMap["kvMetadata"] = func(name string) (interface{}, error) {
+		metadata, ok := kvMetadata[name]
+		if !ok {
+			return nil, fmt.Errorf("%s is not the name of a KV v2 vault secret definition", name)
+		}
+		return metadata, nil
+	}
+	return funcMap
+}
+
+// getNamedTemplates returns the named templates shared by the templates of output, the ones of output.TemplatesConfigMap, read from namespace, overridden by the ones of output.Templates
+func getNamedTemplates(ctx context.Context, kubeClient client.Client, output *redhatcopv1alpha1.TemplatizedK8sSecret, namespace string) (map[string]string, error) {
+	namedTemplates := map[string]string{}
+	if output.TemplatesConfigMap != nil {
+		configMap := &corev1.ConfigMap{}
+		err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: output.TemplatesConfigMap.Name}, configMap)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "unable to read the named templates", "namespace", namespace, "name", output.TemplatesConfigMap.Name)
 			return nil, err
 		}
-		bytesData[k] = b.Bytes()
+		for name, text := range configMap.Data {
+			namedTemplates[name] = text
+		}
 	}
+	for name, text := range output.Templates {
+		namedTemplates[name] = text
+	}
+	return namedTemplates, nil
+}
+
+// templateErrors returns the errors rendering the templates of the output by key, nil when err is not a rendering error
+func templateErrors(err error) []redhatcopv1alpha1.TemplateError {
+	var templateErrors vaultsecretutils.TemplateErrors
+	if errors.As(err, &templateErrors) {
+		return templateErrors
+	}
+	return nil
+}
 
-	annotations := make(map[string]string)
-	annotations[hashAnnotationName] = vaultsecretutils.HashData(bytesData)
+// renderOutput renders the resource described by output in namespace, a Secret unless output specifies another kind of resource, and annotates it with the hash of its data.
+// The functions of funcMap are available to the templates, in addition to the advanced templating functions, and so are the named templates of namedTemplates.
+func renderOutput(context context.Context, restConfig *rest.Config, output *redhatcopv1alpha1.TemplatizedK8sSecret, namespace string, data interface{}, funcMap template.FuncMap, namedTemplates map[string]string) (_ client.Object, err error) {
+	_, span := vaultutils.StartSpan(context, "renderOutput")
+	defer func() { vaultutils.EndSpan(span, err) }()
+	rlog := log.FromContext(context)
 
-	for k, v := range instance.Spec.TemplatizedK8sSecret.Annotations {
-		annotations[k] = v
+	funcs := vaultresourcecontroller.AdvancedTemplateFuncMap(restConfig, rlog)
+	for k, v := range funcMap {
+		funcs[k] = v
 	}
 
-	k8sSecret := &corev1.Secret{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       secretKind,
-			APIVersion: secretAPIVersion,
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        instance.Spec.TemplatizedK8sSecret.Name,
-			Namespace:   instance.Namespace,
-			Annotations: annotations,
-			Labels:      instance.Spec.TemplatizedK8sSecret.Labels,
-		},
-		Data: bytesData,
-		Type: corev1.SecretType(instance.Spec.TemplatizedK8sSecret.Type),
+	render := func(key string, text string) ([]byte, error) {
+		tpl, err := vaultresourcecontroller.NewTemplate(key, funcs, namedTemplates)
+		if err == nil {
+			tpl, err = tpl.Parse(text)
+		}
+		if err != nil {
+			rlog.Error(err, "unable to create template", "output", output.Name, "key", key)
+			return nil, err
+		}
+
+		var b bytes.Buffer
+		err = tpl.Execute(&b, data)
+		if err != nil {
+			rlog.Error(err, "unable to execute template", "output", output.Name, "key", key)
+			return nil, err
+		}
+		return b.Bytes(), nil
 	}
 
-	return k8sSecret, nil
+	object, hash, err := vaultsecretutils.BuildOutputObject(output, namespace, render)
+	if err != nil {
+		return nil, err
+	}
+	annotations := object.GetAnnotations()
+	annotations[hashAnnotationName] = hash
+	object.SetAnnotations(annotations)
+
+	return object, nil
 }
 
 // Calculates the resync period based on the RefreshPeriod, and LeaseDurations returned from Vault for each secret defined (the smallest duration will be returned).
 // If no RefreshPeriod or Leasedurations are found return -1 and bool of false indicating that its was incalculable.
-func (r *VaultSecretReconciler) calculateDuration(instance *redhatcopv1alpha1.VaultSecret) (time.Duration, bool) {
+func calculateDuration(refreshPeriod *metav1.Duration, refreshThreshold int, definitionsStatus []redhatcopv1alpha1.VaultSecretDefinitionStatus) (time.Duration, bool) {
 
 	// if set, always use refresh period if set
-	if instance.Spec.RefreshPeriod != nil {
-		return instance.Spec.RefreshPeriod.Duration, true
+	if refreshPeriod != nil {
+		return refreshPeriod.Duration, true
 	}
 
-	if instance.Status.VaultSecretDefinitionsStatus != nil {
+	if definitionsStatus != nil {
 		// use the smallest LeaseDuration in the VaultDefinitionsStatus array
 		var smallestLeaseDurationSeconds int = math.MaxInt64
 
-		for _, defstat := range instance.Status.VaultSecretDefinitionsStatus {
+		for _, defstat := range definitionsStatus {
 			if defstat.LeaseDuration < smallestLeaseDurationSeconds {
 				smallestLeaseDurationSeconds = defstat.LeaseDuration
 			}
@@ -234,7 +590,7 @@ func (r *VaultSecretReconciler) calculateDuration(instance *redhatcopv1alpha1.Va
 			return -1, false
 		}
 
-		percentage := float64(instance.Spec.RefreshThreshold) / float64(100)
+		percentage := float64(refreshThreshold) / float64(100)
 		scaledSeconds := float64(smallestLeaseDurationSeconds) * percentage
 		duration := time.Duration(scaledSeconds) * time.Second
 		return duration, true
@@ -245,6 +601,24 @@ func (r *VaultSecretReconciler) calculateDuration(instance *redhatcopv1alpha1.Va
 
 }
 
+// isRefreshDue returns whether the secrets must be refreshed from vault, because they were never read or because their refresh period or lease threshold elapsed
+func isRefreshDue(refreshPeriod *metav1.Duration, refreshThreshold int, lastVaultSecretUpdate *metav1.Time, lastLeaseRenewal *metav1.Time, definitionsStatus []redhatcopv1alpha1.VaultSecretDefinitionStatus) bool {
+	// if the secrets were read before
+	if lastVaultSecretUpdate != nil {
+		duration, ok := calculateDuration(refreshPeriod, refreshThreshold, definitionsStatus)
+		// if the next duration is incalculable (no refreshperiod or lease duration), do not sync
+		if !ok {
+			return false
+		}
+		// if the resync period has not elapsed, do not sync
+		if !lastRefresh(lastVaultSecretUpdate, lastLeaseRenewal).Add(duration).Before(time.Now()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func toNamespacedName(obj metav1.Object) string {
 	if obj == nil {
 		return ""
@@ -257,128 +631,132 @@ func toNamespacedName(obj metav1.Object) string {
 	return namespacedName.String()
 }
 
-func (r *VaultSecretReconciler) shouldSync(ctx context.Context, instance *redhatcopv1alpha1.VaultSecret) (bool, error) {
+// isOutputInSync returns whether the output of owner exists in namespace and, for Secrets and ConfigMaps, whether its data matches the data written at the last sync
+func isOutputInSync(ctx context.Context, kubeClient client.Client, owner client.Object, output *redhatcopv1alpha1.TemplatizedK8sSecret, namespace string) (bool, error) {
 
-	secretNamespacedName := &types.NamespacedName{
-		Name:      instance.Spec.TemplatizedK8sSecret.Name,
-		Namespace: instance.Namespace,
-	}
-	secret := &corev1.Secret{}
-	err := r.GetClient().Get(ctx, *secretNamespacedName, secret)
+	object := vaultsecretutils.NewOutputObject(output, namespace)
+	outputNamespacedName := client.ObjectKeyFromObject(object)
+	err := kubeClient.Get(ctx, outputNamespacedName, object)
 	if err != nil {
-		//if k8s secret does not exist (it was deleted), it should sync
+		//if k8s output does not exist (it was deleted), it should sync
 		if apierrors.IsNotFound(err) {
-			return true, nil
+			return false, nil
 		}
 		// else there was an Error reading the object. It should not sync.
 		return false, err
 	} else {
 
-		//if the secret exists and isn't owned by this VaultSecret then the name needs to be different
-		if !vaultresourcecontroller.IsOwner(instance, secret) {
-			return false, fmt.Errorf("the k8s Secret %v is not owned by VaultSecret %v", secretNamespacedName.String(), toNamespacedName(instance))
+		//if the output exists and isn't owned by owner then the name needs to be different
+		if !vaultresourcecontroller.IsOwner(owner, object) {
+			return false, fmt.Errorf("the k8s %s %v is not owned by %s %v", output.GetGroupVersionKind().Kind, outputNamespacedName.String(), owner.GetObjectKind().GroupVersionKind().Kind, toNamespacedName(owner))
 		}
 
-		if secret.Annotations != nil {
-			hash, ok := secret.Annotations[hashAnnotationName]
-			if !ok {
-				return true, nil
-			}
-			// if the hash value in the k8s secret doesnt match the final data section, sync
-			if hash != vaultsecretutils.HashData(secret.Data) {
-				return true, nil
+		// the data of other resources than Secrets and ConfigMaps is only refreshed with the vault secrets
+		if dataHash, ok := vaultsecretutils.OutputDataHash(object, output); ok {
+			annotations := object.GetAnnotations()
+			if annotations != nil {
+				hash, ok := annotations[hashAnnotationName]
+				if !ok {
+					return false, nil
+				}
+				// if the hash value in the k8s output doesnt match the final data section, sync
+				if hash != dataHash {
+					return false, nil
+				}
+				// else the hash matches. continue with logic.
+			} else {
+				// if annotation is nil, sync
+				return false, nil
 			}
-			// else the hash matches. continue with logic.
-		} else {
-			// if annotation is nil, sync
-			return true, nil
 		}
 	}
+	return true, nil
+}
 
-	// if the vaultsecret has synced before
-	if instance.Status.LastVaultSecretUpdate != nil {
-		duration, ok := r.calculateDuration(instance)
-		// if the next duration is incalculable (no refreshperiod or lease duration), do not sync
-		if !ok {
-			return false, nil
-		}
-		// if the resync period has not elapsed, do not sync
-		if !instance.Status.LastVaultSecretUpdate.Add(duration).Before(time.Now()) {
-			return false, nil
-		}
+// manageRenewalLogic renews the leases of the secrets of instance, it returns false when new credentials must be read instead
+func (r *VaultSecretReconciler) manageRenewalLogic(ctx context.Context, instance *redhatcopv1alpha1.VaultSecret) bool {
+	definitionsStatus, ok := renewLeases(ctx, instance.GetObjectKind().GroupVersionKind().Kind, instance.Namespace, instance.Spec.RefreshPeriod, instance.Spec.VaultSecretDefinitions, instance.Status.VaultSecretDefinitionsStatus)
+	if !ok {
+		return false
 	}
-
-	return true, nil
+	r.Log.V(1).Info("renewed leases", "namespacedName", toNamespacedName(instance))
+	now := metav1.NewTime(time.Now())
+	instance.Status.LastLeaseRenewal = &now
+	instance.Status.VaultSecretDefinitionsStatus = definitionsStatus
+	return true
 }
 
 func (r *VaultSecretReconciler) manageSyncLogic(ctx context.Context, instance *redhatcopv1alpha1.VaultSecret) error {
 
 	r.Log.V(1).Info("Sync VaultSecret", "namespacedName", toNamespacedName(instance))
 
-	mergedMap := make(map[string]interface{})
-
-	definitionsStatus := make([]redhatcopv1alpha1.VaultSecretDefinitionStatus, len(instance.Spec.VaultSecretDefinitions))
-
-	for idx, vaultSecretDefinition := range instance.Spec.VaultSecretDefinitions {
-		ctx = context.WithValue(ctx, "vaultConnection", vaultSecretDefinition.GetVaultConnection())
-		vaultClient, err := vaultSecretDefinition.Authentication.GetVaultClient(ctx, instance.Namespace)
-		if err != nil {
-			r.Log.Error(err, "unable to create vault client", "instance", instance)
-			return err
-		}
-
-		ctx = context.WithValue(ctx, "vaultClient", vaultClient)
-		vaultSecretEndpoint := vaultutils.NewVaultSecretEndpoint(&vaultSecretDefinition)
-		vaultSecret, ok, err := vaultSecretEndpoint.GetSecret(ctx)
-		if err != nil {
-			r.Log.Error(err, "unable to read vault secret for ", "path", vaultSecretDefinition.GetPath())
-			return err
-		}
-		if !ok {
-			return errors.New("secret not found at path: " + vaultSecretDefinition.GetPath())
-		}
-
-		r.Log.V(1).Info("", "", vaultSecret.LeaseDuration)
-
-		definitionsStatus[idx] = redhatcopv1alpha1.VaultSecretDefinitionStatus{
-			Name:          vaultSecretDefinition.Name,
-			LeaseID:       vaultSecret.LeaseID,
-			LeaseDuration: vaultSecret.LeaseDuration,
-			Renewable:     vaultSecret.Renewable,
-		}
-
-		if vaultSecret.Data == nil {
-			return errors.New("no data returned from vault secret for " + vaultSecretDefinition.GetPath())
-		}
-
-		// if its a kv v2, then the structure returned is different
-		kv2DataMap, ok := vaultSecret.Data["data"]
-		if ok && reflect.ValueOf(kv2DataMap).Kind() == reflect.Map {
-			mergedMap[vaultSecretDefinition.Name] = kv2DataMap
-		} else {
-			mergedMap[vaultSecretDefinition.Name] = vaultSecret.Data
-		}
-
+	kind := instance.GetObjectKind().GroupVersionKind().Kind
+	namedTemplates, err := getNamedTemplates(ctx, r.GetClient(), &instance.Spec.TemplatizedK8sSecret, instance.Namespace)
+	if err != nil {
+		return err
 	}
 
-	k8sSecret, err := r.formatK8sSecret(instance, mergedMap)
+	mergedMap, kvMetadata, definitionsStatus, err := readVaultSecrets(ctx, kind, instance.Namespace, instance.Spec.VaultSecretDefinitions)
 	if err != nil {
-		r.Log.Error(err, "unable to format k8s secret", "instance", instance)
 		return err
 	}
 
-	err = r.CreateOrUpdateResource(ctx, instance, instance.GetNamespace(), k8sSecret)
+	output, err := renderOutput(ctx, r.GetRestConfig(), &instance.Spec.TemplatizedK8sSecret, instance.Namespace, mergedMap, templateFuncMap(ctx, kind, instance.Namespace, instance.Spec.VaultSecretDefinitions, kvMetadata), namedTemplates)
+	instance.Status.TemplateErrors = templateErrors(err)
+	if err == nil {
+		err = r.ApplyResource(ctx, instance, instance.GetNamespace(), output)
+		vaultresourcecontroller.ManageOutputConflict(ctx, r.ReconcilerBase, instance, err)
+	}
 	if err != nil {
+		r.Log.Error(err, "unable to write k8s output", "instance", instance)
+		revokeLeases(ctx, kind, instance.Namespace, instance.Spec.VaultSecretDefinitions, definitionsStatus)
 		return err
 	}
 
+	supersededDefinitionsStatus := instance.Status.VaultSecretDefinitionsStatus
 	now := metav1.NewTime(time.Now())
 	instance.Status.LastVaultSecretUpdate = &now
+	instance.Status.LastLeaseRenewal = nil
 	instance.Status.VaultSecretDefinitionsStatus = definitionsStatus
 
+	// the credentials of the previous leases are no longer written to the output, they are revoked once the workloads had time to pick up the new ones
+	instance.Status.SupersededLeases = supersedeLeases(ctx, kind, instance.Namespace, instance.Spec.VaultSecretDefinitions, instance.Status.SupersededLeases, supersededDefinitionsStatus, instance.Spec.LeaseRevocationGracePeriod)
+
 	return nil
 }
 
+// newOutputPredicate returns the predicate of the events of the outputs owned by ownerKind, which triggers a sync when an output is deleted or its data is modified
+func newOutputPredicate(rlog logr.Logger, ownerKind string) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if isOwnedBy(e.ObjectNew, ownerKind) {
+				hash, ok := e.ObjectNew.GetAnnotations()[hashAnnotationName]
+				dataHash, _ := vaultsecretutils.OutputDataHash(e.ObjectNew, nil)
+				if !ok || hash != dataHash {
+					rlog.V(1).Info("Update Event - hash mismatch", "kind", e.ObjectNew.GetObjectKind().GroupVersionKind().Kind, "namespacedName", toNamespacedName(e.ObjectNew))
+					return true
+				}
+			}
+
+			return false
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			//Useful for debugging
+			if isOwnedBy(e.Object, ownerKind) {
+				rlog.V(1).Info("Delete Event", "kind", e.Object.GetObjectKind().GroupVersionKind().Kind, "namespacedName", toNamespacedName(e.Object))
+				return true
+			}
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *VaultSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
@@ -418,52 +796,55 @@ func (r *VaultSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		},
 	}
 
-	k8sSecretPredicate := predicate.Funcs{
-		UpdateFunc: func(e event.UpdateEvent) bool {
-			newSecret, ok := e.ObjectNew.DeepCopyObject().(*corev1.Secret)
-			if !ok {
-				return false
-			}
+	k8sOutputPredicate := newOutputPredicate(r.Log, vaultSecretKind)
 
-			if r.isOwnedSecretByController(newSecret) {
-				hash, ok := newSecret.Annotations[hashAnnotationName]
-				if !ok || hash != vaultsecretutils.HashData(newSecret.Data) {
-					r.Log.V(1).Info("Update Event - hash mismatch", "kind", secretKind, "namespacedName", toNamespacedName(e.ObjectNew))
-					return true
-				}
-			}
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&redhatcopv1alpha1.VaultSecret{}, builder.WithPredicates(vaultSecretPredicate, vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
+		Owns(&corev1.Secret{}, builder.WithPredicates(k8sOutputPredicate)).
+		Owns(&corev1.ConfigMap{}, builder.WithPredicates(k8sOutputPredicate))
+	if r.VaultEvents != nil {
+		controllerBuilder = controllerBuilder.WatchesRawSource(vaultEventSource(r.VaultEvents, r.findVaultSecretsReading), &handler.EnqueueRequestForObject{})
+	}
+	return controllerBuilder.Complete(vaultresourcecontroller.NewTracingReconciler("VaultSecret", r))
+}
 
-			return false
-		},
-		CreateFunc: func(e event.CreateEvent) bool {
-			return false
-		},
-		DeleteFunc: func(e event.DeleteEvent) bool {
-			//Useful for debugging
-			secret, ok := e.Object.DeepCopyObject().(*corev1.Secret)
-			if !ok {
-				return false
-			}
-			if r.isOwnedSecretByController(secret) {
-				r.Log.V(1).Info("Delete Event", "kind", secretKind, "namespacedName", toNamespacedName(e.Object))
-				return true
-			}
-			return false
-		},
-		GenericFunc: func(e event.GenericEvent) bool {
-			return false
-		},
+// findVaultSecretsReading returns the VaultSecrets that read the secret at path and requests their refresh
+func (r *VaultSecretReconciler) findVaultSecretsReading(ctx context.Context, path string) []client.Object {
+	vaultSecrets := &redhatcopv1alpha1.VaultSecretList{}
+	err := r.GetClient().List(ctx, vaultSecrets)
+	if err != nil {
+		r.Log.Error(err, "unable to list VaultSecrets", "path", path)
+		return nil
 	}
+	ctx = vaultutils.WithKubeClient(ctx, r.GetClient())
+	objects := []client.Object{}
+	for i := range vaultSecrets.Items {
+		vaultSecret := &vaultSecrets.Items[i]
+		if readsVaultPath(ctx, r.VaultEvents, vaultSecret.Namespace, vaultSecret.Spec.VaultSecretDefinitions, path) {
+			r.Log.V(1).Info("Vault Event - Secret written", "kind", vaultSecretKind, "namespacedName", client.ObjectKeyFromObject(vaultSecret), "path", path)
+			r.refreshRequests.Request(client.ObjectKeyFromObject(vaultSecret))
+			objects = append(objects, vaultSecret)
+		}
+	}
+	return objects
+}
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&redhatcopv1alpha1.VaultSecret{}, builder.WithPredicates(vaultSecretPredicate, vaultresourcecontroller.NewDefaultPeriodicReconcilePredicate())).
-		Owns(&corev1.Secret{}, builder.WithPredicates(k8sSecretPredicate)).
-		Complete(r)
+// readsVaultPath returns whether one of vaultSecretDefinitions, as found in kubeNamespace, reads the secret at path from the Vault watched by subscriber
+func readsVaultPath(ctx context.Context, subscriber *vaultresourcecontroller.VaultEventSubscriber, kubeNamespace string, vaultSecretDefinitions []redhatcopv1alpha1.VaultSecretDefinition, path string) bool {
+	for i := range vaultSecretDefinitions {
+		vaultSecretDefinition := &vaultSecretDefinitions[i]
+		if vaultSecretDefinition.RequestType != "POST" && vaultresourcecontroller.IsVaultEventPath(vaultSecretDefinition.GetPath(), path) &&
+			subscriber.Watches(ctx, vaultSecretDefinition.GetVaultConnection(), &vaultSecretDefinition.Authentication, kubeNamespace) {
+			return true
+		}
+	}
+	return false
 }
 
-func (r *VaultSecretReconciler) isOwnedSecretByController(secret *corev1.Secret) bool {
-	for _, ownerRef := range secret.ObjectMeta.OwnerReferences {
-		if ownerRef.Kind == vaultSecretKind {
+// isOwnedBy returns whether object is owned by a resource of kind ownerKind
+func isOwnedBy(object client.Object, ownerKind string) bool {
+	for _, ownerRef := range object.GetOwnerReferences() {
+		if ownerRef.Kind == ownerKind {
 			return true
 		}
 	}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSupersedeLeases(t *testing.T) {
+	definitionsStatus := []redhatcopv1alpha1.VaultSecretDefinitionStatus{
+		{Name: "db", LeaseID: "database/creds/app/1"},
+		{Name: "kv"},
+	}
+	cases := []struct {
+		name        string
+		gracePeriod *metav1.Duration
+		expected    time.Duration
+		superseded  int
+	}{
+		{name: "default grace period", expected: defaultLeaseRevocationGracePeriod, superseded: 1},
+		{name: "custom grace period", gracePeriod: &metav1.Duration{Duration: time.Hour}, expected: time.Hour, superseded: 1},
+		// the definitions are not in the spec, the immediate revocation only logs that the leases will expire
+		{name: "no grace period", gracePeriod: &metav1.Duration{}, superseded: 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			before := time.Now()
+			superseded := supersedeLeases(context.TODO(), vaultSecretKind, "test", nil, nil, definitionsStatus, c.gracePeriod)
+			if len(superseded) != c.superseded {
+				t.Fatalf("expected %d superseded leases, got %v", c.superseded, superseded)
+			}
+			if c.superseded == 0 {
+				return
+			}
+			if superseded[0].Name != "db" || superseded[0].LeaseID != "database/creds/app/1" {
+				t.Errorf("unexpected superseded lease %v", superseded[0])
+			}
+			if revokeAfter := superseded[0].RevokeAfter.Sub(before); revokeAfter < c.expected || revokeAfter > c.expected+time.Minute {
+				t.Errorf("expected the lease to be revoked after %v, got %v", c.expected, revokeAfter)
+			}
+		})
+	}
+}
+
+func TestRevokeSupersededLeases(t *testing.T) {
+	supersededLeases := []redhatcopv1alpha1.SupersededLease{
+		{Name: "db", LeaseID: "database/creds/app/1", RevokeAfter: metav1.NewTime(time.Now().Add(-time.Minute))},
+		{Name: "db", LeaseID: "database/creds/app/2", RevokeAfter: metav1.NewTime(time.Now().Add(10 * time.Minute))},
+		{Name: "db", LeaseID: "database/creds/app/3", RevokeAfter: metav1.NewTime(time.Now().Add(2 * time.Minute))},
+	}
+
+	remaining := revokeSupersededLeases(context.TODO(), vaultSecretKind, "test", nil, supersededLeases, false)
+	if len(remaining) != 2 || remaining[0].LeaseID != "database/creds/app/2" || remaining[1].LeaseID != "database/creds/app/3" {
+		t.Fatalf("expected the leases not yet due to remain, got %v", remaining)
+	}
+	next, ok := nextLeaseRevocation(remaining)
+	if !ok || next > 2*time.Minute || next < time.Minute {
+		t.Errorf("expected the next revocation in about 2m, got %v, %v", next, ok)
+	}
+
+	if remaining := revokeSupersededLeases(context.TODO(), vaultSecretKind, "test", nil, supersededLeases, true); remaining != nil {
+		t.Errorf("expected all the leases to be revoked, got %v", remaining)
+	}
+	if _, ok := nextLeaseRevocation(nil); ok {
+		t.Error("expected no revocation without superseded leases")
+	}
+	if next, _ := nextLeaseRevocation(supersededLeases[:1]); next != time.Second {
+		t.Errorf("expected an overdue revocation to be scheduled in 1s, got %v", next)
+	}
+}
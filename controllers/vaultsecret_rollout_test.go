@@ -0,0 +1,80 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestManageRolloutLogic(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{corev1.AddToScheme, appsv1.AddToScheme, redhatcopv1alpha1.AddToScheme} {
+		if err := addToScheme(scheme); err != nil {
+			t.Fatalf("unable to build scheme: %v", err)
+		}
+	}
+	output := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "app-credentials", Namespace: "team-a", Annotations: map[string]string{hashAnnotationName: "hash1"}}}
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team-a"}}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(output, deployment).Build()
+	r := &VaultSecretReconciler{ReconcilerBase: vaultresourcecontroller.NewReconcilerBase(kubeClient, scheme, nil, record.NewFakeRecorder(10), kubeClient, logr.Discard(), "VaultSecret")}
+	instance := &redhatcopv1alpha1.VaultSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-credentials", Namespace: "team-a"},
+		Spec: redhatcopv1alpha1.VaultSecretSpec{
+			TemplatizedK8sSecret: redhatcopv1alpha1.TemplatizedK8sSecret{Name: "app-credentials"},
+			RolloutTargets:       []redhatcopv1alpha1.RolloutTarget{{Kind: "Deployment", Name: "app"}},
+		},
+	}
+	rolloutHash := func() string {
+		workload := &appsv1.Deployment{}
+		if err := kubeClient.Get(context.TODO(), client.ObjectKeyFromObject(deployment), workload); err != nil {
+			t.Fatalf("unable to retrieve deployment: %v", err)
+		}
+		return workload.Spec.Template.Annotations[instance.GetRolloutAnnotationName()]
+	}
+
+	// the targets are not restarted when they first become targets
+	if err := r.manageRolloutLogic(context.TODO(), instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hash := rolloutHash(); hash != "" || instance.Status.LastRolloutHash != "hash1" {
+		t.Errorf("expected the hash to be recorded without rolling out, got annotation %q and recorded hash %q", hash, instance.Status.LastRolloutHash)
+	}
+
+	// the targets are restarted when the data of the output changes
+	output.Annotations[hashAnnotationName] = "hash2"
+	if err := kubeClient.Update(context.TODO(), output); err != nil {
+		t.Fatalf("unable to update output: %v", err)
+	}
+	if err := r.manageRolloutLogic(context.TODO(), instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hash := rolloutHash(); hash != "hash2" || instance.Status.LastRolloutHash != "hash2" {
+		t.Errorf("expected the targets to be rolled out with the new hash, got annotation %q and recorded hash %q", hash, instance.Status.LastRolloutHash)
+	}
+}
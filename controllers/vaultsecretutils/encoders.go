@@ -0,0 +1,156 @@
+package vaultsecretutils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	"sigs.k8s.io/yaml"
+)
+
+// defaultKeyStoreAlias is the alias of the private key entry of the keystores when no alias is specified
+const defaultKeyStoreAlias = "certificate"
+
+var envVarNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// plainDotenvValueRegexp matches the dotenv values that do not need to be quoted
+var plainDotenvValueRegexp = regexp.MustCompile(`^[A-Za-z0-9_./:@,+=%-]*$`)
+
+// EncodeOutputFile encodes the file of encoder, from its values rendered with render.
+func EncodeOutputFile(encoder *redhatcopv1alpha1.OutputEncoder, render func(text string) ([]byte, error)) ([]byte, error) {
+	renderField := func(field string, text string) (string, error) {
+		rendered, err := render(text)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", field, err)
+		}
+		return string(rendered), nil
+	}
+	switch {
+	case encoder.PKCS12 != nil, encoder.JKS != nil:
+		keyStore := encoder.PKCS12
+		if encoder.JKS != nil {
+			keyStore = encoder.JKS
+		}
+		values := map[string]string{}
+		// the fields are rendered in order, so that the same error is reported on every reconcile
+		for _, field := range []struct{ name, text string }{
+			{"certificate", keyStore.Certificate},
+			{"privateKey", keyStore.PrivateKey},
+			{"ca", keyStore.CA},
+			{"password", keyStore.Password},
+		} {
+			value, err := renderField(field.name, field.text)
+			if err != nil {
+				return nil, err
+			}
+			values[field.name] = value
+		}
+		if encoder.JKS == nil {
+			return EncodePKCS12(values["certificate"], values["privateKey"], values["ca"], values["password"])
+		}
+		alias := keyStore.Alias
+		if alias == "" {
+			alias = defaultKeyStoreAlias
+		}
+		return EncodeJKS(values["certificate"], values["privateKey"], values["ca"], values["password"], alias)
+	case encoder.DockerConfigJSON != nil:
+		registries := make([]redhatcopv1alpha1.DockerRegistryCredentials, 0, len(encoder.DockerConfigJSON.Registries))
+		for i, registry := range encoder.DockerConfigJSON.Registries {
+			username, err := renderField(fmt.Sprintf("registries[%d].username", i), registry.Username)
+			if err != nil {
+				return nil, err
+			}
+			password, err := renderField(fmt.Sprintf("registries[%d].password", i), registry.Password)
+			if err != nil {
+				return nil, err
+			}
+			registries = append(registries, redhatcopv1alpha1.DockerRegistryCredentials{Server: registry.Server, Username: username, Password: password})
+		}
+		return EncodeDockerConfigJSON(registries)
+	case encoder.Dotenv != nil:
+		data, err := renderField("data", encoder.Dotenv.Data)
+		if err != nil {
+			return nil, err
+		}
+		return EncodeDotenv(data)
+	}
+	return nil, fmt.Errorf("no format specified in the %s output encoder", encoder.Key)
+}
+
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+type dockerConfigEntry struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// EncodeDockerConfigJSON encodes the rendered credentials of registries in the docker config JSON format
+func EncodeDockerConfigJSON(registries []redhatcopv1alpha1.DockerRegistryCredentials) ([]byte, error) {
+	config := dockerConfigJSON{Auths: map[string]dockerConfigEntry{}}
+	for _, registry := range registries {
+		config.Auths[registry.Server] = dockerConfigEntry{
+			Username: registry.Username,
+			Password: registry.Password,
+			Auth:     base64.StdEncoding.EncodeToString([]byte(registry.Username + ":" + registry.Password)),
+		}
+	}
+	return json.Marshal(config)
+}
+
+// EncodeDotenv encodes the flat map of data, in YAML or JSON, as a dotenv file with one KEY=value line by entry, sorted by key.
+// The values with characters other than letters, digits and ./:@,+=%-_ are single quoted, or double quoted with escapes when they contain single quotes or new lines.
+func EncodeDotenv(data string) ([]byte, error) {
+	values := map[string]interface{}{}
+	jsonData, err := yaml.YAMLToJSON([]byte(data))
+	if err == nil {
+		// numbers are kept as written rather than converted to floats
+		decoder := json.NewDecoder(bytes.NewReader(jsonData))
+		decoder.UseNumber()
+		err = decoder.Decode(&values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the dotenv data: %w", err)
+	}
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if !envVarNameRegexp.MatchString(k) {
+			return nil, fmt.Errorf("%s is not a valid environment variable name", k)
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		var value string
+		switch typed := values[k].(type) {
+		case nil:
+		case string:
+			value = typed
+		case map[string]interface{}, []interface{}:
+			return nil, fmt.Errorf("the value of %s is not a scalar, the dotenv data must be a flat map", k)
+		default:
+			value = fmt.Sprint(typed)
+		}
+		b.WriteString(k + "=" + quoteDotenvValue(value) + "\n")
+	}
+	return []byte(b.String()), nil
+}
+
+func quoteDotenvValue(value string) string {
+	switch {
+	case plainDotenvValueRegexp.MatchString(value):
+		return value
+	case !strings.ContainsAny(value, "'\n"):
+		return "'" + value + "'"
+	default:
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`).Replace(value) + `"`
+	}
+}
@@ -0,0 +1,252 @@
+package vaultsecretutils
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pavlo-v-chernykh/keystore-go/v4"
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// newTestCertificate returns a PEM encoded self-signed certificate and its PEM encoded private key, in PKCS#1 format for RSA keys and SEC 1 format for EC keys
+func newTestCertificate(t *testing.T, commonName string, ec bool) (string, string) {
+	var key interface{}
+	var publicKey interface{}
+	var keyBlock *pem.Block
+	if ec {
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		der, err := x509.MarshalECPrivateKey(ecKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, publicKey, keyBlock = ecKey, &ecKey.PublicKey, &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	} else {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, publicKey, keyBlock = rsaKey, &rsaKey.PublicKey, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), string(pem.EncodeToMemory(keyBlock))
+}
+
+func TestEncodePKCS12(t *testing.T) {
+	certificate, privateKey := newTestCertificate(t, "app.example.com", false)
+	keyStore, err := EncodePKCS12(certificate, privateKey, "", "changeit")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	key, decoded, _, err := pkcs12.DecodeChain(keyStore, "changeit")
+	if err != nil {
+		t.Fatalf("unable to decode the keystore: %v", err)
+	}
+	if decoded.Subject.CommonName != "app.example.com" {
+		t.Errorf("unexpected certificate %v", decoded.Subject)
+	}
+	if _, ok := key.(*rsa.PrivateKey); !ok {
+		t.Errorf("expected an RSA private key, got %T", key)
+	}
+	if _, _, _, err := pkcs12.DecodeChain(keyStore, "wrong"); err == nil {
+		t.Errorf("expected the keystore not to be decoded with a wrong password")
+	}
+
+	again, err := EncodePKCS12(certificate, privateKey, "", "changeit")
+	if err != nil || !bytes.Equal(keyStore, again) {
+		t.Errorf("expected the same keystore to be encoded again (%v)", err)
+	}
+}
+
+func TestEncodePKCS12WithCA(t *testing.T) {
+	certificate, privateKey := newTestCertificate(t, "app.example.com", true)
+	ca, _ := newTestCertificate(t, "ca.example.com", true)
+	keyStore, err := EncodePKCS12(certificate, privateKey, ca, "changeit")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	key, decoded, caCertificates, err := pkcs12.DecodeChain(keyStore, "changeit")
+	if err != nil {
+		t.Fatalf("unable to decode the keystore: %v", err)
+	}
+	if _, ok := key.(*ecdsa.PrivateKey); !ok {
+		t.Errorf("expected an EC private key, got %T", key)
+	}
+	if decoded.Subject.CommonName != "app.example.com" || len(caCertificates) != 1 || caCertificates[0].Subject.CommonName != "ca.example.com" {
+		t.Errorf("expected the certificate followed by the CA certificate, got %v and %d CA certificates", decoded.Subject, len(caCertificates))
+	}
+}
+
+func TestEncodePKCS12Errors(t *testing.T) {
+	certificate, privateKey := newTestCertificate(t, "app.example.com", false)
+	for name, values := range map[string][2]string{
+		"no certificate":      {"", privateKey},
+		"invalid certificate": {"not a certificate", privateKey},
+		"no private key":      {certificate, ""},
+	} {
+		if _, err := EncodePKCS12(values[0], values[1], "", "changeit"); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+// loadJKS loads a Java keystore, checking its integrity with password
+func loadJKS(t *testing.T, keyStore []byte, password string) keystore.KeyStore {
+	loaded := keystore.New()
+	if err := loaded.Load(bytes.NewReader(keyStore), []byte(password)); err != nil {
+		t.Fatalf("unable to load the keystore: %v", err)
+	}
+	return loaded
+}
+
+func TestEncodeJKS(t *testing.T) {
+	certificate, privateKey := newTestCertificate(t, "app.example.com", false)
+	ca, _ := newTestCertificate(t, "ca.example.com", true)
+	keyStore, err := EncodeJKS(certificate, privateKey, ca, "changeit", "App")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	loaded := loadJKS(t, keyStore, "changeit")
+	if aliases := loaded.Aliases(); len(aliases) != 2 {
+		t.Fatalf("expected a private key entry and a trusted certificate entry, got %v", aliases)
+	}
+	entry, err := loaded.GetPrivateKeyEntry("app", []byte("changeit"))
+	if err != nil {
+		t.Fatalf("unable to recover the private key entry: %v", err)
+	}
+	if len(entry.CertificateChain) != 1 {
+		t.Errorf("unexpected private key entry with %d certificates", len(entry.CertificateChain))
+	}
+	key, err := x509.ParsePKCS8PrivateKey(entry.PrivateKey)
+	if err != nil {
+		t.Fatalf("unable to parse the private key: %v", err)
+	}
+	if _, ok := key.(*rsa.PrivateKey); !ok {
+		t.Errorf("expected an RSA private key, got %T", key)
+	}
+	trusted, err := loaded.GetTrustedCertificateEntry("app-ca-0")
+	if err != nil {
+		t.Fatalf("unable to read the trusted certificate entry: %v", err)
+	}
+	caCertificate, err := x509.ParseCertificate(trusted.Certificate.Content)
+	if err != nil || caCertificate.Subject.CommonName != "ca.example.com" {
+		t.Errorf("unexpected trusted certificate entry (%v)", err)
+	}
+
+	again, err := EncodeJKS(certificate, privateKey, ca, "changeit", "App")
+	if err != nil || !bytes.Equal(keyStore, again) {
+		t.Errorf("expected the same keystore to be encoded again (%v)", err)
+	}
+}
+
+func TestEncodeJKSTrustStore(t *testing.T) {
+	ca, _ := newTestCertificate(t, "ca.example.com", false)
+	intermediate, _ := newTestCertificate(t, "intermediate.example.com", false)
+	trustStore, err := EncodeJKS("", "", ca+intermediate, "changeit", "certificate")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	loaded := loadJKS(t, trustStore, "changeit")
+	aliases := loaded.Aliases()
+	if len(aliases) != 2 || !loaded.IsTrustedCertificateEntry("certificate-ca-0") || !loaded.IsTrustedCertificateEntry("certificate-ca-1") {
+		t.Errorf("expected two trusted certificate entries, got %v", aliases)
+	}
+}
+
+func TestEncodeDockerConfigJSON(t *testing.T) {
+	encoded, err := EncodeDockerConfigJSON([]redhatcopv1alpha1.DockerRegistryCredentials{{Server: "quay.io", Username: "robot", Password: "token"}})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	config := map[string]map[string]map[string]string{}
+	if err := json.Unmarshal(encoded, &config); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	auth := config["auths"]["quay.io"]
+	if auth["username"] != "robot" || auth["password"] != "token" || auth["auth"] != base64.StdEncoding.EncodeToString([]byte("robot:token")) {
+		t.Errorf("unexpected docker config %s", encoded)
+	}
+}
+
+func TestEncodeDotenv(t *testing.T) {
+	encoded, err := EncodeDotenv(`{"PORT": 5432, "DB_HOST": "db.example.com", "PASSWORD": "p@ss word$1", "QUOTED": "it's", "EMPTY": null, "ENABLED": true, "TIMEOUT": 1000000}`)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := `DB_HOST=db.example.com
+EMPTY=
+ENABLED=true
+PASSWORD='p@ss word$1'
+PORT=5432
+QUOTED="it's"
+TIMEOUT=1000000
+`
+	if string(encoded) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, encoded)
+	}
+
+	for name, data := range map[string]string{
+		"invalid name": "MY-VAR: value",
+		"nested":       "DB:\n  HOST: db",
+		"not a map":    "- value",
+	} {
+		if _, err := EncodeDotenv(data); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestBuildOutputObjectEncoders(t *testing.T) {
+	output := &redhatcopv1alpha1.TemplatizedK8sSecret{
+		Name:       "app",
+		Type:       "Opaque",
+		StringData: map[string]string{"ca.crt": "{{ .ca }}"},
+		Encoders: []redhatcopv1alpha1.OutputEncoder{
+			{Key: ".env", Dotenv: &redhatcopv1alpha1.DotenvEncoder{Data: "HOST: db"}},
+			{Key: "keystore.p12", PKCS12: &redhatcopv1alpha1.KeyStoreEncoder{Certificate: "fail", PrivateKey: "fail", Password: "changeit"}},
+		},
+	}
+	_, _, err := BuildOutputObject(output, "team-a", render)
+	if err == nil || !strings.Contains(err.Error(), "keystore.p12: certificate: render failure") {
+		t.Errorf("expected the error of the keystore to be reported by key and field, got %v", err)
+	}
+
+	output.Encoders = output.Encoders[:1]
+	object, hash, err := BuildOutputObject(output, "team-a", render)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	secret := object.(*corev1.Secret)
+	if string(secret.Data[".env"]) != "HOST=db\n" {
+		t.Errorf("unexpected encoded data %v", secret.Data)
+	}
+	secret.Data["foreign"] = []byte("kept")
+	if dataHash, _ := OutputDataHash(secret, output); dataHash != hash {
+		t.Errorf("expected the encoded data to be hashed with the rendered data")
+	}
+}
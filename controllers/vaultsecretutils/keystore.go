@@ -0,0 +1,167 @@
+package vaultsecretutils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pavlo-v-chernykh/keystore-go/v4"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// parseCertificates parses the PEM encoded certificates of text, in order
+func parseCertificates(text string) ([]*x509.Certificate, error) {
+	certificates := []*x509.Certificate{}
+	rest := []byte(text)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(certificates) == 0 && strings.TrimSpace(text) != "" {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return certificates, nil
+}
+
+// parsePrivateKey parses the PEM encoded private key of text, in PKCS#1, PKCS#8 or SEC 1 format
+func parsePrivateKey(text string) (interface{}, error) {
+	block, _ := pem.Decode([]byte(text))
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported private key type %s", block.Type)
+	}
+}
+
+// derivedRand is a stream of bytes derived from the content of a keystore. It replaces the random salts of the keystores, so that encoding the same content again returns the same keystore,
+// which does not change the data of the output
+type derivedRand struct {
+	seed    []byte
+	counter uint64
+	block   []byte
+}
+
+func newDerivedRand(label string, content ...[]byte) io.Reader {
+	hash := sha256.New()
+	hash.Write([]byte(label))
+	for _, c := range content {
+		hash.Write(c)
+	}
+	return &derivedRand{seed: hash.Sum(nil)}
+}
+
+func (r *derivedRand) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(r.block) == 0 {
+			hash := sha256.New()
+			hash.Write(r.seed)
+			binary.Write(hash, binary.BigEndian, r.counter)
+			r.counter++
+			r.block = hash.Sum(nil)
+		}
+		copied := copy(p[n:], r.block)
+		r.block = r.block[copied:]
+		n += copied
+	}
+	return n, nil
+}
+
+// EncodePKCS12 encodes the private key, its certificate chain and the CA certificates in a PKCS#12 keystore protected by password.
+// The keystore is encoded with the legacy 3DES and SHA-1 algorithms, which are supported by all the PKCS#12 implementations, including Java 8.
+func EncodePKCS12(certificate string, privateKey string, ca string, password string) ([]byte, error) {
+	chain, err := parseCertificates(certificate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate: %w", err)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("the certificate is empty")
+	}
+	caCertificates, err := parseCertificates(ca)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ca: %w", err)
+	}
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	rand := newDerivedRand("pkcs12", []byte(certificate), []byte(privateKey), []byte(ca), []byte(password))
+	return pkcs12.LegacyDES.WithRand(rand).Encode(key, chain[0], append(chain[1:], caCertificates...), password)
+}
+
+// EncodeJKS encodes the private key, its certificate chain and the CA certificates in a Java keystore protected by password. The private key is protected by the same password.
+// Without a certificate and a private key, a truststore of the CA certificates is encoded.
+func EncodeJKS(certificate string, privateKey string, ca string, password string, alias string) ([]byte, error) {
+	chain, err := parseCertificates(certificate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate: %w", err)
+	}
+	caCertificates, err := parseCertificates(ca)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ca: %w", err)
+	}
+	rand := newDerivedRand("jks", []byte(certificate), []byte(privateKey), []byte(ca), []byte(password))
+	keyStore := keystore.New(keystore.WithOrderedAliases(), keystore.WithCustomRandomNumberGenerator(rand))
+	// the creation date of an entry is the start of validity of its certificate, so that the keystore does not change when it is encoded again
+	if len(chain) > 0 {
+		key, err := parsePrivateKey(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		pkcs8Key, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		certificateChain := make([]keystore.Certificate, 0, len(chain))
+		for _, c := range chain {
+			certificateChain = append(certificateChain, keystore.Certificate{Type: "X509", Content: c.Raw})
+		}
+		err = keyStore.SetPrivateKeyEntry(alias, keystore.PrivateKeyEntry{
+			CreationTime:     chain[0].NotBefore,
+			PrivateKey:       pkcs8Key,
+			CertificateChain: certificateChain,
+		}, []byte(password))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, c := range caCertificates {
+		err = keyStore.SetTrustedCertificateEntry(fmt.Sprintf("%s-ca-%d", alias, i), keystore.TrustedCertificateEntry{
+			CreationTime: c.NotBefore,
+			Certificate:  keystore.Certificate{Type: "X509", Content: c.Raw},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	var b bytes.Buffer
+	if err := keyStore.Store(&b, []byte(password)); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,37 @@
+package vaultsecretutils
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// SplitKVv2Secret splits the data of a KV v2 secret read from vault into the data of the secret and its metadata, such as its version, created_time and custom_metadata, and returns the version of the secret.
+// false is returned for the other secrets, whose data is not nested in a data field.
+func SplitKVv2Secret(secretData map[string]interface{}) (data map[string]interface{}, metadata map[string]interface{}, version int, ok bool) {
+	data, ok = secretData["data"].(map[string]interface{})
+	if !ok {
+		return nil, nil, 0, false
+	}
+	metadata, _ = secretData["metadata"].(map[string]interface{})
+	if metadata != nil {
+		version = toInt(metadata["version"])
+	}
+	return data, metadata, version, true
+}
+
+func toInt(value interface{}) int {
+	switch typed := value.(type) {
+	case json.Number:
+		i, _ := typed.Int64()
+		return int(i)
+	case float64:
+		return int(typed)
+	case int:
+		return typed
+	case string:
+		i, _ := strconv.Atoi(typed)
+		return i
+	default:
+		return 0
+	}
+}
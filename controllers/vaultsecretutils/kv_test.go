@@ -0,0 +1,37 @@
+package vaultsecretutils
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSplitKVv2Secret(t *testing.T) {
+	secretData := map[string]interface{}{
+		"data": map[string]interface{}{"password": "s3cr3t"},
+		"metadata": map[string]interface{}{
+			"version":         json.Number("3"),
+			"created_time":    "2024-01-01T00:00:00Z",
+			"custom_metadata": map[string]interface{}{"owner": "team-a"},
+		},
+	}
+	data, metadata, version, ok := SplitKVv2Secret(secretData)
+	if !ok {
+		t.Fatalf("expected a KV v2 secret")
+	}
+	if data["password"] != "s3cr3t" {
+		t.Errorf("unexpected data %v", data)
+	}
+	if version != 3 || metadata["created_time"] != "2024-01-01T00:00:00Z" || metadata["custom_metadata"].(map[string]interface{})["owner"] != "team-a" {
+		t.Errorf("unexpected metadata %v, version %d", metadata, version)
+	}
+}
+
+func TestSplitKVv2SecretOtherSecrets(t *testing.T) {
+	if _, _, _, ok := SplitKVv2Secret(map[string]interface{}{"username": "user", "password": "s3cr3t"}); ok {
+		t.Errorf("expected a KV v1 secret not to be split")
+	}
+	// a deleted version has no data
+	if _, _, _, ok := SplitKVv2Secret(map[string]interface{}{"data": nil, "metadata": map[string]interface{}{"version": json.Number("2")}}); ok {
+		t.Errorf("expected a deleted version not to be split")
+	}
+}
@@ -0,0 +1,222 @@
+package vaultsecretutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// HashAnnotationName is the annotation of the outputs holding the hash of their data
+const HashAnnotationName = "vaultsecret.redhatcop.redhat.io/secret-hash"
+
+// manifestKey is the key of the rendered manifest when hashing a resource that is neither a Secret nor a ConfigMap
+const manifestKey = "manifest"
+
+// TemplateKey is the key of the errors rendering output.Template
+const TemplateKey = "template"
+
+// TemplateErrors are the errors rendering the templates of an output, by key
+type TemplateErrors []redhatcopv1alpha1.TemplateError
+
+func (e TemplateErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, templateError := range e {
+		messages = append(messages, templateError.Key+": "+templateError.Message)
+	}
+	return "unable to render the output templates: " + strings.Join(messages, "; ")
+}
+
+// NewOutputObject returns an empty resource of the kind of output, with the name of output and namespace
+func NewOutputObject(output *redhatcopv1alpha1.TemplatizedK8sSecret, namespace string) client.Object {
+	gvk := output.GetGroupVersionKind()
+	objectMeta := metav1.ObjectMeta{
+		Name:      output.Name,
+		Namespace: namespace,
+	}
+	typeMeta := metav1.TypeMeta{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+	}
+	switch {
+	case output.IsSecret():
+		return &corev1.Secret{TypeMeta: typeMeta, ObjectMeta: objectMeta}
+	case output.IsConfigMap():
+		return &corev1.ConfigMap{TypeMeta: typeMeta, ObjectMeta: objectMeta}
+	default:
+		object := &unstructured.Unstructured{}
+		object.SetGroupVersionKind(gvk)
+		object.SetName(output.Name)
+		object.SetNamespace(namespace)
+		return object
+	}
+}
+
+// BuildOutputObject returns the resource described by output, with its labels and annotations. The data of Secrets and ConfigMaps is rendered from output.StringData, and encoded by output.Encoders,
+// any other resource is rendered from output.Template, when its kind is allowed by the operator.
+// render renders the template text of key. The errors rendering the templates are returned as TemplateErrors, with the error of each key.
+// The hash of the rendered content is returned with the resource.
+func BuildOutputObject(output *redhatcopv1alpha1.TemplatizedK8sSecret, namespace string, render func(key string, text string) ([]byte, error)) (client.Object, string, error) {
+	object := NewOutputObject(output, namespace)
+	var hash string
+	switch typed := object.(type) {
+	case *corev1.Secret, *corev1.ConfigMap:
+		keys := make([]string, 0, len(output.StringData))
+		for k := range output.StringData {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		data := make(map[string][]byte)
+		templateErrors := TemplateErrors{}
+		for _, k := range keys {
+			rendered, err := render(k, output.StringData[k])
+			if err != nil {
+				templateErrors = append(templateErrors, redhatcopv1alpha1.TemplateError{Key: k, Message: err.Error()})
+				continue
+			}
+			data[k] = rendered
+		}
+		for i := range output.Encoders {
+			encoder := &output.Encoders[i]
+			encoded, err := EncodeOutputFile(encoder, func(text string) ([]byte, error) { return render(encoder.Key, text) })
+			if err != nil {
+				templateErrors = append(templateErrors, redhatcopv1alpha1.TemplateError{Key: encoder.Key, Message: err.Error()})
+				continue
+			}
+			data[encoder.Key] = encoded
+		}
+		if len(templateErrors) > 0 {
+			return nil, "", templateErrors
+		}
+		hash = HashData(data)
+		if secret, ok := typed.(*corev1.Secret); ok {
+			secret.Data = data
+			secret.Type = corev1.SecretType(output.Type)
+		} else {
+			typed.(*corev1.ConfigMap).Data = toConfigMapData(data)
+		}
+	case *unstructured.Unstructured:
+		// the allowed kinds may have changed since the output was validated by the webhook
+		if !output.IsOutputKindAllowed() {
+			return nil, "", fmt.Errorf("the output cannot be a %s, it is not allowed by %s", output.GetGroupVersionKind().GroupKind(), redhatcopv1alpha1.OutputAllowedKindsEnv)
+		}
+		manifest, err := render(TemplateKey, output.Template)
+		if err != nil {
+			return nil, "", TemplateErrors{{Key: TemplateKey, Message: err.Error()}}
+		}
+		content := map[string]interface{}{}
+		err = yaml.Unmarshal(manifest, &content)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to parse the rendered output template: %w", err)
+		}
+		gvk := output.GetGroupVersionKind()
+		if apiVersion, ok := content["apiVersion"]; ok && apiVersion != gvk.GroupVersion().String() {
+			return nil, "", fmt.Errorf("the rendered output template has apiVersion %v, expected %s", apiVersion, gvk.GroupVersion().String())
+		}
+		if kind, ok := content["kind"]; ok && kind != gvk.Kind {
+			return nil, "", fmt.Errorf("the rendered output template has kind %v, expected %s", kind, gvk.Kind)
+		}
+		// the metadata is set from output
+		delete(content, "metadata")
+		delete(content, "status")
+		for k, v := range content {
+			typed.Object[k] = v
+		}
+		typed.SetGroupVersionKind(gvk)
+		normalized, err := json.Marshal(content)
+		if err != nil {
+			return nil, "", err
+		}
+		hash = HashData(map[string][]byte{manifestKey: normalized})
+	}
+	object.SetLabels(output.Labels)
+	annotations := make(map[string]string)
+	for k, v := range output.Annotations {
+		annotations[k] = v
+	}
+	object.SetAnnotations(annotations)
+	return object, hash, nil
+}
+
+// OutputDataHash returns the hash of the data of a Secret or a ConfigMap, computed the same way as the hash returned by BuildOutputObject. The content of other resources cannot be compared to the rendered manifest, which may have been defaulted by the api server, false is returned for them.
+// When output is not nil, only the keys rendered from output.StringData and encoded by output.Encoders are hashed, the keys added by other field managers are ignored.
+func OutputDataHash(object client.Object, output *redhatcopv1alpha1.TemplatizedK8sSecret) (string, bool) {
+	var data map[string][]byte
+	switch typed := object.(type) {
+	case *corev1.Secret:
+		data = typed.Data
+	case *corev1.ConfigMap:
+		data = fromConfigMapData(typed.Data)
+	default:
+		return "", false
+	}
+	if output != nil {
+		data = filterData(data, output)
+	}
+	return HashData(data), true
+}
+
+func toConfigMapData(data map[string][]byte) map[string]string {
+	configMapData := make(map[string]string, len(data))
+	for k, v := range data {
+		configMapData[k] = string(v)
+	}
+	return configMapData
+}
+
+func fromConfigMapData(configMapData map[string]string) map[string][]byte {
+	data := make(map[string][]byte, len(configMapData))
+	for k, v := range configMapData {
+		data[k] = []byte(v)
+	}
+	return data
+}
+
+// CopyOutputObject returns a copy of object, a Secret or a ConfigMap output of output, for namespace. Only the data rendered from output and the labels and annotations of output are copied, with the hash annotation of object,
+// so that the data read from vault can be output to another namespace without being rendered again. The content of other resources cannot be copied without taking over the fields of other field managers, false is returned for them.
+func CopyOutputObject(output *redhatcopv1alpha1.TemplatizedK8sSecret, object client.Object, namespace string) (client.Object, bool) {
+	copied := NewOutputObject(output, namespace)
+	switch typed := object.(type) {
+	case *corev1.Secret:
+		secret := copied.(*corev1.Secret)
+		secret.Data = filterData(typed.Data, output)
+		secret.Type = typed.Type
+	case *corev1.ConfigMap:
+		copied.(*corev1.ConfigMap).Data = toConfigMapData(filterData(fromConfigMapData(typed.Data), output))
+	default:
+		return nil, false
+	}
+	copied.SetLabels(output.Labels)
+	annotations := make(map[string]string)
+	for k, v := range output.Annotations {
+		annotations[k] = v
+	}
+	if hash, ok := object.GetAnnotations()[HashAnnotationName]; ok {
+		annotations[HashAnnotationName] = hash
+	}
+	copied.SetAnnotations(annotations)
+	return copied, true
+}
+
+// filterData returns the entries of data rendered from output.StringData and encoded by output.Encoders
+func filterData(data map[string][]byte, output *redhatcopv1alpha1.TemplatizedK8sSecret) map[string][]byte {
+	filtered := make(map[string][]byte, len(output.StringData)+len(output.Encoders))
+	for k := range output.StringData {
+		if v, ok := data[k]; ok {
+			filtered[k] = v
+		}
+	}
+	for _, encoder := range output.Encoders {
+		if v, ok := data[encoder.Key]; ok {
+			filtered[encoder.Key] = v
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,208 @@
+package vaultsecretutils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// render replaces {{ .ca }} with a fixed value, standing for the go template rendering of the controller
+func render(key string, text string) ([]byte, error) {
+	if strings.Contains(text, "fail") {
+		return nil, errors.New("render failure")
+	}
+	return []byte(strings.ReplaceAll(text, "{{ .ca }}", "-----BEGIN CERTIFICATE-----")), nil
+}
+
+func TestBuildOutputObjectSecret(t *testing.T) {
+	output := &redhatcopv1alpha1.TemplatizedK8sSecret{
+		Name:        "app",
+		Type:        "Opaque",
+		StringData:  map[string]string{"ca.crt": "{{ .ca }}"},
+		Labels:      map[string]string{"app": "test"},
+		Annotations: map[string]string{"refresh": "daily"},
+	}
+	object, hash, err := BuildOutputObject(output, "team-a", render)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	secret, ok := object.(*corev1.Secret)
+	if !ok {
+		t.Fatalf("expected a Secret, got %T", object)
+	}
+	if secret.Kind != "Secret" || secret.Namespace != "team-a" || secret.Type != corev1.SecretTypeOpaque || string(secret.Data["ca.crt"]) != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("unexpected secret %+v", secret)
+	}
+	if secret.Labels["app"] != "test" || secret.Annotations["refresh"] != "daily" {
+		t.Errorf("expected the labels and annotations to be set, got %v %v", secret.Labels, secret.Annotations)
+	}
+	if dataHash, ok := OutputDataHash(secret, output); !ok || dataHash != hash {
+		t.Errorf("expected the data hash %s to match the rendered hash %s", dataHash, hash)
+	}
+}
+
+func TestBuildOutputObjectConfigMap(t *testing.T) {
+	output := &redhatcopv1alpha1.TemplatizedK8sSecret{
+		Name:       "ca-bundle",
+		Kind:       "ConfigMap",
+		StringData: map[string]string{"ca.crt": "{{ .ca }}"},
+	}
+	object, hash, err := BuildOutputObject(output, "team-a", render)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	configMap, ok := object.(*corev1.ConfigMap)
+	if !ok {
+		t.Fatalf("expected a ConfigMap, got %T", object)
+	}
+	if configMap.Kind != "ConfigMap" || configMap.APIVersion != "v1" || configMap.Data["ca.crt"] != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("unexpected config map %+v", configMap)
+	}
+	if dataHash, ok := OutputDataHash(configMap, output); !ok || dataHash != hash {
+		t.Errorf("expected the data hash %s to match the rendered hash %s", dataHash, hash)
+	}
+	configMap.Data["ca.crt"] = "changed"
+	if dataHash, _ := OutputDataHash(configMap, output); dataHash == hash {
+		t.Errorf("expected a change of the data to change the hash")
+	}
+}
+
+func TestBuildOutputObjectResource(t *testing.T) {
+	t.Setenv(redhatcopv1alpha1.OutputAllowedKindsEnv, "Example.example.com, DestinationRule.networking.istio.io")
+	output := &redhatcopv1alpha1.TemplatizedK8sSecret{
+		Name:       "upstream",
+		APIVersion: "networking.istio.io/v1beta1",
+		Kind:       "DestinationRule",
+		Template: `metadata:
+  name: ignored
+spec:
+  host: db.example.com
+  trafficPolicy:
+    tls:
+      caCertificates: "{{ .ca }}"
+      port: 5432
+`,
+		Labels: map[string]string{"app": "test"},
+	}
+	object, hash, err := BuildOutputObject(output, "team-a", render)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	resource, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		t.Fatalf("expected an unstructured resource, got %T", object)
+	}
+	if resource.GetAPIVersion() != "networking.istio.io/v1beta1" || resource.GetKind() != "DestinationRule" || resource.GetName() != "upstream" || resource.GetNamespace() != "team-a" {
+		t.Errorf("unexpected resource metadata %v", resource.Object)
+	}
+	if ca, _, _ := unstructured.NestedString(resource.Object, "spec", "trafficPolicy", "tls", "caCertificates"); ca != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("unexpected resource content %v", resource.Object)
+	}
+	if resource.GetLabels()["app"] != "test" || hash == "" {
+		t.Errorf("expected the labels and the hash to be set, got %v %s", resource.GetLabels(), hash)
+	}
+	if _, ok := OutputDataHash(resource, output); ok {
+		t.Errorf("expected the content of a resource not to be compared")
+	}
+}
+
+func TestBuildOutputObjectErrors(t *testing.T) {
+	t.Setenv(redhatcopv1alpha1.OutputAllowedKindsEnv, "Example.example.com")
+	for name, output := range map[string]*redhatcopv1alpha1.TemplatizedK8sSecret{
+		"render failure":   {Name: "app", StringData: map[string]string{"key": "fail"}},
+		"invalid manifest": {Name: "app", APIVersion: "example.com/v1", Kind: "Example", Template: "spec: [unclosed"},
+		"other kind":       {Name: "app", APIVersion: "example.com/v1", Kind: "Example", Template: "kind: Other\nspec: {}"},
+		"kind not allowed": {Name: "app", APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding", Template: "roleRef: {}"},
+	} {
+		if _, _, err := BuildOutputObject(output, "team-a", render); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestBuildOutputObjectTemplateErrors(t *testing.T) {
+	t.Setenv(redhatcopv1alpha1.OutputAllowedKindsEnv, "Example.example.com")
+	output := &redhatcopv1alpha1.TemplatizedK8sSecret{Name: "app", StringData: map[string]string{"b": "fail", "ca.crt": "{{ .ca }}", "a": "fail"}}
+	_, _, err := BuildOutputObject(output, "team-a", render)
+	templateErrors := TemplateErrors{}
+	if !errors.As(err, &templateErrors) {
+		t.Fatalf("expected template errors, got %v", err)
+	}
+	if len(templateErrors) != 2 || templateErrors[0].Key != "a" || templateErrors[1].Key != "b" || templateErrors[0].Message != "render failure" {
+		t.Errorf("expected an error for each failing key, got %v", templateErrors)
+	}
+
+	resource := &redhatcopv1alpha1.TemplatizedK8sSecret{Name: "app", APIVersion: "example.com/v1", Kind: "Example", Template: "fail"}
+	_, _, err = BuildOutputObject(resource, "team-a", render)
+	if !errors.As(err, &templateErrors) || len(templateErrors) != 1 || templateErrors[0].Key != TemplateKey {
+		t.Errorf("expected a template error for the template key, got %v", err)
+	}
+}
+
+func TestOutputDataHashIgnoresForeignKeys(t *testing.T) {
+	output := &redhatcopv1alpha1.TemplatizedK8sSecret{
+		Name:       "app",
+		Type:       "Opaque",
+		StringData: map[string]string{"ca.crt": "{{ .ca }}"},
+	}
+	object, hash, err := BuildOutputObject(output, "team-a", render)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	secret := object.(*corev1.Secret)
+	secret.Data["added-by-another-controller"] = []byte("value")
+	if dataHash, _ := OutputDataHash(secret, output); dataHash != hash {
+		t.Errorf("expected the keys added by other field managers to be ignored")
+	}
+	if dataHash, _ := OutputDataHash(secret, nil); dataHash == hash {
+		t.Errorf("expected all the keys to be hashed without output")
+	}
+}
+
+func TestCopyOutputObject(t *testing.T) {
+	output := &redhatcopv1alpha1.TemplatizedK8sSecret{
+		Name:        "pull-secret",
+		Type:        "kubernetes.io/dockerconfigjson",
+		StringData:  map[string]string{".dockerconfigjson": "{{ .ca }}"},
+		Labels:      map[string]string{"app": "test"},
+		Annotations: map[string]string{"refresh": "daily"},
+	}
+	object, hash, err := BuildOutputObject(output, "team-a", render)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	object.SetAnnotations(map[string]string{HashAnnotationName: hash, "refresh": "daily", "added-by": "argocd"})
+	object.SetLabels(map[string]string{"app": "test", "added-by": "argocd"})
+	object.SetResourceVersion("42")
+	object.(*corev1.Secret).Data["added-by-another-controller"] = []byte("value")
+
+	copied, ok := CopyOutputObject(output, object, "team-b")
+	if !ok {
+		t.Fatalf("expected a Secret to be copied")
+	}
+	secret, ok := copied.(*corev1.Secret)
+	if !ok {
+		t.Fatalf("expected a Secret, got %T", copied)
+	}
+	if secret.Namespace != "team-b" || secret.Name != "pull-secret" || secret.ResourceVersion != "" || secret.Kind != "Secret" {
+		t.Errorf("unexpected metadata %+v", secret.ObjectMeta)
+	}
+	if secret.Type != "kubernetes.io/dockerconfigjson" || len(secret.Data) != 1 || string(secret.Data[".dockerconfigjson"]) != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("expected only the rendered data to be copied, got %v", secret.Data)
+	}
+	if len(secret.Labels) != 1 || len(secret.Annotations) != 2 || secret.Annotations[HashAnnotationName] != hash {
+		t.Errorf("expected only the labels and annotations of output and the hash to be copied, got %v, %v", secret.Labels, secret.Annotations)
+	}
+	if object.GetNamespace() != "team-a" {
+		t.Errorf("expected the original object not to be modified")
+	}
+
+	resource := &unstructured.Unstructured{}
+	if _, ok := CopyOutputObject(&redhatcopv1alpha1.TemplatizedK8sSecret{Name: "app", APIVersion: "example.com/v1", Kind: "Example"}, resource, "team-b"); ok {
+		t.Errorf("expected other resources not to be copied")
+	}
+}
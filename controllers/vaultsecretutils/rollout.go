@@ -0,0 +1,39 @@
+package vaultsecretutils
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// NewRolloutTargetList returns an empty list of the workloads of kind, a Deployment, a StatefulSet or a DaemonSet
+func NewRolloutTargetList(kind string) *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind(kind + "List"))
+	return list
+}
+
+// NewRolloutTarget returns an empty workload of kind, with name and namespace
+func NewRolloutTarget(kind string, name string, namespace string) *unstructured.Unstructured {
+	workload := &unstructured.Unstructured{}
+	workload.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind(kind))
+	workload.SetName(name)
+	workload.SetNamespace(namespace)
+	return workload
+}
+
+// SetPodTemplateAnnotation sets the annotation name to value on the pod template of workload, which rolls the workload out when the value changes.
+// It returns whether the annotation was changed.
+func SetPodTemplateAnnotation(workload *unstructured.Unstructured, name string, value string) (bool, error) {
+	annotations, _, err := unstructured.NestedStringMap(workload.Object, "spec", "template", "metadata", "annotations")
+	if err != nil {
+		return false, err
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if current, ok := annotations[name]; ok && current == value {
+		return false, nil
+	}
+	annotations[name] = value
+	return true, unstructured.SetNestedStringMap(workload.Object, annotations, "spec", "template", "metadata", "annotations")
+}
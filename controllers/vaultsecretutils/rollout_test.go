@@ -0,0 +1,50 @@
+package vaultsecretutils
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSetPodTemplateAnnotation(t *testing.T) {
+	const annotation = "vaultsecret.redhatcop.redhat.io/app"
+	workload := NewRolloutTarget("Deployment", "app", "default")
+	workload.Object["spec"] = map[string]interface{}{
+		"template": map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{"other": "value"},
+			},
+		},
+	}
+
+	changed, err := SetPodTemplateAnnotation(workload, annotation, "hash1")
+	if err != nil || !changed {
+		t.Fatalf("expected the annotation to be added, got %v, %v", changed, err)
+	}
+	annotations, _, _ := unstructured.NestedStringMap(workload.Object, "spec", "template", "metadata", "annotations")
+	if annotations[annotation] != "hash1" || annotations["other"] != "value" {
+		t.Errorf("unexpected pod template annotations %v", annotations)
+	}
+
+	changed, err = SetPodTemplateAnnotation(workload, annotation, "hash1")
+	if err != nil || changed {
+		t.Errorf("expected an unchanged hash not to change the workload, got %v, %v", changed, err)
+	}
+
+	changed, err = SetPodTemplateAnnotation(workload, annotation, "hash2")
+	if err != nil || !changed {
+		t.Errorf("expected a new hash to change the workload, got %v, %v", changed, err)
+	}
+}
+
+func TestSetPodTemplateAnnotationWithoutTemplateMetadata(t *testing.T) {
+	workload := NewRolloutTarget("StatefulSet", "db", "default")
+	changed, err := SetPodTemplateAnnotation(workload, "vaultsecret.redhatcop.redhat.io/db", "hash")
+	if err != nil || !changed {
+		t.Fatalf("expected the annotation to be added, got %v, %v", changed, err)
+	}
+	value, _, _ := unstructured.NestedString(workload.Object, "spec", "template", "metadata", "annotations", "vaultsecret.redhatcop.redhat.io/db")
+	if value != "hash" {
+		t.Errorf("expected the annotation to be set, got %q", value)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
@@ -33,6 +34,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	//"github.com/redhat-cop/operator-utils/pkg/util"
@@ -40,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	redhatcopv1alpha1 "github.com/redhat-cop/vault-config-operator/api/v1alpha1"
+	vaultutils "github.com/redhat-cop/vault-config-operator/api/v1alpha1/utils"
 	"github.com/redhat-cop/vault-config-operator/controllers"
 	"github.com/redhat-cop/vault-config-operator/controllers/vaultresourcecontroller"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -64,12 +67,18 @@ func main() {
 	var probeAddr string
 	var enableHTTP2 bool
 	var secureMetrics bool
+	var observeOnly bool
+	var enableTracing bool
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&observeOnly, "observe-only", false,
+		"Report the changes the operator would make to Vault in the status and events of each resource, without making them.")
+	flag.BoolVar(&enableTracing, "enable-tracing", false,
+		"Export the traces of the reconcile cycles and vault requests with OTLP, configured with the standard OTEL_EXPORTER_OTLP_* environment variables.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -77,6 +86,16 @@ func main() {
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	vaultutils.SetObserveOnly(observeOnly)
+	var shutdownTracing func(context.Context) error
+	if enableTracing {
+		var err error
+		shutdownTracing, err = vaultutils.SetupTracing(context.Background())
+		if err != nil {
+			setupLog.Error(err, "unable to set up tracing")
+			os.Exit(1)
+		}
+	}
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -140,6 +159,22 @@ func main() {
 	// Set the sync period for use in predicates
 	vaultresourcecontroller.SetSyncPeriod(syncPeriod)
 
+	metrics.Registry.MustRegister(vaultresourcecontroller.NewReconcileFailedCollector(mgr.GetCache(), scheme, redhatcopv1alpha1.GroupVersion))
+
+	// the secrets written in Vault refresh the VaultSecrets reading them, and verify the RandomSecrets written at their paths, as soon as they are notified, the refresh periods remain the fallback
+	var vaultEvents *vaultresourcecontroller.VaultEventSubscriber
+	if vaultresourcecontroller.IsVaultEventsSubscriptionEnabled() {
+		vaultEvents, err = vaultresourcecontroller.NewVaultEventSubscriberFromEnv(mgr.GetClient(), mgr.GetConfig())
+		if err != nil {
+			setupLog.Error(err, "unable to create vault event subscriber")
+			os.Exit(1)
+		}
+		if err = mgr.Add(vaultEvents); err != nil {
+			setupLog.Error(err, "unable to add vault event subscriber")
+			os.Exit(1)
+		}
+	}
+
 	if err = (&controllers.KubernetesAuthEngineRoleReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "KubernetesAuthEngineRole")}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KubernetesAuthEngineRole")
 		os.Exit(1)
@@ -160,7 +195,7 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "SecretEngineMount")
 		os.Exit(1)
 	}
-	if err = (&controllers.RandomSecretReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "RandomSecret")}).SetupWithManager(mgr); err != nil {
+	if err = (&controllers.RandomSecretReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "RandomSecret"), VaultEvents: vaultEvents}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RandomSecret")
 		os.Exit(1)
 	}
@@ -223,10 +258,14 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controllers.VaultSecretReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "VaultSecret")}).SetupWithManager(mgr); err != nil {
+	if err = (&controllers.VaultSecretReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "VaultSecret"), VaultEvents: vaultEvents}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VaultSecret")
 		os.Exit(1)
 	}
+	if err = (&controllers.ClusterVaultSecretReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "ClusterVaultSecret"), VaultEvents: vaultEvents}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterVaultSecret")
+		os.Exit(1)
+	}
 	if err = (&controllers.PasswordPolicyReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "PasswordPolicy")}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "PasswordPolicy")
 		os.Exit(1)
@@ -294,6 +333,51 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&controllers.TransitSecretEngineKeyReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "TransitSecretEngineKey")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TransitSecretEngineKey")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.SSHSecretEngineConfigReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "SSHSecretEngineConfig")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SSHSecretEngineConfig")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.SSHSecretEngineRoleReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "SSHSecretEngineRole")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SSHSecretEngineRole")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.AWSSecretEngineConfigReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "AWSSecretEngineConfig")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AWSSecretEngineConfig")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.AWSSecretEngineRoleReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "AWSSecretEngineRole")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AWSSecretEngineRole")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.LDAPSecretEngineConfigReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "LDAPSecretEngineConfig")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LDAPSecretEngineConfig")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.LDAPSecretEngineStaticRoleReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "LDAPSecretEngineStaticRole")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LDAPSecretEngineStaticRole")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.LDAPSecretEngineDynamicRoleReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "LDAPSecretEngineDynamicRole")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LDAPSecretEngineDynamicRole")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.LDAPSecretEngineLibraryReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "LDAPSecretEngineLibrary")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LDAPSecretEngineLibrary")
+		os.Exit(1)
+	}
+
 	if err = (&controllers.DatabaseSecretEngineStaticRoleReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "DatabaseSecretEngineStaticRole")}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DatabaseSecretEngineStaticRole")
 		os.Exit(1)
@@ -308,6 +392,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&controllers.EntityReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "Entity")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Entity")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.EntityAliasReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "EntityAlias")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "EntityAlias")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.VaultConnectionReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "VaultConnection")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VaultConnection")
+		os.Exit(1)
+	}
+	if err = (&controllers.NamespacedVaultConnectionReconciler{ReconcilerBase: vaultresourcecontroller.NewFromManager(mgr, "NamespacedVaultConnection")}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NamespacedVaultConnection")
+		os.Exit(1)
+	}
+
 	if webhooks, ok := os.LookupEnv("ENABLE_WEBHOOKS"); !ok || webhooks != "false" {
 		if err = (&redhatcopv1alpha1.RandomSecret{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "RandomSecret")
@@ -384,6 +487,10 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "VaultSecret")
 			os.Exit(1)
 		}
+		if err = (&redhatcopv1alpha1.ClusterVaultSecret{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterVaultSecret")
+			os.Exit(1)
+		}
 		if err = (&redhatcopv1alpha1.PasswordPolicy{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "PasswordPolicy")
 			os.Exit(1)
@@ -452,6 +559,51 @@ func main() {
 			os.Exit(1)
 		}
 
+		if err = (&redhatcopv1alpha1.TransitSecretEngineKey{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "TransitSecretEngineKey")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.SSHSecretEngineConfig{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "SSHSecretEngineConfig")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.SSHSecretEngineRole{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "SSHSecretEngineRole")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.AWSSecretEngineConfig{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AWSSecretEngineConfig")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.AWSSecretEngineRole{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AWSSecretEngineRole")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.LDAPSecretEngineConfig{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "LDAPSecretEngineConfig")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.LDAPSecretEngineStaticRole{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "LDAPSecretEngineStaticRole")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.LDAPSecretEngineDynamicRole{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "LDAPSecretEngineDynamicRole")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.LDAPSecretEngineLibrary{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "LDAPSecretEngineLibrary")
+			os.Exit(1)
+		}
+
 		mgr.GetWebhookServer().Register("/validate-redhatcop-redhat-io-v1alpha1-rabbitmqsecretengineconfig", &webhook.Admission{Handler: &redhatcopv1alpha1.RabbitMQSecretEngineConfigValidation{Client: mgr.GetClient()}})
 
 		if err = (&redhatcopv1alpha1.DatabaseSecretEngineStaticRole{}).SetupWebhookWithManager(mgr); err != nil {
@@ -467,6 +619,24 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "GroupAlias")
 			os.Exit(1)
 		}
+
+		if err = (&redhatcopv1alpha1.Entity{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Entity")
+			os.Exit(1)
+		}
+
+		if err = (&redhatcopv1alpha1.EntityAlias{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "EntityAlias")
+			os.Exit(1)
+		}
+		if err = (&redhatcopv1alpha1.VaultConnection{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "VaultConnection")
+			os.Exit(1)
+		}
+		if err = (&redhatcopv1alpha1.NamespacedVaultConnection{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "NamespacedVaultConnection")
+			os.Exit(1)
+		}
 	}
 
 	//+kubebuilder:scaffold:builder
@@ -485,4 +655,9 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+	if shutdownTracing != nil {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "unable to flush traces")
+		}
+	}
 }